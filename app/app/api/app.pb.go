@@ -235,36 +235,38 @@ type UserInfoReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address                  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Level                    int64  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
-	Status                   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Amount                   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	BalanceUsdt              string `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb               string `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	InviteUrl                string `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
-	InviteUserAddress        string `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
-	RecommendNum             int64  `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
-	RecommendTeamNum         int64  `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
-	Total                    string `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
-	FeeTotal                 string `protobuf:"bytes,23,opt,name=feeTotal,proto3" json:"feeTotal,omitempty"`
-	Row                      int64  `protobuf:"varint,12,opt,name=row,proto3" json:"row,omitempty"`
-	Col                      int64  `protobuf:"varint,13,opt,name=col,proto3" json:"col,omitempty"`
-	CurrentMonthRecommendNum int64  `protobuf:"varint,14,opt,name=currentMonthRecommendNum,proto3" json:"currentMonthRecommendNum,omitempty"`
-	RecommendTotal           string `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
-	LocationTotal            string `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
-	Level1Dhb                string `protobuf:"bytes,17,opt,name=level1Dhb,proto3" json:"level1Dhb,omitempty"`
-	Level2Dhb                string `protobuf:"bytes,18,opt,name=level2Dhb,proto3" json:"level2Dhb,omitempty"`
-	Level3Dhb                string `protobuf:"bytes,19,opt,name=level3Dhb,proto3" json:"level3Dhb,omitempty"`
-	Usdt                     string `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
-	Dhb                      string `protobuf:"bytes,21,opt,name=dhb,proto3" json:"dhb,omitempty"`
-	Account                  string `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
-	RecommendNumAll          int64  `protobuf:"varint,24,opt,name=recommendNumAll,proto3" json:"recommendNumAll,omitempty"`
-	RecommendTeamAll         int64  `protobuf:"varint,25,opt,name=recommendTeamAll,proto3" json:"recommendTeamAll,omitempty"`
-	AmountB                  string `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
-	LocationCount            int64  `protobuf:"varint,28,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
-	Undo                     int64  `protobuf:"varint,29,opt,name=undo,proto3" json:"undo,omitempty"`
-	Contract                 string `protobuf:"bytes,30,opt,name=contract,proto3" json:"contract,omitempty"`
-	AreaAmount               string `protobuf:"bytes,31,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
+	Address                  string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Level                    int64                   `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Status                   string                  `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Amount                   string                  `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceUsdt              string                  `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb               string                  `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	InviteUrl                string                  `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
+	InviteUserAddress        string                  `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
+	RecommendNum             int64                   `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
+	RecommendTeamNum         int64                   `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
+	Total                    string                  `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	FeeTotal                 string                  `protobuf:"bytes,23,opt,name=feeTotal,proto3" json:"feeTotal,omitempty"`
+	Row                      int64                   `protobuf:"varint,12,opt,name=row,proto3" json:"row,omitempty"`
+	Col                      int64                   `protobuf:"varint,13,opt,name=col,proto3" json:"col,omitempty"`
+	CurrentMonthRecommendNum int64                   `protobuf:"varint,14,opt,name=currentMonthRecommendNum,proto3" json:"currentMonthRecommendNum,omitempty"`
+	RecommendTotal           string                  `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
+	LocationTotal            string                  `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
+	Level1Dhb                string                  `protobuf:"bytes,17,opt,name=level1Dhb,proto3" json:"level1Dhb,omitempty"`
+	Level2Dhb                string                  `protobuf:"bytes,18,opt,name=level2Dhb,proto3" json:"level2Dhb,omitempty"`
+	Level3Dhb                string                  `protobuf:"bytes,19,opt,name=level3Dhb,proto3" json:"level3Dhb,omitempty"`
+	Usdt                     string                  `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
+	Dhb                      string                  `protobuf:"bytes,21,opt,name=dhb,proto3" json:"dhb,omitempty"`
+	Account                  string                  `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
+	RecommendNumAll          int64                   `protobuf:"varint,24,opt,name=recommendNumAll,proto3" json:"recommendNumAll,omitempty"`
+	RecommendTeamAll         int64                   `protobuf:"varint,25,opt,name=recommendTeamAll,proto3" json:"recommendTeamAll,omitempty"`
+	AmountB                  string                  `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
+	LocationCount            int64                   `protobuf:"varint,28,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
+	Undo                     int64                   `protobuf:"varint,29,opt,name=undo,proto3" json:"undo,omitempty"`
+	Contract                 string                  `protobuf:"bytes,30,opt,name=contract,proto3" json:"contract,omitempty"`
+	AreaAmount               string                  `protobuf:"bytes,31,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
+	Matrix                   []*UserInfoReply_Matrix `protobuf:"bytes,32,rep,name=matrix,proto3" json:"matrix,omitempty"`
+	AutoReinvest             int64                   `protobuf:"varint,33,opt,name=autoReinvest,proto3" json:"autoReinvest,omitempty"`
 }
 
 func (x *UserInfoReply) Reset() {
@@ -509,6 +511,20 @@ func (x *UserInfoReply) GetAreaAmount() string {
 	return ""
 }
 
+func (x *UserInfoReply) GetMatrix() []*UserInfoReply_Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *UserInfoReply) GetAutoReinvest() int64 {
+	if x != nil {
+		return x.AutoReinvest
+	}
+	return 0
+}
+
 type RewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -773,16 +789,14 @@ func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
 	return nil
 }
 
-type WithdrawListRequest struct {
+type MyLocationListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *WithdrawListRequest) Reset() {
-	*x = WithdrawListRequest{}
+func (x *MyLocationListRequest) Reset() {
+	*x = MyLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -790,13 +804,13 @@ func (x *WithdrawListRequest) Reset() {
 	}
 }
 
-func (x *WithdrawListRequest) String() string {
+func (x *MyLocationListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListRequest) ProtoMessage() {}
+func (*MyLocationListRequest) ProtoMessage() {}
 
-func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
+func (x *MyLocationListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,28 +822,21 @@ func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MyLocationListRequest.ProtoReflect.Descriptor instead.
+func (*MyLocationListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *WithdrawListRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type WithdrawListReply struct {
+type MyLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Locations []*MyLocationListReply_List `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
 }
 
-func (x *WithdrawListReply) Reset() {
-	*x = WithdrawListReply{}
+func (x *MyLocationListReply) Reset() {
+	*x = MyLocationListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +844,13 @@ func (x *WithdrawListReply) Reset() {
 	}
 }
 
-func (x *WithdrawListReply) String() string {
+func (x *MyLocationListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply) ProtoMessage() {}
+func (*MyLocationListReply) ProtoMessage() {}
 
-func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
+func (x *MyLocationListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,28 +862,26 @@ func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use MyLocationListReply.ProtoReflect.Descriptor instead.
+func (*MyLocationListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
+func (x *MyLocationListReply) GetLocations() []*MyLocationListReply_List {
 	if x != nil {
-		return x.Withdraw
+		return x.Locations
 	}
 	return nil
 }
 
-type RecommendListRequest struct {
+type LocationNeighbourRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *RecommendListRequest) Reset() {
-	*x = RecommendListRequest{}
+func (x *LocationNeighbourRequest) Reset() {
+	*x = LocationNeighbourRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,13 +889,13 @@ func (x *RecommendListRequest) Reset() {
 	}
 }
 
-func (x *RecommendListRequest) String() string {
+func (x *LocationNeighbourRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListRequest) ProtoMessage() {}
+func (*LocationNeighbourRequest) ProtoMessage() {}
 
-func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
+func (x *LocationNeighbourRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,28 +907,26 @@ func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocationNeighbourRequest.ProtoReflect.Descriptor instead.
+func (*LocationNeighbourRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RecommendListRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type RecommendListReply struct {
+type LocationNeighbourReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
+	MatrixId int64                          `protobuf:"varint,1,opt,name=matrixId,proto3" json:"matrixId,omitempty"`
+	Row      int64                          `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col      int64                          `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	Position int64                          `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	RowPeers []*LocationNeighbourReply_List `protobuf:"bytes,5,rep,name=rowPeers,proto3" json:"rowPeers,omitempty"`
+	ColPeers []*LocationNeighbourReply_List `protobuf:"bytes,6,rep,name=colPeers,proto3" json:"colPeers,omitempty"`
 }
 
-func (x *RecommendListReply) Reset() {
-	*x = RecommendListReply{}
+func (x *LocationNeighbourReply) Reset() {
+	*x = LocationNeighbourReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,13 +934,13 @@ func (x *RecommendListReply) Reset() {
 	}
 }
 
-func (x *RecommendListReply) String() string {
+func (x *LocationNeighbourReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply) ProtoMessage() {}
+func (*LocationNeighbourReply) ProtoMessage() {}
 
-func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
+func (x *LocationNeighbourReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,28 +952,63 @@ func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
-func (*RecommendListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocationNeighbourReply.ProtoReflect.Descriptor instead.
+func (*LocationNeighbourReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
+func (x *LocationNeighbourReply) GetMatrixId() int64 {
 	if x != nil {
-		return x.Recommends
+		return x.MatrixId
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply) GetRowPeers() []*LocationNeighbourReply_List {
+	if x != nil {
+		return x.RowPeers
 	}
 	return nil
 }
 
-type WithdrawRequest struct {
+func (x *LocationNeighbourReply) GetColPeers() []*LocationNeighbourReply_List {
+	if x != nil {
+		return x.ColPeers
+	}
+	return nil
+}
+
+type WithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *WithdrawListRequest) Reset() {
+	*x = WithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -978,13 +1016,13 @@ func (x *WithdrawRequest) Reset() {
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *WithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*WithdrawListRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,28 +1034,28 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
+func (x *WithdrawListRequest) GetType() string {
 	if x != nil {
-		return x.SendBody
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type WithdrawReply struct {
+type WithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
 }
 
-func (x *WithdrawReply) Reset() {
-	*x = WithdrawReply{}
+func (x *WithdrawListReply) Reset() {
+	*x = WithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,13 +1063,13 @@ func (x *WithdrawReply) Reset() {
 	}
 }
 
-func (x *WithdrawReply) String() string {
+func (x *WithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawReply) ProtoMessage() {}
+func (*WithdrawListReply) ProtoMessage() {}
 
-func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,29 +1081,30 @@ func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
-func (*WithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WithdrawReply) GetStatus() string {
+func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
 	if x != nil {
-		return x.Status
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListRequest struct {
+type RecommendTreeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page   int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Sort   string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
-func (x *AdminRewardListRequest) Reset() {
-	*x = AdminRewardListRequest{}
+func (x *RecommendTreeRequest) Reset() {
+	*x = RecommendTreeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,13 +1112,13 @@ func (x *AdminRewardListRequest) Reset() {
 	}
 }
 
-func (x *AdminRewardListRequest) String() string {
+func (x *RecommendTreeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListRequest) ProtoMessage() {}
+func (*RecommendTreeRequest) ProtoMessage() {}
 
-func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,36 +1130,49 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
-func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeRequest.ProtoReflect.Descriptor instead.
+func (*RecommendTreeRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AdminRewardListRequest) GetPage() int64 {
+func (x *RecommendTreeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecommendTreeRequest) GetPage() int64 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminRewardListRequest) GetAddress() string {
+func (x *RecommendTreeRequest) GetSort() string {
 	if x != nil {
-		return x.Address
+		return x.Sort
 	}
 	return ""
 }
 
-type AdminRewardListReply struct {
+type RecommendTreeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	UserId     int64                       `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address    string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TeamNum    int64                       `protobuf:"varint,3,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	ActiveNum  int64                       `protobuf:"varint,4,opt,name=active_num,json=activeNum,proto3" json:"active_num,omitempty"`
+	TeamAmount string                      `protobuf:"bytes,5,opt,name=team_amount,json=teamAmount,proto3" json:"team_amount,omitempty"`
+	Depths     []*RecommendTreeReply_Depth `protobuf:"bytes,6,rep,name=depths,proto3" json:"depths,omitempty"`
+	Children   []*RecommendTreeReply_List  `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
+	Count      int64                       `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminRewardListReply) Reset() {
-	*x = AdminRewardListReply{}
+func (x *RecommendTreeReply) Reset() {
+	*x = RecommendTreeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1128,13 +1180,13 @@ func (x *AdminRewardListReply) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply) String() string {
+func (x *RecommendTreeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply) ProtoMessage() {}
+func (*RecommendTreeReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1146,36 +1198,77 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeReply.ProtoReflect.Descriptor instead.
+func (*RecommendTreeReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+func (x *RecommendTreeReply) GetUserId() int64 {
 	if x != nil {
-		return x.Rewards
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply) GetActiveNum() int64 {
+	if x != nil {
+		return x.ActiveNum
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply) GetTeamAmount() string {
+	if x != nil {
+		return x.TeamAmount
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply) GetDepths() []*RecommendTreeReply_Depth {
+	if x != nil {
+		return x.Depths
 	}
 	return nil
 }
 
-func (x *AdminRewardListReply) GetCount() int64 {
+func (x *RecommendTreeReply) GetChildren() []*RecommendTreeReply_List {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *RecommendTreeReply) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-type AdminUserListRequest struct {
+type RecommendListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *AdminUserListRequest) Reset() {
-	*x = AdminUserListRequest{}
+func (x *RecommendListRequest) Reset() {
+	*x = RecommendListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1183,13 +1276,13 @@ func (x *AdminUserListRequest) Reset() {
 	}
 }
 
-func (x *AdminUserListRequest) String() string {
+func (x *RecommendListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListRequest) ProtoMessage() {}
+func (*RecommendListRequest) ProtoMessage() {}
 
-func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1201,36 +1294,28 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AdminUserListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminUserListRequest) GetAddress() string {
+func (x *RecommendListRequest) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
 	return ""
 }
 
-type AdminUserListReply struct {
+type RecommendListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
 }
 
-func (x *AdminUserListReply) Reset() {
-	*x = AdminUserListReply{}
+func (x *RecommendListReply) Reset() {
+	*x = RecommendListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,13 +1323,13 @@ func (x *AdminUserListReply) Reset() {
 	}
 }
 
-func (x *AdminUserListReply) String() string {
+func (x *RecommendListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply) ProtoMessage() {}
+func (*RecommendListReply) ProtoMessage() {}
 
-func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,36 +1341,28 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
+func (*RecommendListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
+func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
 	if x != nil {
-		return x.Users
+		return x.Recommends
 	}
 	return nil
 }
 
-func (x *AdminUserListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminLocationListRequest struct {
+type WithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLocationListRequest) Reset() {
-	*x = AdminLocationListRequest{}
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1293,13 +1370,13 @@ func (x *AdminLocationListRequest) Reset() {
 	}
 }
 
-func (x *AdminLocationListRequest) String() string {
+func (x *WithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListRequest) ProtoMessage() {}
+func (*WithdrawRequest) ProtoMessage() {}
 
-func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1311,36 +1388,28 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
-func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AdminLocationListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminLocationListRequest) GetAddress() string {
+func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminLocationListReply struct {
+type WithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminLocationListReply) Reset() {
-	*x = AdminLocationListReply{}
+func (x *WithdrawReply) Reset() {
+	*x = WithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1348,13 +1417,13 @@ func (x *AdminLocationListReply) Reset() {
 	}
 }
 
-func (x *AdminLocationListReply) String() string {
+func (x *WithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply) ProtoMessage() {}
+func (*WithdrawReply) ProtoMessage() {}
 
-func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1366,36 +1435,28 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
+func (*WithdrawReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
-	if x != nil {
-		return x.Locations
-	}
-	return nil
-}
-
-func (x *AdminLocationListReply) GetCount() int64 {
+func (x *WithdrawReply) GetStatus() string {
 	if x != nil {
-		return x.Count
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type AdminWithdrawListRequest struct {
+type TransferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	SendBody *TransferRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawListRequest) Reset() {
-	*x = AdminWithdrawListRequest{}
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1403,13 +1464,13 @@ func (x *AdminWithdrawListRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListRequest) String() string {
+func (x *TransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListRequest) ProtoMessage() {}
+func (*TransferRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1421,36 +1482,29 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AdminWithdrawListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminWithdrawListRequest) GetAddress() string {
+func (x *TransferRequest) GetSendBody() *TransferRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminWithdrawListReply struct {
+type TransferReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
-	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawListReply) Reset() {
-	*x = AdminWithdrawListReply{}
+func (x *TransferReply) Reset() {
+	*x = TransferReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1458,13 +1512,13 @@ func (x *AdminWithdrawListReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListReply) String() string {
+func (x *TransferReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply) ProtoMessage() {}
+func (*TransferReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
+func (x *TransferReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,33 +1530,35 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferReply.ProtoReflect.Descriptor instead.
+func (*TransferReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
+func (x *TransferReply) GetId() int64 {
 	if x != nil {
-		return x.Withdraw
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *AdminWithdrawListReply) GetCount() int64 {
+func (x *TransferReply) GetStatus() string {
 	if x != nil {
-		return x.Count
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type AdminWithdrawRequest struct {
+type ConvertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *ConvertRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawRequest) Reset() {
-	*x = AdminWithdrawRequest{}
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,13 +1566,13 @@ func (x *AdminWithdrawRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawRequest) String() string {
+func (x *ConvertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawRequest) ProtoMessage() {}
+func (*ConvertRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1528,19 +1584,32 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{26}
 }
 
-type AdminWithdrawReply struct {
+func (x *ConvertRequest) GetSendBody() *ConvertRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type ConvertReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAmount string `protobuf:"bytes,2,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
+	ToAmount   string `protobuf:"bytes,3,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	Price      string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Status     string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawReply) Reset() {
-	*x = AdminWithdrawReply{}
+func (x *ConvertReply) Reset() {
+	*x = ConvertReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1548,13 +1617,13 @@ func (x *AdminWithdrawReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawReply) String() string {
+func (x *ConvertReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawReply) ProtoMessage() {}
+func (*ConvertReply) ProtoMessage() {}
 
-func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *ConvertReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1566,19 +1635,56 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConvertReply.ProtoReflect.Descriptor instead.
+func (*ConvertReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{27}
 }
 
-type AdminWithdrawEthRequest struct {
+func (x *ConvertReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConvertReply) GetFromAmount() string {
+	if x != nil {
+		return x.FromAmount
+	}
+	return ""
+}
+
+func (x *ConvertReply) GetToAmount() string {
+	if x != nil {
+		return x.ToAmount
+	}
+	return ""
+}
+
+func (x *ConvertReply) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *ConvertReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ReinvestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *ReinvestRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawEthRequest) Reset() {
-	*x = AdminWithdrawEthRequest{}
+func (x *ReinvestRequest) Reset() {
+	*x = ReinvestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1586,13 +1692,13 @@ func (x *AdminWithdrawEthRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthRequest) String() string {
+func (x *ReinvestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthRequest) ProtoMessage() {}
+func (*ReinvestRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+func (x *ReinvestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1604,19 +1710,28 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReinvestRequest.ProtoReflect.Descriptor instead.
+func (*ReinvestRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{28}
 }
 
-type AdminWithdrawEthReply struct {
+func (x *ReinvestRequest) GetSendBody() *ReinvestRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type ReinvestReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawEthReply) Reset() {
-	*x = AdminWithdrawEthReply{}
+func (x *ReinvestReply) Reset() {
+	*x = ReinvestReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1624,13 +1739,13 @@ func (x *AdminWithdrawEthReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthReply) String() string {
+func (x *ReinvestReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthReply) ProtoMessage() {}
+func (*ReinvestReply) ProtoMessage() {}
 
-func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+func (x *ReinvestReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,19 +1757,28 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReinvestReply.ProtoReflect.Descriptor instead.
+func (*ReinvestReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{29}
 }
 
-type AdminFeeRequest struct {
+func (x *ReinvestReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetAutoReinvestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *SetAutoReinvestRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminFeeRequest) Reset() {
-	*x = AdminFeeRequest{}
+func (x *SetAutoReinvestRequest) Reset() {
+	*x = SetAutoReinvestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1662,13 +1786,13 @@ func (x *AdminFeeRequest) Reset() {
 	}
 }
 
-func (x *AdminFeeRequest) String() string {
+func (x *SetAutoReinvestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeRequest) ProtoMessage() {}
+func (*SetAutoReinvestRequest) ProtoMessage() {}
 
-func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAutoReinvestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1680,19 +1804,28 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAutoReinvestRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoReinvestRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{30}
 }
 
-type AdminFeeReply struct {
+func (x *SetAutoReinvestRequest) GetSendBody() *SetAutoReinvestRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type SetAutoReinvestReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminFeeReply) Reset() {
-	*x = AdminFeeReply{}
+func (x *SetAutoReinvestReply) Reset() {
+	*x = SetAutoReinvestReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1700,13 +1833,13 @@ func (x *AdminFeeReply) Reset() {
 	}
 }
 
-func (x *AdminFeeReply) String() string {
+func (x *SetAutoReinvestReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeReply) ProtoMessage() {}
+func (*SetAutoReinvestReply) ProtoMessage() {}
 
-func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+func (x *SetAutoReinvestReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1718,19 +1851,28 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAutoReinvestReply.ProtoReflect.Descriptor instead.
+func (*SetAutoReinvestReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{31}
 }
 
-type AdminAllRequest struct {
+func (x *SetAutoReinvestReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpgradeLocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *UpgradeLocationRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminAllRequest) Reset() {
-	*x = AdminAllRequest{}
+func (x *UpgradeLocationRequest) Reset() {
+	*x = UpgradeLocationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1738,13 +1880,13 @@ func (x *AdminAllRequest) Reset() {
 	}
 }
 
-func (x *AdminAllRequest) String() string {
+func (x *UpgradeLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllRequest) ProtoMessage() {}
+func (*UpgradeLocationRequest) ProtoMessage() {}
 
-func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+func (x *UpgradeLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1756,29 +1898,28 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
-func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeLocationRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeLocationRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{32}
 }
 
-type AdminAllReply struct {
+func (x *UpgradeLocationRequest) GetSendBody() *UpgradeLocationRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type UpgradeLocationReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
-	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
-	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
-	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
-	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
-	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
-	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
-	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
-	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminAllReply) Reset() {
-	*x = AdminAllReply{}
+func (x *UpgradeLocationReply) Reset() {
+	*x = UpgradeLocationReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,13 +1927,13 @@ func (x *AdminAllReply) Reset() {
 	}
 }
 
-func (x *AdminAllReply) String() string {
+func (x *UpgradeLocationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllReply) ProtoMessage() {}
+func (*UpgradeLocationReply) ProtoMessage() {}
 
-func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+func (x *UpgradeLocationReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,84 +1945,26 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
-func (*AdminAllReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeLocationReply.ProtoReflect.Descriptor instead.
+func (*UpgradeLocationReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *AdminAllReply) GetTodayTotalUser() int64 {
-	if x != nil {
-		return x.TodayTotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetTotalUser() int64 {
-	if x != nil {
-		return x.TotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetAllBalance() string {
-	if x != nil {
-		return x.AllBalance
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayLocation() string {
-	if x != nil {
-		return x.TodayLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllLocation() string {
-	if x != nil {
-		return x.AllLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayWithdraw() string {
-	if x != nil {
-		return x.TodayWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllWithdraw() string {
-	if x != nil {
-		return x.AllWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllReward() string {
+func (x *UpgradeLocationReply) GetStatus() string {
 	if x != nil {
-		return x.AllReward
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
-	if x != nil {
-		return x.AllSystemRewardAndFee
+		return x.Status
 	}
 	return ""
 }
 
-type AdminUserRecommendRequest struct {
+type ReserveProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *AdminUserRecommendRequest) Reset() {
-	*x = AdminUserRecommendRequest{}
+func (x *ReserveProofRequest) Reset() {
+	*x = ReserveProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,13 +1972,13 @@ func (x *AdminUserRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendRequest) String() string {
+func (x *ReserveProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendRequest) ProtoMessage() {}
+func (*ReserveProofRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *ReserveProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,28 +1990,29 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveProofRequest.ProtoReflect.Descriptor instead.
+func (*ReserveProofRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AdminUserRecommendRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-type AdminUserRecommendReply struct {
+type ReserveProofReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	SnapshotId int64                      `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	MerkleRoot string                     `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Address    string                     `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Balance    int64                      `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Leaf       string                     `protobuf:"bytes,5,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Index      int64                      `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Proof      []*ReserveProofReply_Proof `protobuf:"bytes,7,rep,name=proof,proto3" json:"proof,omitempty"`
+	CreatedAt  string                     `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Nonce      string                     `protobuf:"bytes,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *AdminUserRecommendReply) Reset() {
-	*x = AdminUserRecommendReply{}
+func (x *ReserveProofReply) Reset() {
+	*x = ReserveProofReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1936,13 +2020,13 @@ func (x *AdminUserRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendReply) String() string {
+func (x *ReserveProofReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply) ProtoMessage() {}
+func (*ReserveProofReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *ReserveProofReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1954,29 +2038,85 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveProofReply.ProtoReflect.Descriptor instead.
+func (*ReserveProofReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+func (x *ReserveProofReply) GetSnapshotId() int64 {
 	if x != nil {
-		return x.Users
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *ReserveProofReply) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *ReserveProofReply) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReserveProofReply) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ReserveProofReply) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *ReserveProofReply) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReserveProofReply) GetProof() []*ReserveProofReply_Proof {
+	if x != nil {
+		return x.Proof
 	}
 	return nil
 }
 
-type AdminMonthRecommendRequest struct {
+func (x *ReserveProofReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReserveProofReply) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type StatementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *AdminMonthRecommendRequest) Reset() {
-	*x = AdminMonthRecommendRequest{}
+func (x *StatementRequest) Reset() {
+	*x = StatementRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1984,13 +2124,13 @@ func (x *AdminMonthRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendRequest) String() string {
+func (x *StatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendRequest) ProtoMessage() {}
+func (*StatementRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *StatementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,36 +2142,41 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementRequest.ProtoReflect.Descriptor instead.
+func (*StatementRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AdminMonthRecommendRequest) GetAddress() string {
+func (x *StatementRequest) GetStart() string {
 	if x != nil {
-		return x.Address
+		return x.Start
 	}
 	return ""
 }
 
-func (x *AdminMonthRecommendRequest) GetPage() int64 {
+func (x *StatementRequest) GetEnd() string {
 	if x != nil {
-		return x.Page
+		return x.End
 	}
-	return 0
+	return ""
 }
 
-type AdminMonthRecommendReply struct {
+type StatementReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	List        []*StatementReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Start       string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End         string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	OpeningUsdt string                 `protobuf:"bytes,4,opt,name=opening_usdt,json=openingUsdt,proto3" json:"opening_usdt,omitempty"`
+	OpeningDhb  string                 `protobuf:"bytes,5,opt,name=opening_dhb,json=openingDhb,proto3" json:"opening_dhb,omitempty"`
+	ClosingUsdt string                 `protobuf:"bytes,6,opt,name=closing_usdt,json=closingUsdt,proto3" json:"closing_usdt,omitempty"`
+	ClosingDhb  string                 `protobuf:"bytes,7,opt,name=closing_dhb,json=closingDhb,proto3" json:"closing_dhb,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply) Reset() {
-	*x = AdminMonthRecommendReply{}
+func (x *StatementReply) Reset() {
+	*x = StatementReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,13 +2184,13 @@ func (x *AdminMonthRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendReply) String() string {
+func (x *StatementReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply) ProtoMessage() {}
+func (*StatementReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *StatementReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2057,36 +2202,68 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementReply.ProtoReflect.Descriptor instead.
+func (*StatementReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
+func (x *StatementReply) GetList() []*StatementReply_List {
 	if x != nil {
-		return x.Users
+		return x.List
 	}
 	return nil
 }
 
-func (x *AdminMonthRecommendReply) GetCount() int64 {
+func (x *StatementReply) GetStart() string {
 	if x != nil {
-		return x.Count
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-type AdminConfigRequest struct {
+func (x *StatementReply) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *StatementReply) GetOpeningUsdt() string {
+	if x != nil {
+		return x.OpeningUsdt
+	}
+	return ""
+}
+
+func (x *StatementReply) GetOpeningDhb() string {
+	if x != nil {
+		return x.OpeningDhb
+	}
+	return ""
+}
+
+func (x *StatementReply) GetClosingUsdt() string {
+	if x != nil {
+		return x.ClosingUsdt
+	}
+	return ""
+}
+
+func (x *StatementReply) GetClosingDhb() string {
+	if x != nil {
+		return x.ClosingDhb
+	}
+	return ""
+}
+
+type ExitLocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *AdminConfigRequest) Reset() {
-	*x = AdminConfigRequest{}
+func (x *ExitLocationRequest) Reset() {
+	*x = ExitLocationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2094,13 +2271,13 @@ func (x *AdminConfigRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigRequest) String() string {
+func (x *ExitLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigRequest) ProtoMessage() {}
+func (*ExitLocationRequest) ProtoMessage() {}
 
-func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *ExitLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2112,36 +2289,23 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExitLocationRequest.ProtoReflect.Descriptor instead.
+func (*ExitLocationRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AdminConfigRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminConfigRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-type AdminConfigReply struct {
+type ExitLocationReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
-	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Refund  string `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
+	Penalty string `protobuf:"bytes,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
 }
 
-func (x *AdminConfigReply) Reset() {
-	*x = AdminConfigReply{}
+func (x *ExitLocationReply) Reset() {
+	*x = ExitLocationReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2149,13 +2313,13 @@ func (x *AdminConfigReply) Reset() {
 	}
 }
 
-func (x *AdminConfigReply) String() string {
+func (x *ExitLocationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply) ProtoMessage() {}
+func (*ExitLocationReply) ProtoMessage() {}
 
-func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+func (x *ExitLocationReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2167,35 +2331,43 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExitLocationReply.ProtoReflect.Descriptor instead.
+func (*ExitLocationReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+func (x *ExitLocationReply) GetStatus() string {
 	if x != nil {
-		return x.Config
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *AdminConfigReply) GetCount() int64 {
+func (x *ExitLocationReply) GetRefund() string {
 	if x != nil {
-		return x.Count
+		return x.Refund
 	}
-	return 0
+	return ""
 }
 
-type AdminConfigUpdateRequest struct {
+func (x *ExitLocationReply) GetPenalty() string {
+	if x != nil {
+		return x.Penalty
+	}
+	return ""
+}
+
+type AdminRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest) Reset() {
-	*x = AdminConfigUpdateRequest{}
+func (x *AdminRewardListRequest) Reset() {
+	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2203,13 +2375,13 @@ func (x *AdminConfigUpdateRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateRequest) String() string {
+func (x *AdminRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest) ProtoMessage() {}
+func (*AdminRewardListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2221,26 +2393,36 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+func (x *AdminRewardListRequest) GetPage() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type AdminConfigUpdateReply struct {
+func (x *AdminRewardListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigUpdateReply) Reset() {
-	*x = AdminConfigUpdateReply{}
+func (x *AdminRewardListReply) Reset() {
+	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2248,13 +2430,13 @@ func (x *AdminConfigUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateReply) String() string {
+func (x *AdminRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateReply) ProtoMessage() {}
+func (*AdminRewardListReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2266,22 +2448,36 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{41}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *AdminRewardListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminUserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *AdminUserListRequest) Reset() {
+	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2289,13 +2485,13 @@ func (x *EthAuthorizeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *AdminUserListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*AdminUserListRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2307,38 +2503,36 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+func (x *AdminUserListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+func (x *AdminUserListRequest) GetAddress() string {
 	if x != nil {
-		return x.Code
+		return x.Address
 	}
 	return ""
 }
 
-type RewardListReply_List struct {
+type AdminUserListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminUserListReply) Reset() {
+	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2346,13 +2540,13 @@ func (x *RewardListReply_List) Reset() {
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminUserListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminUserListReply) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2364,52 +2558,36 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *RewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RewardListReply_List) GetLocationStatus() string {
+func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
 	if x != nil {
-		return x.LocationStatus
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *RewardListReply_List) GetType() string {
+func (x *AdminUserListReply) GetCount() int64 {
 	if x != nil {
-		return x.Type
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type RecommendRewardListReply_List struct {
+type AdminLocationListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
+func (x *AdminLocationListRequest) Reset() {
+	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2417,13 +2595,13 @@ func (x *RecommendRewardListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AdminLocationListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AdminLocationListRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2435,50 +2613,36 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RecommendRewardListReply_List) GetType() string {
+func (x *AdminLocationListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Type
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AdminLocationListRequest) GetAddress() string {
 	if x != nil {
-		return x.Reason
+		return x.Address
 	}
 	return ""
 }
 
-type FeeRewardListReply_List struct {
+type AdminLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AdminLocationListReply) Reset() {
+	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2486,13 +2650,13 @@ func (x *FeeRewardListReply_List) Reset() {
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AdminLocationListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AdminLocationListReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2504,38 +2668,35 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
+func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
 	if x != nil {
-		return x.CreatedAt
+		return x.Locations
 	}
-	return ""
+	return nil
 }
 
-func (x *FeeRewardListReply_List) GetAmount() string {
+func (x *AdminLocationListReply) GetCount() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type WithdrawListReply_List struct {
+type AdminLocationEventListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	LocationId int64 `protobuf:"varint,1,opt,name=locationId,proto3" json:"locationId,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AdminLocationEventListRequest) Reset() {
+	*x = AdminLocationEventListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2543,13 +2704,13 @@ func (x *WithdrawListReply_List) Reset() {
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AdminLocationEventListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AdminLocationEventListRequest) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationEventListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2561,50 +2722,35 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *WithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminLocationEventListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationEventListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
+func (x *AdminLocationEventListRequest) GetLocationId() int64 {
 	if x != nil {
-		return x.Status
+		return x.LocationId
 	}
-	return ""
+	return 0
 }
 
-type RecommendListReply_List struct {
+type AdminLocationEventListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Events     []*AdminLocationEventListReply_List `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Address    string                              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	MatrixId   int64                               `protobuf:"varint,3,opt,name=matrixId,proto3" json:"matrixId,omitempty"`
+	Status     string                              `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Current    string                              `protobuf:"bytes,5,opt,name=current,proto3" json:"current,omitempty"`
+	Row        int64                               `protobuf:"varint,6,opt,name=row,proto3" json:"row,omitempty"`
+	Col        int64                               `protobuf:"varint,7,opt,name=col,proto3" json:"col,omitempty"`
+	Consistent bool                                `protobuf:"varint,8,opt,name=consistent,proto3" json:"consistent,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *AdminLocationEventListReply) Reset() {
+	*x = AdminLocationEventListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2612,13 +2758,13 @@ func (x *RecommendListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *AdminLocationEventListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*AdminLocationEventListReply) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationEventListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2630,36 +2776,78 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use AdminLocationEventListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationEventListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RecommendListReply_List) GetAmount() string {
+func (x *AdminLocationEventListReply) GetEvents() []*AdminLocationEventListReply_List {
 	if x != nil {
-		return x.Amount
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AdminLocationEventListReply) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *RecommendListReply_List) GetStatus() string {
+func (x *AdminLocationEventListReply) GetMatrixId() int64 {
+	if x != nil {
+		return x.MatrixId
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type WithdrawRequest_SendBody struct {
+func (x *AdminLocationEventListReply) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+type AdminWithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminWithdrawListRequest) Reset() {
+	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2667,13 +2855,13 @@ func (x *WithdrawRequest_SendBody) Reset() {
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminWithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminWithdrawListRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2685,39 +2873,36 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *WithdrawRequest_SendBody) GetType() string {
+func (x *AdminWithdrawListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Type
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawRequest_SendBody) GetAmount() string {
+func (x *AdminWithdrawListRequest) GetAddress() string {
 	if x != nil {
-		return x.Amount
+		return x.Address
 	}
 	return ""
 }
 
-type AdminRewardListReply_List struct {
+type AdminWithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminWithdrawListReply) Reset() {
+	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2725,13 +2910,13 @@ func (x *AdminRewardListReply_List) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminWithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawListReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2743,63 +2928,33 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{19, 0}
-}
-
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AdminRewardListReply_List) GetAddress() string {
+func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
 	if x != nil {
-		return x.Address
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
+func (x *AdminWithdrawListReply) GetCount() int64 {
 	if x != nil {
-		return x.Reason
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminUserListReply_UserList struct {
+type AdminWithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
-	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
-	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminWithdrawRequest) Reset() {
+	*x = AdminWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2807,13 +2962,13 @@ func (x *AdminUserListReply_UserList) Reset() {
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminWithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminWithdrawRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2825,99 +2980,72 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+type AdminWithdrawReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
+func (x *AdminWithdrawReply) Reset() {
+	*x = AdminWithdrawReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
-	}
-	return ""
+func (x *AdminWithdrawReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
-}
+func (*AdminWithdrawReply) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
-	if x != nil {
-		return x.MonthRecommend
+func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
-	if x != nil {
-		return x.HistoryRecommend
-	}
-	return 0
+// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{51}
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminWithdrawEthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminWithdrawEthRequest) Reset() {
+	*x = AdminWithdrawEthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[51]
+		mi := &file_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminWithdrawEthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminWithdrawEthRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[51]
+func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2928,98 +3056,72 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{23, 0}
-}
-
-func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminLocationListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+type AdminWithdrawEthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminLocationListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
+func (x *AdminWithdrawEthReply) Reset() {
+	*x = AdminWithdrawEthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *AdminWithdrawEthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
-	}
-	return 0
-}
+func (*AdminWithdrawEthReply) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
+func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
-	if x != nil {
-		return x.CurrentMax
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{53}
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminFeeRequest) Reset() {
+	*x = AdminFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[52]
+		mi := &file_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminFeeRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[52]
+func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,88 +3132,72 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{25, 0}
-}
-
-func (x *AdminWithdrawListReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type AdminFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminFeeReply) Reset() {
+	*x = AdminFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminWithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x *AdminFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetRelAmount() string {
-	if x != nil {
-		return x.RelAmount
-	}
-	return ""
-}
+func (*AdminFeeReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{55}
 }
 
-type AdminUserRecommendReply_List struct {
+type AdminAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminAllRequest) Reset() {
+	*x = AdminAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[53]
+		mi := &file_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminAllRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[53]
+func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,67 +3208,44 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{35, 0}
-}
-
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminUserRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
+func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{56}
 }
 
-type AdminMonthRecommendReply_List struct {
+type AdminAllReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
+	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
+	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
+	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
+	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
+	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
+	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
+	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
+	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminAllReply) Reset() {
+	*x = AdminAllReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[54]
+		mi := &file_api_app_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminAllReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminAllReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[54]
+func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3193,66 +3256,99 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
+func (*AdminAllReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
+func (x *AdminAllReply) GetTodayTotalUser() int64 {
 	if x != nil {
-		return x.Address
+		return x.TodayTotalUser
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+func (x *AdminAllReply) GetTotalUser() int64 {
 	if x != nil {
-		return x.RecommendAddress
+		return x.TotalUser
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
+func (x *AdminAllReply) GetAllBalance() string {
 	if x != nil {
-		return x.Id
+		return x.AllBalance
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+func (x *AdminAllReply) GetTodayLocation() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TodayLocation
 	}
 	return ""
 }
 
-type AdminConfigReply_List struct {
+func (x *AdminAllReply) GetAllLocation() string {
+	if x != nil {
+		return x.AllLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayWithdraw() string {
+	if x != nil {
+		return x.TodayWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllWithdraw() string {
+	if x != nil {
+		return x.AllWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllReward() string {
+	if x != nil {
+		return x.AllReward
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+	if x != nil {
+		return x.AllSystemRewardAndFee
+	}
+	return ""
+}
+
+type AdminUserRecommendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+func (x *AdminUserRecommendRequest) Reset() {
+	*x = AdminUserRecommendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[55]
+		mi := &file_api_app_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *AdminUserRecommendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*AdminUserRecommendRequest) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[55]
+func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3263,58 +3359,146 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
+func (x *AdminUserRecommendRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
+type AdminUserRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AdminUserRecommendReply) Reset() {
+	*x = AdminUserRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
 	if x != nil {
-		return x.Name
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminConfigReply_List) GetValue() string {
+type AdminMonthRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminMonthRecommendRequest) Reset() {
+	*x = AdminMonthRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendRequest) ProtoMessage() {}
+
+func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AdminMonthRecommendRequest) GetAddress() string {
 	if x != nil {
-		return x.Value
+		return x.Address
 	}
 	return ""
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+func (x *AdminMonthRecommendRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminMonthRecommendReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *AdminMonthRecommendReply) Reset() {
+	*x = AdminMonthRecommendReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[56]
+		mi := &file_api_app_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *AdminMonthRecommendReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminMonthRecommendReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[56]
+func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,579 +3509,9064 @@ func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{40, 0}
+// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
 	if x != nil {
-		return x.Id
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminMonthRecommendReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+type AdminRecommendTreeBackfillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRecommendTreeBackfillRequest) Reset() {
+	*x = AdminRecommendTreeBackfillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendTreeBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendTreeBackfillRequest) ProtoMessage() {}
+
+func (x *AdminRecommendTreeBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendTreeBackfillRequest.ProtoReflect.Descriptor instead.
+func (*AdminRecommendTreeBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{62}
+}
+
+type AdminRecommendTreeBackfillReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminRecommendTreeBackfillReply) Reset() {
+	*x = AdminRecommendTreeBackfillReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendTreeBackfillReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendTreeBackfillReply) ProtoMessage() {}
+
+func (x *AdminRecommendTreeBackfillReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendTreeBackfillReply.ProtoReflect.Descriptor instead.
+func (*AdminRecommendTreeBackfillReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AdminRecommendTreeBackfillReply) GetCount() int64 {
 	if x != nil {
-		return x.Value
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-var File_api_app_proto protoreflect.FileDescriptor
+type AdminTeamStatsRebuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-var file_api_app_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13,
-	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a,
-	0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xad, 0x07,
-	0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68,
-	0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12,
-	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75,
-	0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
-	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f,
-	0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x63, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12,
-	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
-	0x68, 0x62, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c,
-	0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
-	0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x6e, 0x64,
-	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a,
-	0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a,
-	0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16,
-	0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x69,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
-	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0xe9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63,
-	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61,
-	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4d, 0x61, 0x78, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x02,
-	0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19,
-	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74,
-	0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c,
-	0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41,
-	0x6e, 0x64, 0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f,
-	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x32, 0xdf, 0x09, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a, 0x0c, 0x45, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x57, 0x0a,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c,
-	0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
-	0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
-	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
-	0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x71,
-	0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
-	0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74,
-	0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
-	0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x66, 0x65, 0x65, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61,
-	0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AdminTeamStatsRebuildRequest) Reset() {
+	*x = AdminTeamStatsRebuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_api_app_proto_rawDescOnce sync.Once
-	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
-)
+func (x *AdminTeamStatsRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_api_app_proto_rawDescGZIP() []byte {
-	file_api_app_proto_rawDescOnce.Do(func() {
-		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
-	})
-	return file_api_app_proto_rawDescData
+func (*AdminTeamStatsRebuildRequest) ProtoMessage() {}
+
+func (x *AdminTeamStatsRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
-var file_api_app_proto_goTypes = []interface{}{
-	(*EthAuthorizeRequest)(nil),                 // 0: api.EthAuthorizeRequest
-	(*EthAuthorizeReply)(nil),                   // 1: api.EthAuthorizeReply
-	(*DepositRequest)(nil),                      // 2: api.DepositRequest
-	(*DepositReply)(nil),                        // 3: api.DepositReply
-	(*UserInfoRequest)(nil),                     // 4: api.UserInfoRequest
-	(*UserInfoReply)(nil),                       // 5: api.UserInfoReply
-	(*RewardListRequest)(nil),                   // 6: api.RewardListRequest
-	(*RewardListReply)(nil),                     // 7: api.RewardListReply
-	(*RecommendRewardListRequest)(nil),          // 8: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),            // 9: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                // 10: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                  // 11: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                 // 12: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                   // 13: api.WithdrawListReply
-	(*RecommendListRequest)(nil),                // 14: api.RecommendListRequest
-	(*RecommendListReply)(nil),                  // 15: api.RecommendListReply
-	(*WithdrawRequest)(nil),                     // 16: api.WithdrawRequest
-	(*WithdrawReply)(nil),                       // 17: api.WithdrawReply
-	(*AdminRewardListRequest)(nil),              // 18: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                // 19: api.AdminRewardListReply
-	(*AdminUserListRequest)(nil),                // 20: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                  // 21: api.AdminUserListReply
-	(*AdminLocationListRequest)(nil),            // 22: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),              // 23: api.AdminLocationListReply
-	(*AdminWithdrawListRequest)(nil),            // 24: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),              // 25: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                // 26: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                  // 27: api.AdminWithdrawReply
-	(*AdminWithdrawEthRequest)(nil),             // 28: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),               // 29: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                     // 30: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                       // 31: api.AdminFeeReply
-	(*AdminAllRequest)(nil),                     // 32: api.AdminAllRequest
-	(*AdminAllReply)(nil),                       // 33: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),           // 34: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),             // 35: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),          // 36: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),            // 37: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                  // 38: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                    // 39: api.AdminConfigReply
-	(*AdminConfigUpdateRequest)(nil),            // 40: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),              // 41: api.AdminConfigUpdateReply
-	(*EthAuthorizeRequest_SendBody)(nil),        // 42: api.EthAuthorizeRequest.SendBody
-	(*RewardListReply_List)(nil),                // 43: api.RewardListReply.List
-	(*RecommendRewardListReply_List)(nil),       // 44: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),             // 45: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),              // 46: api.WithdrawListReply.List
-	(*RecommendListReply_List)(nil),             // 47: api.RecommendListReply.List
-	(*WithdrawRequest_SendBody)(nil),            // 48: api.WithdrawRequest.SendBody
-	(*AdminRewardListReply_List)(nil),           // 49: api.AdminRewardListReply.List
-	(*AdminUserListReply_UserList)(nil),         // 50: api.AdminUserListReply.UserList
-	(*AdminLocationListReply_LocationList)(nil), // 51: api.AdminLocationListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),         // 52: api.AdminWithdrawListReply.List
-	(*AdminUserRecommendReply_List)(nil),        // 53: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),       // 54: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),               // 55: api.AdminConfigReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),   // 56: api.AdminConfigUpdateRequest.SendBody
+// Deprecated: Use AdminTeamStatsRebuildRequest.ProtoReflect.Descriptor instead.
+func (*AdminTeamStatsRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{64}
 }
-var file_api_app_proto_depIdxs = []int32{
-	42, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	43, // 1: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	44, // 2: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	45, // 3: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	46, // 4: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	47, // 5: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	48, // 6: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	49, // 7: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	50, // 8: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	51, // 9: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	52, // 10: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	53, // 11: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	54, // 12: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	55, // 13: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	56, // 14: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	0,  // 15: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
-	4,  // 16: api.App.UserInfo:input_type -> api.UserInfoRequest
-	6,  // 17: api.App.RewardList:input_type -> api.RewardListRequest
-	8,  // 18: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	10, // 19: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	12, // 20: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	14, // 21: api.App.RecommendList:input_type -> api.RecommendListRequest
-	16, // 22: api.App.Withdraw:input_type -> api.WithdrawRequest
-	2,  // 23: api.App.Deposit:input_type -> api.DepositRequest
-	26, // 24: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	28, // 25: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	30, // 26: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	1,  // 27: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
-	5,  // 28: api.App.UserInfo:output_type -> api.UserInfoReply
-	7,  // 29: api.App.RewardList:output_type -> api.RewardListReply
-	9,  // 30: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	11, // 31: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	13, // 32: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	15, // 33: api.App.RecommendList:output_type -> api.RecommendListReply
-	17, // 34: api.App.Withdraw:output_type -> api.WithdrawReply
-	3,  // 35: api.App.Deposit:output_type -> api.DepositReply
-	27, // 36: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	29, // 37: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	31, // 38: api.App.AdminFee:output_type -> api.AdminFeeReply
-	27, // [27:39] is the sub-list for method output_type
-	15, // [15:27] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+
+type AdminTeamStatsRebuildReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func init() { file_api_app_proto_init() }
-func file_api_app_proto_init() {
-	if File_api_app_proto != nil {
-		return
+func (x *AdminTeamStatsRebuildReply) Reset() {
+	*x = AdminTeamStatsRebuildReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest); i {
+}
+
+func (x *AdminTeamStatsRebuildReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTeamStatsRebuildReply) ProtoMessage() {}
+
+func (x *AdminTeamStatsRebuildReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTeamStatsRebuildReply.ProtoReflect.Descriptor instead.
+func (*AdminTeamStatsRebuildReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AdminTeamStatsRebuildReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminBalanceAdjustRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminBalanceAdjustRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminBalanceAdjustRequest) Reset() {
+	*x = AdminBalanceAdjustRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustRequest) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustRequest.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AdminBalanceAdjustRequest) GetSendBody() *AdminBalanceAdjustRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminBalanceAdjustReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminBalanceAdjustReply) Reset() {
+	*x = AdminBalanceAdjustReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustReply) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustReply.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AdminBalanceAdjustReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminBalanceAdjustReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminBalanceAdjustApproveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminBalanceAdjustApproveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminBalanceAdjustApproveRequest) Reset() {
+	*x = AdminBalanceAdjustApproveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustApproveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustApproveRequest) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustApproveRequest.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustApproveRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AdminBalanceAdjustApproveRequest) GetSendBody() *AdminBalanceAdjustApproveRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminBalanceAdjustApproveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminBalanceAdjustApproveReply) Reset() {
+	*x = AdminBalanceAdjustApproveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustApproveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustApproveReply) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustApproveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustApproveReply.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustApproveReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AdminBalanceAdjustApproveReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminBalanceAdjustListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page   int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminBalanceAdjustListRequest) Reset() {
+	*x = AdminBalanceAdjustListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustListRequest) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustListRequest.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AdminBalanceAdjustListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminBalanceAdjustListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  []*AdminBalanceAdjustListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64                               `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminBalanceAdjustListReply) Reset() {
+	*x = AdminBalanceAdjustListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustListReply) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustListReply.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AdminBalanceAdjustListReply) GetList() []*AdminBalanceAdjustListReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminBalanceAdjustListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReserveSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Onchain         string                     `protobuf:"bytes,2,opt,name=onchain,proto3" json:"onchain,omitempty"`
+	UserBalance     string                     `protobuf:"bytes,3,opt,name=user_balance,json=userBalance,proto3" json:"user_balance,omitempty"`
+	PendingWithdraw string                     `protobuf:"bytes,4,opt,name=pending_withdraw,json=pendingWithdraw,proto3" json:"pending_withdraw,omitempty"`
+	Liabilities     string                     `protobuf:"bytes,5,opt,name=liabilities,proto3" json:"liabilities,omitempty"`
+	Ratio           string                     `protobuf:"bytes,6,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	MerkleRoot      string                     `protobuf:"bytes,7,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Wallets         []*ReserveSnapshot_Wallets `protobuf:"bytes,8,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	CreatedAt       string                     `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ReserveSnapshot) Reset() {
+	*x = ReserveSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSnapshot) ProtoMessage() {}
+
+func (x *ReserveSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSnapshot.ProtoReflect.Descriptor instead.
+func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReserveSnapshot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReserveSnapshot) GetOnchain() string {
+	if x != nil {
+		return x.Onchain
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetUserBalance() string {
+	if x != nil {
+		return x.UserBalance
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetPendingWithdraw() string {
+	if x != nil {
+		return x.PendingWithdraw
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetLiabilities() string {
+	if x != nil {
+		return x.Liabilities
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot) GetWallets() []*ReserveSnapshot_Wallets {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *ReserveSnapshot) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminDepositReverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminDepositReverseRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminDepositReverseRequest) Reset() {
+	*x = AdminDepositReverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositReverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositReverseRequest) ProtoMessage() {}
+
+func (x *AdminDepositReverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositReverseRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositReverseRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminDepositReverseRequest) GetSendBody() *AdminDepositReverseRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminDepositReverseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LocationId   int64  `protobuf:"varint,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Amount       string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RewardNum    int64  `protobuf:"varint,4,opt,name=reward_num,json=rewardNum,proto3" json:"reward_num,omitempty"`
+	RewardAmount string `protobuf:"bytes,5,opt,name=reward_amount,json=rewardAmount,proto3" json:"reward_amount,omitempty"`
+	Status       string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminDepositReverseReply) Reset() {
+	*x = AdminDepositReverseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositReverseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositReverseReply) ProtoMessage() {}
+
+func (x *AdminDepositReverseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositReverseReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositReverseReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdminDepositReverseReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositReverseReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminDepositReverseReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositReverseReply) GetRewardNum() int64 {
+	if x != nil {
+		return x.RewardNum
+	}
+	return 0
+}
+
+func (x *AdminDepositReverseReply) GetRewardAmount() string {
+	if x != nil {
+		return x.RewardAmount
+	}
+	return ""
+}
+
+func (x *AdminDepositReverseReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminReserveSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminReserveSnapshotRequest) Reset() {
+	*x = AdminReserveSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveSnapshotRequest) ProtoMessage() {}
+
+func (x *AdminReserveSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*AdminReserveSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{75}
+}
+
+type AdminReserveSnapshotReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *ReserveSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *AdminReserveSnapshotReply) Reset() {
+	*x = AdminReserveSnapshotReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveSnapshotReply) ProtoMessage() {}
+
+func (x *AdminReserveSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveSnapshotReply.ProtoReflect.Descriptor instead.
+func (*AdminReserveSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminReserveSnapshotReply) GetSnapshot() *ReserveSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type AdminReserveListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminReserveListRequest) Reset() {
+	*x = AdminReserveListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveListRequest) ProtoMessage() {}
+
+func (x *AdminReserveListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveListRequest.ProtoReflect.Descriptor instead.
+func (*AdminReserveListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdminReserveListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminReserveListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  []*ReserveSnapshot `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64              `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminReserveListReply) Reset() {
+	*x = AdminReserveListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveListReply) ProtoMessage() {}
+
+func (x *AdminReserveListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveListReply.ProtoReflect.Descriptor instead.
+func (*AdminReserveListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdminReserveListReply) GetList() []*ReserveSnapshot {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminReserveListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminReserveMerkleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotId int64 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (x *AdminReserveMerkleRequest) Reset() {
+	*x = AdminReserveMerkleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveMerkleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveMerkleRequest) ProtoMessage() {}
+
+func (x *AdminReserveMerkleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveMerkleRequest.ProtoReflect.Descriptor instead.
+func (*AdminReserveMerkleRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AdminReserveMerkleRequest) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+type AdminReserveMerkleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotId int64                             `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	MerkleRoot string                            `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Leaves     []*AdminReserveMerkleReply_Leaves `protobuf:"bytes,3,rep,name=leaves,proto3" json:"leaves,omitempty"`
+}
+
+func (x *AdminReserveMerkleReply) Reset() {
+	*x = AdminReserveMerkleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveMerkleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveMerkleReply) ProtoMessage() {}
+
+func (x *AdminReserveMerkleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveMerkleReply.ProtoReflect.Descriptor instead.
+func (*AdminReserveMerkleReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AdminReserveMerkleReply) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *AdminReserveMerkleReply) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *AdminReserveMerkleReply) GetLeaves() []*AdminReserveMerkleReply_Leaves {
+	if x != nil {
+		return x.Leaves
+	}
+	return nil
+}
+
+type AdminReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (x *AdminReconcileRequest) Reset() {
+	*x = AdminReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileRequest) ProtoMessage() {}
+
+func (x *AdminReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AdminReconcileRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+type AdminReconcileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*AdminReconcileReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *AdminReconcileReply) Reset() {
+	*x = AdminReconcileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileReply) ProtoMessage() {}
+
+func (x *AdminReconcileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AdminReconcileReply) GetList() []*AdminReconcileReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AdminReconcileListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day  string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Page int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminReconcileListRequest) Reset() {
+	*x = AdminReconcileListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileListRequest) ProtoMessage() {}
+
+func (x *AdminReconcileListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileListRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AdminReconcileListRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AdminReconcileListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminReconcileListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  []*AdminReconcileListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64                           `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminReconcileListReply) Reset() {
+	*x = AdminReconcileListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileListReply) ProtoMessage() {}
+
+func (x *AdminReconcileListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileListReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdminReconcileListReply) GetList() []*AdminReconcileListReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminReconcileListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminReconcileDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResultId int64 `protobuf:"varint,1,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	Page     int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminReconcileDetailRequest) Reset() {
+	*x = AdminReconcileDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileDetailRequest) ProtoMessage() {}
+
+func (x *AdminReconcileDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileDetailRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileDetailRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AdminReconcileDetailRequest) GetResultId() int64 {
+	if x != nil {
+		return x.ResultId
+	}
+	return 0
+}
+
+func (x *AdminReconcileDetailRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminReconcileDetailReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  []*AdminReconcileDetailReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64                             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminReconcileDetailReply) Reset() {
+	*x = AdminReconcileDetailReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileDetailReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileDetailReply) ProtoMessage() {}
+
+func (x *AdminReconcileDetailReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileDetailReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileDetailReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AdminReconcileDetailReply) GetList() []*AdminReconcileDetailReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminReconcileDetailReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminLedgerVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Opening bool `protobuf:"varint,1,opt,name=opening,proto3" json:"opening,omitempty"`
+	Fix     bool `protobuf:"varint,2,opt,name=fix,proto3" json:"fix,omitempty"`
+}
+
+func (x *AdminLedgerVerifyRequest) Reset() {
+	*x = AdminLedgerVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerVerifyRequest) ProtoMessage() {}
+
+func (x *AdminLedgerVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerVerifyRequest.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AdminLedgerVerifyRequest) GetOpening() bool {
+	if x != nil {
+		return x.Opening
+	}
+	return false
+}
+
+func (x *AdminLedgerVerifyRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type AdminLedgerVerifyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List     []*AdminLedgerVerifyReply_List     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Accounts []*AdminLedgerVerifyReply_Accounts `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Count    int64                              `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Opening  int64                              `protobuf:"varint,4,opt,name=opening,proto3" json:"opening,omitempty"`
+}
+
+func (x *AdminLedgerVerifyReply) Reset() {
+	*x = AdminLedgerVerifyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerVerifyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerVerifyReply) ProtoMessage() {}
+
+func (x *AdminLedgerVerifyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerVerifyReply.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AdminLedgerVerifyReply) GetList() []*AdminLedgerVerifyReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminLedgerVerifyReply) GetAccounts() []*AdminLedgerVerifyReply_Accounts {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *AdminLedgerVerifyReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AdminLedgerVerifyReply) GetOpening() int64 {
+	if x != nil {
+		return x.Opening
+	}
+	return 0
+}
+
+type AdminRecommendAreaCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+}
+
+func (x *AdminRecommendAreaCheckRequest) Reset() {
+	*x = AdminRecommendAreaCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendAreaCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendAreaCheckRequest) ProtoMessage() {}
+
+func (x *AdminRecommendAreaCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendAreaCheckRequest.ProtoReflect.Descriptor instead.
+func (*AdminRecommendAreaCheckRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AdminRecommendAreaCheckRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type AdminRecommendAreaCheckReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  []*AdminRecommendAreaCheckReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64                                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Fixed bool                                 `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+}
+
+func (x *AdminRecommendAreaCheckReply) Reset() {
+	*x = AdminRecommendAreaCheckReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendAreaCheckReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendAreaCheckReply) ProtoMessage() {}
+
+func (x *AdminRecommendAreaCheckReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendAreaCheckReply.ProtoReflect.Descriptor instead.
+func (*AdminRecommendAreaCheckReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AdminRecommendAreaCheckReply) GetList() []*AdminRecommendAreaCheckReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminRecommendAreaCheckReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AdminRecommendAreaCheckReply) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+type AdminUserMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminUserMoveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminUserMoveRequest) Reset() {
+	*x = AdminUserMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserMoveRequest) ProtoMessage() {}
+
+func (x *AdminUserMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserMoveRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserMoveRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AdminUserMoveRequest) GetSendBody() *AdminUserMoveRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminUserMoveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminUserMoveReply) Reset() {
+	*x = AdminUserMoveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserMoveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserMoveReply) ProtoMessage() {}
+
+func (x *AdminUserMoveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserMoveReply.ProtoReflect.Descriptor instead.
+func (*AdminUserMoveReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AdminUserMoveReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminVipLevelListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminVipLevelListRequest) Reset() {
+	*x = AdminVipLevelListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelListRequest) ProtoMessage() {}
+
+func (x *AdminVipLevelListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelListRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{93}
+}
+
+type AdminVipLevelListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Levels []*AdminVipLevelListReply_List `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+}
+
+func (x *AdminVipLevelListReply) Reset() {
+	*x = AdminVipLevelListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelListReply) ProtoMessage() {}
+
+func (x *AdminVipLevelListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelListReply.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AdminVipLevelListReply) GetLevels() []*AdminVipLevelListReply_List {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type AdminVipLevelUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminVipLevelUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminVipLevelUpdateRequest) Reset() {
+	*x = AdminVipLevelUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelUpdateRequest) ProtoMessage() {}
+
+func (x *AdminVipLevelUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AdminVipLevelUpdateRequest) GetSendBody() *AdminVipLevelUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminVipLevelUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminVipLevelUpdateReply) Reset() {
+	*x = AdminVipLevelUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelUpdateReply) ProtoMessage() {}
+
+func (x *AdminVipLevelUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{96}
+}
+
+type AdminVipRecalcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminVipRecalcRequest) Reset() {
+	*x = AdminVipRecalcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipRecalcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipRecalcRequest) ProtoMessage() {}
+
+func (x *AdminVipRecalcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipRecalcRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipRecalcRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{97}
+}
+
+type AdminVipRecalcReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminVipRecalcReply) Reset() {
+	*x = AdminVipRecalcReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipRecalcReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipRecalcReply) ProtoMessage() {}
+
+func (x *AdminVipRecalcReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipRecalcReply.ProtoReflect.Descriptor instead.
+func (*AdminVipRecalcReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AdminVipRecalcReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminVipLogListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminVipLogListRequest) Reset() {
+	*x = AdminVipLogListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLogListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLogListRequest) ProtoMessage() {}
+
+func (x *AdminVipLogListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLogListRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipLogListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AdminVipLogListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminVipLogListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminVipLogListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs  []*AdminVipLogListReply_List `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Count int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminVipLogListReply) Reset() {
+	*x = AdminVipLogListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLogListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLogListReply) ProtoMessage() {}
+
+func (x *AdminVipLogListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLogListReply.ProtoReflect.Descriptor instead.
+func (*AdminVipLogListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AdminVipLogListReply) GetLogs() []*AdminVipLogListReply_List {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *AdminVipLogListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminDhbPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDhbPriceRequest) Reset() {
+	*x = AdminDhbPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDhbPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDhbPriceRequest) ProtoMessage() {}
+
+func (x *AdminDhbPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDhbPriceRequest.ProtoReflect.Descriptor instead.
+func (*AdminDhbPriceRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{101}
+}
+
+type AdminDhbPriceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price  string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *AdminDhbPriceReply) Reset() {
+	*x = AdminDhbPriceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDhbPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDhbPriceReply) ProtoMessage() {}
+
+func (x *AdminDhbPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDhbPriceReply.ProtoReflect.Descriptor instead.
+func (*AdminDhbPriceReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AdminDhbPriceReply) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *AdminDhbPriceReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type AdminDailyMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (x *AdminDailyMetricsRequest) Reset() {
+	*x = AdminDailyMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsRequest) ProtoMessage() {}
+
+func (x *AdminDailyMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AdminDailyMetricsRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+type AdminDailyMetricsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day  string                         `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	List []*AdminDailyMetricsReply_List `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *AdminDailyMetricsReply) Reset() {
+	*x = AdminDailyMetricsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsReply) ProtoMessage() {}
+
+func (x *AdminDailyMetricsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AdminDailyMetricsReply) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsReply) GetList() []*AdminDailyMetricsReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AdminDailyMetricsSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start       string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End         string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Granularity string `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AdminDailyMetricsSeriesRequest) Reset() {
+	*x = AdminDailyMetricsSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsSeriesRequest) ProtoMessage() {}
+
+func (x *AdminDailyMetricsSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsSeriesRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AdminDailyMetricsSeriesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AdminDailyMetricsSeriesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start       string                               `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End         string                               `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Granularity string                               `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	List        []*AdminDailyMetricsSeriesReply_List `protobuf:"bytes,4,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *AdminDailyMetricsSeriesReply) Reset() {
+	*x = AdminDailyMetricsSeriesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsSeriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsSeriesReply) ProtoMessage() {}
+
+func (x *AdminDailyMetricsSeriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsSeriesReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsSeriesReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AdminDailyMetricsSeriesReply) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesReply) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesReply) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesReply) GetList() []*AdminDailyMetricsSeriesReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AdminConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminConfigRequest) Reset() {
+	*x = AdminConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigRequest) ProtoMessage() {}
+
+func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AdminConfigRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminConfigRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminConfigReply) Reset() {
+	*x = AdminConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply) ProtoMessage() {}
+
+func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AdminConfigReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest) Reset() {
+	*x = AdminConfigUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminConfigUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminConfigUpdateReply) Reset() {
+	*x = AdminConfigUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateReply) ProtoMessage() {}
+
+func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{110}
+}
+
+type EthAuthorizeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAuthorizeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type UserInfoReply_Matrix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatrixId int64  `protobuf:"varint,1,opt,name=matrixId,proto3" json:"matrixId,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level    int64  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Row      int64  `protobuf:"varint,5,opt,name=row,proto3" json:"row,omitempty"`
+	Col      int64  `protobuf:"varint,6,opt,name=col,proto3" json:"col,omitempty"`
+	RowNum   int64  `protobuf:"varint,7,opt,name=rowNum,proto3" json:"rowNum,omitempty"`
+	ColNum   int64  `protobuf:"varint,8,opt,name=colNum,proto3" json:"colNum,omitempty"`
+}
+
+func (x *UserInfoReply_Matrix) Reset() {
+	*x = UserInfoReply_Matrix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_Matrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_Matrix) ProtoMessage() {}
+
+func (x *UserInfoReply_Matrix) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_Matrix.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_Matrix) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *UserInfoReply_Matrix) GetMatrixId() int64 {
+	if x != nil {
+		return x.MatrixId
+	}
+	return 0
+}
+
+func (x *UserInfoReply_Matrix) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserInfoReply_Matrix) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UserInfoReply_Matrix) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserInfoReply_Matrix) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *UserInfoReply_Matrix) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *UserInfoReply_Matrix) GetRowNum() int64 {
+	if x != nil {
+		return x.RowNum
+	}
+	return 0
+}
+
+func (x *UserInfoReply_Matrix) GetColNum() int64 {
+	if x != nil {
+		return x.ColNum
+	}
+	return 0
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MyLocationListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MatrixId           int64  `protobuf:"varint,2,opt,name=matrixId,proto3" json:"matrixId,omitempty"`
+	Level              int64  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Status             string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt          string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StopDate           string `protobuf:"bytes,6,opt,name=stopDate,proto3" json:"stopDate,omitempty"`
+	Current            string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax         string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	Progress           string `protobuf:"bytes,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	Row                int64  `protobuf:"varint,10,opt,name=row,proto3" json:"row,omitempty"`
+	Col                int64  `protobuf:"varint,11,opt,name=col,proto3" json:"col,omitempty"`
+	RowReward          string `protobuf:"bytes,12,opt,name=rowReward,proto3" json:"rowReward,omitempty"`
+	ColReward          string `protobuf:"bytes,13,opt,name=colReward,proto3" json:"colReward,omitempty"`
+	RecommendReward    string `protobuf:"bytes,14,opt,name=recommendReward,proto3" json:"recommendReward,omitempty"`
+	RecommendVipReward string `protobuf:"bytes,15,opt,name=recommendVipReward,proto3" json:"recommendVipReward,omitempty"`
+	FeeReward          string `protobuf:"bytes,16,opt,name=feeReward,proto3" json:"feeReward,omitempty"`
+	Total              string `protobuf:"bytes,17,opt,name=total,proto3" json:"total,omitempty"`
+	Source             string `protobuf:"bytes,18,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *MyLocationListReply_List) Reset() {
+	*x = MyLocationListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MyLocationListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyLocationListReply_List) ProtoMessage() {}
+
+func (x *MyLocationListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyLocationListReply_List.ProtoReflect.Descriptor instead.
+func (*MyLocationListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *MyLocationListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MyLocationListReply_List) GetMatrixId() int64 {
+	if x != nil {
+		return x.MatrixId
+	}
+	return 0
+}
+
+func (x *MyLocationListReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *MyLocationListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetStopDate() string {
+	if x != nil {
+		return x.StopDate
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *MyLocationListReply_List) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *MyLocationListReply_List) GetRowReward() string {
+	if x != nil {
+		return x.RowReward
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetColReward() string {
+	if x != nil {
+		return x.ColReward
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetRecommendReward() string {
+	if x != nil {
+		return x.RecommendReward
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetRecommendVipReward() string {
+	if x != nil {
+		return x.RecommendVipReward
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetFeeReward() string {
+	if x != nil {
+		return x.FeeReward
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *MyLocationListReply_List) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type LocationNeighbourReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Level    int64  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Progress string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Row      int64  `protobuf:"varint,5,opt,name=row,proto3" json:"row,omitempty"`
+	Col      int64  `protobuf:"varint,6,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (x *LocationNeighbourReply_List) Reset() {
+	*x = LocationNeighbourReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationNeighbourReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationNeighbourReply_List) ProtoMessage() {}
+
+func (x *LocationNeighbourReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationNeighbourReply_List.ProtoReflect.Descriptor instead.
+func (*LocationNeighbourReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *LocationNeighbourReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LocationNeighbourReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply_List) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *LocationNeighbourReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LocationNeighbourReply_List) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *LocationNeighbourReply_List) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RecommendTreeReply_Depth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Depth     int64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	Num       int64 `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	ActiveNum int64 `protobuf:"varint,3,opt,name=active_num,json=activeNum,proto3" json:"active_num,omitempty"`
+}
+
+func (x *RecommendTreeReply_Depth) Reset() {
+	*x = RecommendTreeReply_Depth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTreeReply_Depth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTreeReply_Depth) ProtoMessage() {}
+
+func (x *RecommendTreeReply_Depth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTreeReply_Depth.ProtoReflect.Descriptor instead.
+func (*RecommendTreeReply_Depth) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *RecommendTreeReply_Depth) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply_Depth) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply_Depth) GetActiveNum() int64 {
+	if x != nil {
+		return x.ActiveNum
+	}
+	return 0
+}
+
+type RecommendTreeReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TeamNum    int64  `protobuf:"varint,5,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	ActiveNum  int64  `protobuf:"varint,6,opt,name=active_num,json=activeNum,proto3" json:"active_num,omitempty"`
+	TeamAmount string `protobuf:"bytes,7,opt,name=team_amount,json=teamAmount,proto3" json:"team_amount,omitempty"`
+	CreatedAt  string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *RecommendTreeReply_List) Reset() {
+	*x = RecommendTreeReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendTreeReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendTreeReply_List) ProtoMessage() {}
+
+func (x *RecommendTreeReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendTreeReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendTreeReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *RecommendTreeReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply_List) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply_List) GetActiveNum() int64 {
+	if x != nil {
+		return x.ActiveNum
+	}
+	return 0
+}
+
+func (x *RecommendTreeReply_List) GetTeamAmount() string {
+	if x != nil {
+		return x.TeamAmount
+	}
+	return ""
+}
+
+func (x *RecommendTreeReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type TransferRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *TransferRequest_SendBody) Reset() {
+	*x = TransferRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest_SendBody) ProtoMessage() {}
+
+func (x *TransferRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*TransferRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *TransferRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TransferRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ConvertRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ConvertRequest_SendBody) Reset() {
+	*x = ConvertRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest_SendBody) ProtoMessage() {}
+
+func (x *ConvertRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*ConvertRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ConvertRequest_SendBody) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ConvertRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ReinvestRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *ReinvestRequest_SendBody) Reset() {
+	*x = ReinvestRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinvestRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinvestRequest_SendBody) ProtoMessage() {}
+
+func (x *ReinvestRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinvestRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*ReinvestRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *ReinvestRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type SetAutoReinvestRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetAutoReinvestRequest_SendBody) Reset() {
+	*x = SetAutoReinvestRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoReinvestRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoReinvestRequest_SendBody) ProtoMessage() {}
+
+func (x *SetAutoReinvestRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoReinvestRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*SetAutoReinvestRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *SetAutoReinvestRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type UpgradeLocationRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *UpgradeLocationRequest_SendBody) Reset() {
+	*x = UpgradeLocationRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeLocationRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeLocationRequest_SendBody) ProtoMessage() {}
+
+func (x *UpgradeLocationRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeLocationRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*UpgradeLocationRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *UpgradeLocationRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type ReserveProofReply_Proof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash     string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Position string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *ReserveProofReply_Proof) Reset() {
+	*x = ReserveProofReply_Proof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveProofReply_Proof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveProofReply_Proof) ProtoMessage() {}
+
+func (x *ReserveProofReply_Proof) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveProofReply_Proof.ProtoReflect.Descriptor instead.
+func (*ReserveProofReply_Proof) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *ReserveProofReply_Proof) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ReserveProofReply_Proof) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type StatementReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CoinType  string `protobuf:"bytes,4,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance   string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Ref       string `protobuf:"bytes,8,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *StatementReply_List) Reset() {
+	*x = StatementReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementReply_List) ProtoMessage() {}
+
+func (x *StatementReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementReply_List.ProtoReflect.Descriptor instead.
+func (*StatementReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *StatementReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatementReply_List) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
+	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+	TeamNum          int64  `protobuf:"varint,9,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	TeamActiveNum    int64  `protobuf:"varint,10,opt,name=team_active_num,json=teamActiveNum,proto3" json:"team_active_num,omitempty"`
+	TeamAmount       string `protobuf:"bytes,11,opt,name=team_amount,json=teamAmount,proto3" json:"team_amount,omitempty"`
+	AreaAmount       string `protobuf:"bytes,12,opt,name=area_amount,json=areaAmount,proto3" json:"area_amount,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
+	if x != nil {
+		return x.MonthRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetTeamNum() int64 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetTeamActiveNum() int64 {
+	if x != nil {
+		return x.TeamActiveNum
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetTeamAmount() string {
+	if x != nil {
+		return x.TeamAmount
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return ""
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	MatrixId     int64  `protobuf:"varint,9,opt,name=matrixId,proto3" json:"matrixId,omitempty"`
+	Id           int64  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetMatrixId() int64 {
+	if x != nil {
+		return x.MatrixId
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminLocationEventListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type          string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	BeforeStatus  string `protobuf:"bytes,4,opt,name=beforeStatus,proto3" json:"beforeStatus,omitempty"`
+	AfterStatus   string `protobuf:"bytes,5,opt,name=afterStatus,proto3" json:"afterStatus,omitempty"`
+	BeforeCurrent string `protobuf:"bytes,6,opt,name=beforeCurrent,proto3" json:"beforeCurrent,omitempty"`
+	AfterCurrent  string `protobuf:"bytes,7,opt,name=afterCurrent,proto3" json:"afterCurrent,omitempty"`
+	BeforeRow     int64  `protobuf:"varint,8,opt,name=beforeRow,proto3" json:"beforeRow,omitempty"`
+	AfterRow      int64  `protobuf:"varint,9,opt,name=afterRow,proto3" json:"afterRow,omitempty"`
+	BeforeCol     int64  `protobuf:"varint,10,opt,name=beforeCol,proto3" json:"beforeCol,omitempty"`
+	AfterCol      int64  `protobuf:"varint,11,opt,name=afterCol,proto3" json:"afterCol,omitempty"`
+	StopDate      string `protobuf:"bytes,12,opt,name=stopDate,proto3" json:"stopDate,omitempty"`
+	TriggerType   string `protobuf:"bytes,13,opt,name=triggerType,proto3" json:"triggerType,omitempty"`
+	TriggerRef    string `protobuf:"bytes,14,opt,name=triggerRef,proto3" json:"triggerRef,omitempty"`
+	CorrelationId string `protobuf:"bytes,15,opt,name=correlationId,proto3" json:"correlationId,omitempty"`
+	BeforeLevel   int64  `protobuf:"varint,16,opt,name=beforeLevel,proto3" json:"beforeLevel,omitempty"`
+	AfterLevel    int64  `protobuf:"varint,17,opt,name=afterLevel,proto3" json:"afterLevel,omitempty"`
+	BeforeMax     string `protobuf:"bytes,18,opt,name=beforeMax,proto3" json:"beforeMax,omitempty"`
+	AfterMax      string `protobuf:"bytes,19,opt,name=afterMax,proto3" json:"afterMax,omitempty"`
+}
+
+func (x *AdminLocationEventListReply_List) Reset() {
+	*x = AdminLocationEventListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationEventListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationEventListReply_List) ProtoMessage() {}
+
+func (x *AdminLocationEventListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationEventListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLocationEventListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *AdminLocationEventListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeStatus() string {
+	if x != nil {
+		return x.BeforeStatus
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterStatus() string {
+	if x != nil {
+		return x.AfterStatus
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeCurrent() string {
+	if x != nil {
+		return x.BeforeCurrent
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterCurrent() string {
+	if x != nil {
+		return x.AfterCurrent
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeRow() int64 {
+	if x != nil {
+		return x.BeforeRow
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterRow() int64 {
+	if x != nil {
+		return x.AfterRow
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeCol() int64 {
+	if x != nil {
+		return x.BeforeCol
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterCol() int64 {
+	if x != nil {
+		return x.AfterCol
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetStopDate() string {
+	if x != nil {
+		return x.StopDate
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetTriggerRef() string {
+	if x != nil {
+		return x.TriggerRef
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeLevel() int64 {
+	if x != nil {
+		return x.BeforeLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterLevel() int64 {
+	if x != nil {
+		return x.AfterLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationEventListReply_List) GetBeforeMax() string {
+	if x != nil {
+		return x.BeforeMax
+	}
+	return ""
+}
+
+func (x *AdminLocationEventListReply_List) GetAfterMax() string {
+	if x != nil {
+		return x.AfterMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminBalanceAdjustRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	CoinType  string `protobuf:"bytes,3,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Ticket    string `protobuf:"bytes,6,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) Reset() {
+	*x = AdminBalanceAdjustRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustRequest_SendBody) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type AdminBalanceAdjustApproveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool  `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *AdminBalanceAdjustApproveRequest_SendBody) Reset() {
+	*x = AdminBalanceAdjustApproveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustApproveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustApproveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustApproveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustApproveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustApproveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *AdminBalanceAdjustApproveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminBalanceAdjustApproveRequest_SendBody) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type AdminBalanceAdjustListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Direction  string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	CoinType   string `protobuf:"bytes,4,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Amount     string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason     string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Ticket     string `protobuf:"bytes,7,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Status     string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy  int64  `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ApprovedBy int64  `protobuf:"varint,10,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	CreatedAt  string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminBalanceAdjustListReply_List) Reset() {
+	*x = AdminBalanceAdjustListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceAdjustListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceAdjustListReply_List) ProtoMessage() {}
+
+func (x *AdminBalanceAdjustListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceAdjustListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminBalanceAdjustListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetApprovedBy() int64 {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *AdminBalanceAdjustListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ReserveSnapshot_Wallets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *ReserveSnapshot_Wallets) Reset() {
+	*x = ReserveSnapshot_Wallets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveSnapshot_Wallets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSnapshot_Wallets) ProtoMessage() {}
+
+func (x *ReserveSnapshot_Wallets) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSnapshot_Wallets.ProtoReflect.Descriptor instead.
+func (*ReserveSnapshot_Wallets) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *ReserveSnapshot_Wallets) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReserveSnapshot_Wallets) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type AdminDepositReverseRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminDepositReverseRequest_SendBody) Reset() {
+	*x = AdminDepositReverseRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositReverseRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositReverseRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminDepositReverseRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositReverseRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminDepositReverseRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *AdminDepositReverseRequest_SendBody) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AdminDepositReverseRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminReserveMerkleReply_Leaves struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Leaf    string `protobuf:"bytes,4,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Nonce   string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *AdminReserveMerkleReply_Leaves) Reset() {
+	*x = AdminReserveMerkleReply_Leaves{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReserveMerkleReply_Leaves) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReserveMerkleReply_Leaves) ProtoMessage() {}
+
+func (x *AdminReserveMerkleReply_Leaves) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReserveMerkleReply_Leaves.ProtoReflect.Descriptor instead.
+func (*AdminReserveMerkleReply_Leaves) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{80, 0}
+}
+
+func (x *AdminReserveMerkleReply_Leaves) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminReserveMerkleReply_Leaves) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminReserveMerkleReply_Leaves) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AdminReserveMerkleReply_Leaves) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *AdminReserveMerkleReply_Leaves) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type AdminReconcileReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Day      string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expected string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Num      int64  `protobuf:"varint,6,opt,name=num,proto3" json:"num,omitempty"`
+	Alert    int64  `protobuf:"varint,7,opt,name=alert,proto3" json:"alert,omitempty"`
+}
+
+func (x *AdminReconcileReply_List) Reset() {
+	*x = AdminReconcileReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileReply_List) ProtoMessage() {}
+
+func (x *AdminReconcileReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileReply_List.ProtoReflect.Descriptor instead.
+func (*AdminReconcileReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *AdminReconcileReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminReconcileReply_List) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AdminReconcileReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminReconcileReply_List) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *AdminReconcileReply_List) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *AdminReconcileReply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *AdminReconcileReply_List) GetAlert() int64 {
+	if x != nil {
+		return x.Alert
+	}
+	return 0
+}
+
+type AdminReconcileListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Day       string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expected  string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual    string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Num       int64  `protobuf:"varint,6,opt,name=num,proto3" json:"num,omitempty"`
+	Alert     int64  `protobuf:"varint,7,opt,name=alert,proto3" json:"alert,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminReconcileListReply_List) Reset() {
+	*x = AdminReconcileListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileListReply_List) ProtoMessage() {}
+
+func (x *AdminReconcileListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminReconcileListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *AdminReconcileListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminReconcileListReply_List) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AdminReconcileListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminReconcileListReply_List) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *AdminReconcileListReply_List) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *AdminReconcileListReply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *AdminReconcileListReply_List) GetAlert() int64 {
+	if x != nil {
+		return x.Alert
+	}
+	return 0
+}
+
+func (x *AdminReconcileListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminReconcileDetailReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RecordId int64  `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Expected string `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Reason   string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminReconcileDetailReply_List) Reset() {
+	*x = AdminReconcileDetailReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileDetailReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileDetailReply_List) ProtoMessage() {}
+
+func (x *AdminReconcileDetailReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileDetailReply_List.ProtoReflect.Descriptor instead.
+func (*AdminReconcileDetailReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{86, 0}
+}
+
+func (x *AdminReconcileDetailReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminReconcileDetailReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminReconcileDetailReply_List) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *AdminReconcileDetailReply_List) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *AdminReconcileDetailReply_List) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *AdminReconcileDetailReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminLedgerVerifyReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId            int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BalanceUsdt       string `protobuf:"bytes,2,opt,name=balance_usdt,json=balanceUsdt,proto3" json:"balance_usdt,omitempty"`
+	LedgerBalanceUsdt string `protobuf:"bytes,3,opt,name=ledger_balance_usdt,json=ledgerBalanceUsdt,proto3" json:"ledger_balance_usdt,omitempty"`
+	BalanceDhb        string `protobuf:"bytes,4,opt,name=balance_dhb,json=balanceDhb,proto3" json:"balance_dhb,omitempty"`
+	LedgerBalanceDhb  string `protobuf:"bytes,5,opt,name=ledger_balance_dhb,json=ledgerBalanceDhb,proto3" json:"ledger_balance_dhb,omitempty"`
+}
+
+func (x *AdminLedgerVerifyReply_List) Reset() {
+	*x = AdminLedgerVerifyReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerVerifyReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerVerifyReply_List) ProtoMessage() {}
+
+func (x *AdminLedgerVerifyReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerVerifyReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (x *AdminLedgerVerifyReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminLedgerVerifyReply_List) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_List) GetLedgerBalanceUsdt() string {
+	if x != nil {
+		return x.LedgerBalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_List) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_List) GetLedgerBalanceDhb() string {
+	if x != nil {
+		return x.LedgerBalanceDhb
+	}
+	return ""
+}
+
+type AdminLedgerVerifyReply_Accounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	CoinType string `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Balance  string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *AdminLedgerVerifyReply_Accounts) Reset() {
+	*x = AdminLedgerVerifyReply_Accounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerVerifyReply_Accounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerVerifyReply_Accounts) ProtoMessage() {}
+
+func (x *AdminLedgerVerifyReply_Accounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerVerifyReply_Accounts.ProtoReflect.Descriptor instead.
+func (*AdminLedgerVerifyReply_Accounts) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{88, 1}
+}
+
+func (x *AdminLedgerVerifyReply_Accounts) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_Accounts) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminLedgerVerifyReply_Accounts) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type AdminRecommendAreaCheckReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecommendCode string `protobuf:"bytes,1,opt,name=recommend_code,json=recommendCode,proto3" json:"recommend_code,omitempty"`
+	Num           int64  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	ExpectNum     int64  `protobuf:"varint,3,opt,name=expect_num,json=expectNum,proto3" json:"expect_num,omitempty"`
+	Type          string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *AdminRecommendAreaCheckReply_List) Reset() {
+	*x = AdminRecommendAreaCheckReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendAreaCheckReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendAreaCheckReply_List) ProtoMessage() {}
+
+func (x *AdminRecommendAreaCheckReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendAreaCheckReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRecommendAreaCheckReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{90, 0}
+}
+
+func (x *AdminRecommendAreaCheckReply_List) GetRecommendCode() string {
+	if x != nil {
+		return x.RecommendCode
+	}
+	return ""
+}
+
+func (x *AdminRecommendAreaCheckReply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *AdminRecommendAreaCheckReply_List) GetExpectNum() int64 {
+	if x != nil {
+		return x.ExpectNum
+	}
+	return 0
+}
+
+func (x *AdminRecommendAreaCheckReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type AdminUserMoveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecommendUserId int64  `protobuf:"varint,2,opt,name=recommend_user_id,json=recommendUserId,proto3" json:"recommend_user_id,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Recompute       bool   `protobuf:"varint,4,opt,name=recompute,proto3" json:"recompute,omitempty"`
+}
+
+func (x *AdminUserMoveRequest_SendBody) Reset() {
+	*x = AdminUserMoveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserMoveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserMoveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUserMoveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserMoveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUserMoveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *AdminUserMoveRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserMoveRequest_SendBody) GetRecommendUserId() int64 {
+	if x != nil {
+		return x.RecommendUserId
+	}
+	return 0
+}
+
+func (x *AdminUserMoveRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminUserMoveRequest_SendBody) GetRecompute() bool {
+	if x != nil {
+		return x.Recompute
+	}
+	return false
+}
+
+type AdminVipLevelListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Level         int64  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	NeedRecommend int64  `protobuf:"varint,3,opt,name=need_recommend,json=needRecommend,proto3" json:"need_recommend,omitempty"`
+	NeedTeam      string `protobuf:"bytes,4,opt,name=need_team,json=needTeam,proto3" json:"need_team,omitempty"`
+	NeedArea      string `protobuf:"bytes,5,opt,name=need_area,json=needArea,proto3" json:"need_area,omitempty"`
+	Rate          int64  `protobuf:"varint,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	ExpireDays    int64  `protobuf:"varint,7,opt,name=expire_days,json=expireDays,proto3" json:"expire_days,omitempty"`
+}
+
+func (x *AdminVipLevelListReply_List) Reset() {
+	*x = AdminVipLevelListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelListReply_List) ProtoMessage() {}
+
+func (x *AdminVipLevelListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{94, 0}
+}
+
+func (x *AdminVipLevelListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminVipLevelListReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminVipLevelListReply_List) GetNeedRecommend() int64 {
+	if x != nil {
+		return x.NeedRecommend
+	}
+	return 0
+}
+
+func (x *AdminVipLevelListReply_List) GetNeedTeam() string {
+	if x != nil {
+		return x.NeedTeam
+	}
+	return ""
+}
+
+func (x *AdminVipLevelListReply_List) GetNeedArea() string {
+	if x != nil {
+		return x.NeedArea
+	}
+	return ""
+}
+
+func (x *AdminVipLevelListReply_List) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *AdminVipLevelListReply_List) GetExpireDays() int64 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+type AdminVipLevelUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level         int64  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	NeedRecommend int64  `protobuf:"varint,2,opt,name=need_recommend,json=needRecommend,proto3" json:"need_recommend,omitempty"`
+	NeedTeam      string `protobuf:"bytes,3,opt,name=need_team,json=needTeam,proto3" json:"need_team,omitempty"`
+	NeedArea      string `protobuf:"bytes,4,opt,name=need_area,json=needArea,proto3" json:"need_area,omitempty"`
+	Rate          int64  `protobuf:"varint,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	ExpireDays    int64  `protobuf:"varint,6,opt,name=expire_days,json=expireDays,proto3" json:"expire_days,omitempty"`
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) Reset() {
+	*x = AdminVipLevelUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLevelUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLevelUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminVipLevelUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{95, 0}
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetNeedRecommend() int64 {
+	if x != nil {
+		return x.NeedRecommend
+	}
+	return 0
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetNeedTeam() string {
+	if x != nil {
+		return x.NeedTeam
+	}
+	return ""
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetNeedArea() string {
+	if x != nil {
+		return x.NeedArea
+	}
+	return ""
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *AdminVipLevelUpdateRequest_SendBody) GetExpireDays() int64 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+type AdminVipLogListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BeforeVip int64  `protobuf:"varint,3,opt,name=before_vip,json=beforeVip,proto3" json:"before_vip,omitempty"`
+	AfterVip  int64  `protobuf:"varint,4,opt,name=after_vip,json=afterVip,proto3" json:"after_vip,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminVipLogListReply_List) Reset() {
+	*x = AdminVipLogListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipLogListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipLogListReply_List) ProtoMessage() {}
+
+func (x *AdminVipLogListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipLogListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminVipLogListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *AdminVipLogListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminVipLogListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminVipLogListReply_List) GetBeforeVip() int64 {
+	if x != nil {
+		return x.BeforeVip
+	}
+	return 0
+}
+
+func (x *AdminVipLogListReply_List) GetAfterVip() int64 {
+	if x != nil {
+		return x.AfterVip
+	}
+	return 0
+}
+
+func (x *AdminVipLogListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminVipLogListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminDailyMetricsReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Num    int64  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminDailyMetricsReply_List) Reset() {
+	*x = AdminDailyMetricsReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsReply_List) ProtoMessage() {}
+
+func (x *AdminDailyMetricsReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{104, 0}
+}
+
+func (x *AdminDailyMetricsReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsReply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *AdminDailyMetricsReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminDailyMetricsSeriesReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Num    int64  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) Reset() {
+	*x = AdminDailyMetricsSeriesReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyMetricsSeriesReply_List) ProtoMessage() {}
+
+func (x *AdminDailyMetricsSeriesReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDailyMetricsSeriesReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDailyMetricsSeriesReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *AdminDailyMetricsSeriesReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{109, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_app_proto protoreflect.FileDescriptor
+
+var file_api_app_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13,
+	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a,
+	0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc1, 0x09,
+	0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68,
+	0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f,
+	0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x63, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x33, 0x44, 0x68, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x68, 0x62, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c,
+	0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x6e, 0x64,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x1a, 0xba, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
+	0x4e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x4e, 0x75,
+	0x6d, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c,
+	0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a,
+	0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xcc, 0x04, 0x0a, 0x13, 0x4d, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xf7, 0x03, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x70,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f,
+	0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x56, 0x69, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x70, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x81, 0x03, 0x0a,
+	0x16, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x75, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x6f, 0x77, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x73, 0x1a,
+	0x8e, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c,
+	0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x57, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xdf,
+	0x04, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x65, 0x70, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x52, 0x06, 0x64, 0x65, 0x70, 0x74, 0x68, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x4e, 0x0a, 0x05, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x75, 0x6d, 0x1a, 0xe3, 0x01, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3c, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x20, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x20, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2e, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a,
+	0x16, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x20, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2e, 0x0a, 0x14,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x15, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xd5, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x1a, 0x37, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x10, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xbb, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x64, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x55,
+	0x73, 0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x68, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x44, 0x68, 0x62, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x75, 0x73, 0x64, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73,
+	0x69, 0x6e, 0x67, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x44, 0x68, 0x62, 0x1a, 0xca, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x78, 0x69, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x11,
+	0x45, 0x78, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x46, 0x0a, 0x16, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xee, 0x03, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x89, 0x03,
+	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10,
+	0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70,
+	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
+	0x61, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x65, 0x61,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x8d, 0x02, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x1d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd8, 0x06,
+	0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0xcd, 0x04, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x6f,
+	0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52,
+	0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x70,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x66, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
+	0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a,
+	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17,
+	0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
+	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9,
+	0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x66,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x1f, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0xa7, 0x01, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x20, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x34, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x22, 0x38, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x1d, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xaa, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x1a, 0x3d, 0x0a, 0x07, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbf,
+	0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4d, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x2d,
+	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a,
+	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x1a,
+	0x7f, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0x29, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0xe3, 0x01, 0x0a, 0x13,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x98, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x22, 0x41, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb7, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e,
+	0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xa2, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x69,
+	0x78, 0x22, 0xe1, 0x03, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x1a, 0xc1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x75, 0x73, 0x64, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x68, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x1a, 0x5b, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x32, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x69, 0x78, 0x22, 0xfa, 0x01, 0x0a, 0x1c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65,
+	0x61, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x1a, 0x72, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x4e,
+	0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x85, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x97, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x1a, 0xc2, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x65, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x65, 0x65, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x65, 0x64,
+	0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x65,
+	0x64, 0x41, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x1a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0xb6, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x65, 0x64, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x65, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x65, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x65, 0x64,
+	0x41, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69,
+	0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xa3, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x56, 0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x56, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x68, 0x62, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x68, 0x62,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2c, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x44, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x7e, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x82, 0x02, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x5c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xab, 0x2a, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a,
+	0x0c, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74,
+	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
+	0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x70, 0x0a, 0x0e, 0x4d, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x6d, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x7b, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x12,
+	0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x61, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x5d, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x3a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x61, 0x0a, 0x08, 0x52, 0x65, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x3a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7f, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x21, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73,
+	0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7e, 0x0a, 0x0f, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x20,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x67, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5a, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x6a, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x78, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x51, 0x0a, 0x07,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x16, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x6c, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x84, 0x01,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0xa1, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x8a, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x12, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x12, 0x68, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x79, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x75, 0x0a, 0x11, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x70, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x76, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69,
+	0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x76,
+	0x69, 0x70, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x89, 0x01,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x1f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x76, 0x69,
+	0x70, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x76, 0x69, 0x70, 0x5f, 0x72, 0x65,
+	0x63, 0x61, 0x6c, 0x63, 0x12, 0x6e, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x56, 0x69, 0x70, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x76, 0x69, 0x70, 0x5f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x68, 0x62,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x68, 0x62, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x68, 0x62, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x64, 0x68, 0x62, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61,
+	0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_app_proto_rawDescOnce sync.Once
+	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
+)
+
+func file_api_app_proto_rawDescGZIP() []byte {
+	file_api_app_proto_rawDescOnce.Do(func() {
+		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
+	})
+	return file_api_app_proto_rawDescData
+}
+
+var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 157)
+var file_api_app_proto_goTypes = []interface{}{
+	(*EthAuthorizeRequest)(nil),                       // 0: api.EthAuthorizeRequest
+	(*EthAuthorizeReply)(nil),                         // 1: api.EthAuthorizeReply
+	(*DepositRequest)(nil),                            // 2: api.DepositRequest
+	(*DepositReply)(nil),                              // 3: api.DepositReply
+	(*UserInfoRequest)(nil),                           // 4: api.UserInfoRequest
+	(*UserInfoReply)(nil),                             // 5: api.UserInfoReply
+	(*RewardListRequest)(nil),                         // 6: api.RewardListRequest
+	(*RewardListReply)(nil),                           // 7: api.RewardListReply
+	(*RecommendRewardListRequest)(nil),                // 8: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),                  // 9: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                      // 10: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                        // 11: api.FeeRewardListReply
+	(*MyLocationListRequest)(nil),                     // 12: api.MyLocationListRequest
+	(*MyLocationListReply)(nil),                       // 13: api.MyLocationListReply
+	(*LocationNeighbourRequest)(nil),                  // 14: api.LocationNeighbourRequest
+	(*LocationNeighbourReply)(nil),                    // 15: api.LocationNeighbourReply
+	(*WithdrawListRequest)(nil),                       // 16: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                         // 17: api.WithdrawListReply
+	(*RecommendTreeRequest)(nil),                      // 18: api.RecommendTreeRequest
+	(*RecommendTreeReply)(nil),                        // 19: api.RecommendTreeReply
+	(*RecommendListRequest)(nil),                      // 20: api.RecommendListRequest
+	(*RecommendListReply)(nil),                        // 21: api.RecommendListReply
+	(*WithdrawRequest)(nil),                           // 22: api.WithdrawRequest
+	(*WithdrawReply)(nil),                             // 23: api.WithdrawReply
+	(*TransferRequest)(nil),                           // 24: api.TransferRequest
+	(*TransferReply)(nil),                             // 25: api.TransferReply
+	(*ConvertRequest)(nil),                            // 26: api.ConvertRequest
+	(*ConvertReply)(nil),                              // 27: api.ConvertReply
+	(*ReinvestRequest)(nil),                           // 28: api.ReinvestRequest
+	(*ReinvestReply)(nil),                             // 29: api.ReinvestReply
+	(*SetAutoReinvestRequest)(nil),                    // 30: api.SetAutoReinvestRequest
+	(*SetAutoReinvestReply)(nil),                      // 31: api.SetAutoReinvestReply
+	(*UpgradeLocationRequest)(nil),                    // 32: api.UpgradeLocationRequest
+	(*UpgradeLocationReply)(nil),                      // 33: api.UpgradeLocationReply
+	(*ReserveProofRequest)(nil),                       // 34: api.ReserveProofRequest
+	(*ReserveProofReply)(nil),                         // 35: api.ReserveProofReply
+	(*StatementRequest)(nil),                          // 36: api.StatementRequest
+	(*StatementReply)(nil),                            // 37: api.StatementReply
+	(*ExitLocationRequest)(nil),                       // 38: api.ExitLocationRequest
+	(*ExitLocationReply)(nil),                         // 39: api.ExitLocationReply
+	(*AdminRewardListRequest)(nil),                    // 40: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                      // 41: api.AdminRewardListReply
+	(*AdminUserListRequest)(nil),                      // 42: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                        // 43: api.AdminUserListReply
+	(*AdminLocationListRequest)(nil),                  // 44: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                    // 45: api.AdminLocationListReply
+	(*AdminLocationEventListRequest)(nil),             // 46: api.AdminLocationEventListRequest
+	(*AdminLocationEventListReply)(nil),               // 47: api.AdminLocationEventListReply
+	(*AdminWithdrawListRequest)(nil),                  // 48: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                    // 49: api.AdminWithdrawListReply
+	(*AdminWithdrawRequest)(nil),                      // 50: api.AdminWithdrawRequest
+	(*AdminWithdrawReply)(nil),                        // 51: api.AdminWithdrawReply
+	(*AdminWithdrawEthRequest)(nil),                   // 52: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                     // 53: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                           // 54: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                             // 55: api.AdminFeeReply
+	(*AdminAllRequest)(nil),                           // 56: api.AdminAllRequest
+	(*AdminAllReply)(nil),                             // 57: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),                 // 58: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),                   // 59: api.AdminUserRecommendReply
+	(*AdminMonthRecommendRequest)(nil),                // 60: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),                  // 61: api.AdminMonthRecommendReply
+	(*AdminRecommendTreeBackfillRequest)(nil),         // 62: api.AdminRecommendTreeBackfillRequest
+	(*AdminRecommendTreeBackfillReply)(nil),           // 63: api.AdminRecommendTreeBackfillReply
+	(*AdminTeamStatsRebuildRequest)(nil),              // 64: api.AdminTeamStatsRebuildRequest
+	(*AdminTeamStatsRebuildReply)(nil),                // 65: api.AdminTeamStatsRebuildReply
+	(*AdminBalanceAdjustRequest)(nil),                 // 66: api.AdminBalanceAdjustRequest
+	(*AdminBalanceAdjustReply)(nil),                   // 67: api.AdminBalanceAdjustReply
+	(*AdminBalanceAdjustApproveRequest)(nil),          // 68: api.AdminBalanceAdjustApproveRequest
+	(*AdminBalanceAdjustApproveReply)(nil),            // 69: api.AdminBalanceAdjustApproveReply
+	(*AdminBalanceAdjustListRequest)(nil),             // 70: api.AdminBalanceAdjustListRequest
+	(*AdminBalanceAdjustListReply)(nil),               // 71: api.AdminBalanceAdjustListReply
+	(*ReserveSnapshot)(nil),                           // 72: api.ReserveSnapshot
+	(*AdminDepositReverseRequest)(nil),                // 73: api.AdminDepositReverseRequest
+	(*AdminDepositReverseReply)(nil),                  // 74: api.AdminDepositReverseReply
+	(*AdminReserveSnapshotRequest)(nil),               // 75: api.AdminReserveSnapshotRequest
+	(*AdminReserveSnapshotReply)(nil),                 // 76: api.AdminReserveSnapshotReply
+	(*AdminReserveListRequest)(nil),                   // 77: api.AdminReserveListRequest
+	(*AdminReserveListReply)(nil),                     // 78: api.AdminReserveListReply
+	(*AdminReserveMerkleRequest)(nil),                 // 79: api.AdminReserveMerkleRequest
+	(*AdminReserveMerkleReply)(nil),                   // 80: api.AdminReserveMerkleReply
+	(*AdminReconcileRequest)(nil),                     // 81: api.AdminReconcileRequest
+	(*AdminReconcileReply)(nil),                       // 82: api.AdminReconcileReply
+	(*AdminReconcileListRequest)(nil),                 // 83: api.AdminReconcileListRequest
+	(*AdminReconcileListReply)(nil),                   // 84: api.AdminReconcileListReply
+	(*AdminReconcileDetailRequest)(nil),               // 85: api.AdminReconcileDetailRequest
+	(*AdminReconcileDetailReply)(nil),                 // 86: api.AdminReconcileDetailReply
+	(*AdminLedgerVerifyRequest)(nil),                  // 87: api.AdminLedgerVerifyRequest
+	(*AdminLedgerVerifyReply)(nil),                    // 88: api.AdminLedgerVerifyReply
+	(*AdminRecommendAreaCheckRequest)(nil),            // 89: api.AdminRecommendAreaCheckRequest
+	(*AdminRecommendAreaCheckReply)(nil),              // 90: api.AdminRecommendAreaCheckReply
+	(*AdminUserMoveRequest)(nil),                      // 91: api.AdminUserMoveRequest
+	(*AdminUserMoveReply)(nil),                        // 92: api.AdminUserMoveReply
+	(*AdminVipLevelListRequest)(nil),                  // 93: api.AdminVipLevelListRequest
+	(*AdminVipLevelListReply)(nil),                    // 94: api.AdminVipLevelListReply
+	(*AdminVipLevelUpdateRequest)(nil),                // 95: api.AdminVipLevelUpdateRequest
+	(*AdminVipLevelUpdateReply)(nil),                  // 96: api.AdminVipLevelUpdateReply
+	(*AdminVipRecalcRequest)(nil),                     // 97: api.AdminVipRecalcRequest
+	(*AdminVipRecalcReply)(nil),                       // 98: api.AdminVipRecalcReply
+	(*AdminVipLogListRequest)(nil),                    // 99: api.AdminVipLogListRequest
+	(*AdminVipLogListReply)(nil),                      // 100: api.AdminVipLogListReply
+	(*AdminDhbPriceRequest)(nil),                      // 101: api.AdminDhbPriceRequest
+	(*AdminDhbPriceReply)(nil),                        // 102: api.AdminDhbPriceReply
+	(*AdminDailyMetricsRequest)(nil),                  // 103: api.AdminDailyMetricsRequest
+	(*AdminDailyMetricsReply)(nil),                    // 104: api.AdminDailyMetricsReply
+	(*AdminDailyMetricsSeriesRequest)(nil),            // 105: api.AdminDailyMetricsSeriesRequest
+	(*AdminDailyMetricsSeriesReply)(nil),              // 106: api.AdminDailyMetricsSeriesReply
+	(*AdminConfigRequest)(nil),                        // 107: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                          // 108: api.AdminConfigReply
+	(*AdminConfigUpdateRequest)(nil),                  // 109: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                    // 110: api.AdminConfigUpdateReply
+	(*EthAuthorizeRequest_SendBody)(nil),              // 111: api.EthAuthorizeRequest.SendBody
+	(*UserInfoReply_Matrix)(nil),                      // 112: api.UserInfoReply.Matrix
+	(*RewardListReply_List)(nil),                      // 113: api.RewardListReply.List
+	(*RecommendRewardListReply_List)(nil),             // 114: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),                   // 115: api.FeeRewardListReply.List
+	(*MyLocationListReply_List)(nil),                  // 116: api.MyLocationListReply.List
+	(*LocationNeighbourReply_List)(nil),               // 117: api.LocationNeighbourReply.List
+	(*WithdrawListReply_List)(nil),                    // 118: api.WithdrawListReply.List
+	(*RecommendTreeReply_Depth)(nil),                  // 119: api.RecommendTreeReply.Depth
+	(*RecommendTreeReply_List)(nil),                   // 120: api.RecommendTreeReply.List
+	(*RecommendListReply_List)(nil),                   // 121: api.RecommendListReply.List
+	(*WithdrawRequest_SendBody)(nil),                  // 122: api.WithdrawRequest.SendBody
+	(*TransferRequest_SendBody)(nil),                  // 123: api.TransferRequest.SendBody
+	(*ConvertRequest_SendBody)(nil),                   // 124: api.ConvertRequest.SendBody
+	(*ReinvestRequest_SendBody)(nil),                  // 125: api.ReinvestRequest.SendBody
+	(*SetAutoReinvestRequest_SendBody)(nil),           // 126: api.SetAutoReinvestRequest.SendBody
+	(*UpgradeLocationRequest_SendBody)(nil),           // 127: api.UpgradeLocationRequest.SendBody
+	(*ReserveProofReply_Proof)(nil),                   // 128: api.ReserveProofReply.Proof
+	(*StatementReply_List)(nil),                       // 129: api.StatementReply.List
+	(*AdminRewardListReply_List)(nil),                 // 130: api.AdminRewardListReply.List
+	(*AdminUserListReply_UserList)(nil),               // 131: api.AdminUserListReply.UserList
+	(*AdminLocationListReply_LocationList)(nil),       // 132: api.AdminLocationListReply.LocationList
+	(*AdminLocationEventListReply_List)(nil),          // 133: api.AdminLocationEventListReply.List
+	(*AdminWithdrawListReply_List)(nil),               // 134: api.AdminWithdrawListReply.List
+	(*AdminUserRecommendReply_List)(nil),              // 135: api.AdminUserRecommendReply.List
+	(*AdminMonthRecommendReply_List)(nil),             // 136: api.AdminMonthRecommendReply.List
+	(*AdminBalanceAdjustRequest_SendBody)(nil),        // 137: api.AdminBalanceAdjustRequest.SendBody
+	(*AdminBalanceAdjustApproveRequest_SendBody)(nil), // 138: api.AdminBalanceAdjustApproveRequest.SendBody
+	(*AdminBalanceAdjustListReply_List)(nil),          // 139: api.AdminBalanceAdjustListReply.List
+	(*ReserveSnapshot_Wallets)(nil),                   // 140: api.ReserveSnapshot.Wallets
+	(*AdminDepositReverseRequest_SendBody)(nil),       // 141: api.AdminDepositReverseRequest.SendBody
+	(*AdminReserveMerkleReply_Leaves)(nil),            // 142: api.AdminReserveMerkleReply.Leaves
+	(*AdminReconcileReply_List)(nil),                  // 143: api.AdminReconcileReply.List
+	(*AdminReconcileListReply_List)(nil),              // 144: api.AdminReconcileListReply.List
+	(*AdminReconcileDetailReply_List)(nil),            // 145: api.AdminReconcileDetailReply.List
+	(*AdminLedgerVerifyReply_List)(nil),               // 146: api.AdminLedgerVerifyReply.List
+	(*AdminLedgerVerifyReply_Accounts)(nil),           // 147: api.AdminLedgerVerifyReply.Accounts
+	(*AdminRecommendAreaCheckReply_List)(nil),         // 148: api.AdminRecommendAreaCheckReply.List
+	(*AdminUserMoveRequest_SendBody)(nil),             // 149: api.AdminUserMoveRequest.SendBody
+	(*AdminVipLevelListReply_List)(nil),               // 150: api.AdminVipLevelListReply.List
+	(*AdminVipLevelUpdateRequest_SendBody)(nil),       // 151: api.AdminVipLevelUpdateRequest.SendBody
+	(*AdminVipLogListReply_List)(nil),                 // 152: api.AdminVipLogListReply.List
+	(*AdminDailyMetricsReply_List)(nil),               // 153: api.AdminDailyMetricsReply.List
+	(*AdminDailyMetricsSeriesReply_List)(nil),         // 154: api.AdminDailyMetricsSeriesReply.List
+	(*AdminConfigReply_List)(nil),                     // 155: api.AdminConfigReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),         // 156: api.AdminConfigUpdateRequest.SendBody
+}
+var file_api_app_proto_depIdxs = []int32{
+	111, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	112, // 1: api.UserInfoReply.matrix:type_name -> api.UserInfoReply.Matrix
+	113, // 2: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	114, // 3: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	115, // 4: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	116, // 5: api.MyLocationListReply.locations:type_name -> api.MyLocationListReply.List
+	117, // 6: api.LocationNeighbourReply.rowPeers:type_name -> api.LocationNeighbourReply.List
+	117, // 7: api.LocationNeighbourReply.colPeers:type_name -> api.LocationNeighbourReply.List
+	118, // 8: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	119, // 9: api.RecommendTreeReply.depths:type_name -> api.RecommendTreeReply.Depth
+	120, // 10: api.RecommendTreeReply.children:type_name -> api.RecommendTreeReply.List
+	121, // 11: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	122, // 12: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	123, // 13: api.TransferRequest.send_body:type_name -> api.TransferRequest.SendBody
+	124, // 14: api.ConvertRequest.send_body:type_name -> api.ConvertRequest.SendBody
+	125, // 15: api.ReinvestRequest.send_body:type_name -> api.ReinvestRequest.SendBody
+	126, // 16: api.SetAutoReinvestRequest.send_body:type_name -> api.SetAutoReinvestRequest.SendBody
+	127, // 17: api.UpgradeLocationRequest.send_body:type_name -> api.UpgradeLocationRequest.SendBody
+	128, // 18: api.ReserveProofReply.proof:type_name -> api.ReserveProofReply.Proof
+	129, // 19: api.StatementReply.list:type_name -> api.StatementReply.List
+	130, // 20: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	131, // 21: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	132, // 22: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	133, // 23: api.AdminLocationEventListReply.events:type_name -> api.AdminLocationEventListReply.List
+	134, // 24: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	135, // 25: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	136, // 26: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	137, // 27: api.AdminBalanceAdjustRequest.send_body:type_name -> api.AdminBalanceAdjustRequest.SendBody
+	138, // 28: api.AdminBalanceAdjustApproveRequest.send_body:type_name -> api.AdminBalanceAdjustApproveRequest.SendBody
+	139, // 29: api.AdminBalanceAdjustListReply.list:type_name -> api.AdminBalanceAdjustListReply.List
+	140, // 30: api.ReserveSnapshot.wallets:type_name -> api.ReserveSnapshot.Wallets
+	141, // 31: api.AdminDepositReverseRequest.send_body:type_name -> api.AdminDepositReverseRequest.SendBody
+	72,  // 32: api.AdminReserveSnapshotReply.snapshot:type_name -> api.ReserveSnapshot
+	72,  // 33: api.AdminReserveListReply.list:type_name -> api.ReserveSnapshot
+	142, // 34: api.AdminReserveMerkleReply.leaves:type_name -> api.AdminReserveMerkleReply.Leaves
+	143, // 35: api.AdminReconcileReply.list:type_name -> api.AdminReconcileReply.List
+	144, // 36: api.AdminReconcileListReply.list:type_name -> api.AdminReconcileListReply.List
+	145, // 37: api.AdminReconcileDetailReply.list:type_name -> api.AdminReconcileDetailReply.List
+	146, // 38: api.AdminLedgerVerifyReply.list:type_name -> api.AdminLedgerVerifyReply.List
+	147, // 39: api.AdminLedgerVerifyReply.accounts:type_name -> api.AdminLedgerVerifyReply.Accounts
+	148, // 40: api.AdminRecommendAreaCheckReply.list:type_name -> api.AdminRecommendAreaCheckReply.List
+	149, // 41: api.AdminUserMoveRequest.send_body:type_name -> api.AdminUserMoveRequest.SendBody
+	150, // 42: api.AdminVipLevelListReply.levels:type_name -> api.AdminVipLevelListReply.List
+	151, // 43: api.AdminVipLevelUpdateRequest.send_body:type_name -> api.AdminVipLevelUpdateRequest.SendBody
+	152, // 44: api.AdminVipLogListReply.logs:type_name -> api.AdminVipLogListReply.List
+	153, // 45: api.AdminDailyMetricsReply.list:type_name -> api.AdminDailyMetricsReply.List
+	154, // 46: api.AdminDailyMetricsSeriesReply.list:type_name -> api.AdminDailyMetricsSeriesReply.List
+	155, // 47: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	156, // 48: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	0,   // 49: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
+	4,   // 50: api.App.UserInfo:input_type -> api.UserInfoRequest
+	6,   // 51: api.App.RewardList:input_type -> api.RewardListRequest
+	8,   // 52: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	10,  // 53: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	12,  // 54: api.App.MyLocationList:input_type -> api.MyLocationListRequest
+	14,  // 55: api.App.LocationNeighbour:input_type -> api.LocationNeighbourRequest
+	16,  // 56: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	18,  // 57: api.App.RecommendTree:input_type -> api.RecommendTreeRequest
+	20,  // 58: api.App.RecommendList:input_type -> api.RecommendListRequest
+	22,  // 59: api.App.Withdraw:input_type -> api.WithdrawRequest
+	24,  // 60: api.App.Transfer:input_type -> api.TransferRequest
+	26,  // 61: api.App.Convert:input_type -> api.ConvertRequest
+	28,  // 62: api.App.Reinvest:input_type -> api.ReinvestRequest
+	30,  // 63: api.App.SetAutoReinvest:input_type -> api.SetAutoReinvestRequest
+	32,  // 64: api.App.UpgradeLocation:input_type -> api.UpgradeLocationRequest
+	34,  // 65: api.App.ReserveProof:input_type -> api.ReserveProofRequest
+	36,  // 66: api.App.Statement:input_type -> api.StatementRequest
+	38,  // 67: api.App.ExitLocation:input_type -> api.ExitLocationRequest
+	2,   // 68: api.App.Deposit:input_type -> api.DepositRequest
+	50,  // 69: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
+	52,  // 70: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	54,  // 71: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	46,  // 72: api.App.AdminLocationEventList:input_type -> api.AdminLocationEventListRequest
+	62,  // 73: api.App.AdminRecommendTreeBackfill:input_type -> api.AdminRecommendTreeBackfillRequest
+	64,  // 74: api.App.AdminTeamStatsRebuild:input_type -> api.AdminTeamStatsRebuildRequest
+	66,  // 75: api.App.AdminBalanceAdjust:input_type -> api.AdminBalanceAdjustRequest
+	68,  // 76: api.App.AdminBalanceAdjustApprove:input_type -> api.AdminBalanceAdjustApproveRequest
+	70,  // 77: api.App.AdminBalanceAdjustList:input_type -> api.AdminBalanceAdjustListRequest
+	73,  // 78: api.App.AdminDepositReverse:input_type -> api.AdminDepositReverseRequest
+	75,  // 79: api.App.AdminReserveSnapshot:input_type -> api.AdminReserveSnapshotRequest
+	77,  // 80: api.App.AdminReserveList:input_type -> api.AdminReserveListRequest
+	79,  // 81: api.App.AdminReserveMerkle:input_type -> api.AdminReserveMerkleRequest
+	81,  // 82: api.App.AdminReconcile:input_type -> api.AdminReconcileRequest
+	83,  // 83: api.App.AdminReconcileList:input_type -> api.AdminReconcileListRequest
+	85,  // 84: api.App.AdminReconcileDetail:input_type -> api.AdminReconcileDetailRequest
+	87,  // 85: api.App.AdminLedgerVerify:input_type -> api.AdminLedgerVerifyRequest
+	89,  // 86: api.App.AdminRecommendAreaCheck:input_type -> api.AdminRecommendAreaCheckRequest
+	91,  // 87: api.App.AdminUserMove:input_type -> api.AdminUserMoveRequest
+	93,  // 88: api.App.AdminVipLevelList:input_type -> api.AdminVipLevelListRequest
+	95,  // 89: api.App.AdminVipLevelUpdate:input_type -> api.AdminVipLevelUpdateRequest
+	97,  // 90: api.App.AdminVipRecalc:input_type -> api.AdminVipRecalcRequest
+	99,  // 91: api.App.AdminVipLogList:input_type -> api.AdminVipLogListRequest
+	101, // 92: api.App.AdminDhbPrice:input_type -> api.AdminDhbPriceRequest
+	103, // 93: api.App.AdminDailyMetrics:input_type -> api.AdminDailyMetricsRequest
+	105, // 94: api.App.AdminDailyMetricsSeries:input_type -> api.AdminDailyMetricsSeriesRequest
+	1,   // 95: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
+	5,   // 96: api.App.UserInfo:output_type -> api.UserInfoReply
+	7,   // 97: api.App.RewardList:output_type -> api.RewardListReply
+	9,   // 98: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	11,  // 99: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	13,  // 100: api.App.MyLocationList:output_type -> api.MyLocationListReply
+	15,  // 101: api.App.LocationNeighbour:output_type -> api.LocationNeighbourReply
+	17,  // 102: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	19,  // 103: api.App.RecommendTree:output_type -> api.RecommendTreeReply
+	21,  // 104: api.App.RecommendList:output_type -> api.RecommendListReply
+	23,  // 105: api.App.Withdraw:output_type -> api.WithdrawReply
+	25,  // 106: api.App.Transfer:output_type -> api.TransferReply
+	27,  // 107: api.App.Convert:output_type -> api.ConvertReply
+	29,  // 108: api.App.Reinvest:output_type -> api.ReinvestReply
+	31,  // 109: api.App.SetAutoReinvest:output_type -> api.SetAutoReinvestReply
+	33,  // 110: api.App.UpgradeLocation:output_type -> api.UpgradeLocationReply
+	35,  // 111: api.App.ReserveProof:output_type -> api.ReserveProofReply
+	37,  // 112: api.App.Statement:output_type -> api.StatementReply
+	39,  // 113: api.App.ExitLocation:output_type -> api.ExitLocationReply
+	3,   // 114: api.App.Deposit:output_type -> api.DepositReply
+	51,  // 115: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
+	53,  // 116: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	55,  // 117: api.App.AdminFee:output_type -> api.AdminFeeReply
+	47,  // 118: api.App.AdminLocationEventList:output_type -> api.AdminLocationEventListReply
+	63,  // 119: api.App.AdminRecommendTreeBackfill:output_type -> api.AdminRecommendTreeBackfillReply
+	65,  // 120: api.App.AdminTeamStatsRebuild:output_type -> api.AdminTeamStatsRebuildReply
+	67,  // 121: api.App.AdminBalanceAdjust:output_type -> api.AdminBalanceAdjustReply
+	69,  // 122: api.App.AdminBalanceAdjustApprove:output_type -> api.AdminBalanceAdjustApproveReply
+	71,  // 123: api.App.AdminBalanceAdjustList:output_type -> api.AdminBalanceAdjustListReply
+	74,  // 124: api.App.AdminDepositReverse:output_type -> api.AdminDepositReverseReply
+	76,  // 125: api.App.AdminReserveSnapshot:output_type -> api.AdminReserveSnapshotReply
+	78,  // 126: api.App.AdminReserveList:output_type -> api.AdminReserveListReply
+	80,  // 127: api.App.AdminReserveMerkle:output_type -> api.AdminReserveMerkleReply
+	82,  // 128: api.App.AdminReconcile:output_type -> api.AdminReconcileReply
+	84,  // 129: api.App.AdminReconcileList:output_type -> api.AdminReconcileListReply
+	86,  // 130: api.App.AdminReconcileDetail:output_type -> api.AdminReconcileDetailReply
+	88,  // 131: api.App.AdminLedgerVerify:output_type -> api.AdminLedgerVerifyReply
+	90,  // 132: api.App.AdminRecommendAreaCheck:output_type -> api.AdminRecommendAreaCheckReply
+	92,  // 133: api.App.AdminUserMove:output_type -> api.AdminUserMoveReply
+	94,  // 134: api.App.AdminVipLevelList:output_type -> api.AdminVipLevelListReply
+	96,  // 135: api.App.AdminVipLevelUpdate:output_type -> api.AdminVipLevelUpdateReply
+	98,  // 136: api.App.AdminVipRecalc:output_type -> api.AdminVipRecalcReply
+	100, // 137: api.App.AdminVipLogList:output_type -> api.AdminVipLogListReply
+	102, // 138: api.App.AdminDhbPrice:output_type -> api.AdminDhbPriceReply
+	104, // 139: api.App.AdminDailyMetrics:output_type -> api.AdminDailyMetricsReply
+	106, // 140: api.App.AdminDailyMetricsSeries:output_type -> api.AdminDailyMetricsSeriesReply
+	95,  // [95:141] is the sub-list for method output_type
+	49,  // [49:95] is the sub-list for method input_type
+	49,  // [49:49] is the sub-list for extension type_name
+	49,  // [49:49] is the sub-list for extension extendee
+	0,   // [0:49] is the sub-list for field type_name
+}
+
+func init() { file_api_app_proto_init() }
+func file_api_app_proto_init() {
+	if File_api_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationNeighbourRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationNeighbourReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoReinvestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoReinvestReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeLocationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveProofReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitLocationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationEventListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationEventListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendTreeBackfillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendTreeBackfillReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTeamStatsRebuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTeamStatsRebuildReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustApproveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustApproveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReverseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReverseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveSnapshotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveMerkleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveMerkleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileDetailReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3908,8 +12577,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendAreaCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3920,8 +12589,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendAreaCheckReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3932,8 +12601,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserMoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3944,8 +12613,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserMoveReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3956,8 +12625,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3968,8 +12637,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3980,8 +12649,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3992,8 +12661,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4004,8 +12673,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipRecalcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4016,8 +12685,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipRecalcReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4028,8 +12697,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLogListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4040,8 +12709,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLogListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4052,8 +12721,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDhbPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4064,8 +12733,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDhbPriceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4076,8 +12745,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4088,8 +12757,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4100,8 +12769,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsSeriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4112,8 +12781,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsSeriesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4124,8 +12793,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4136,8 +12805,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4148,8 +12817,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4160,8 +12829,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4172,8 +12841,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4184,8 +12853,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_Matrix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4196,8 +12865,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4208,8 +12877,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4220,8 +12889,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4232,8 +12901,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyLocationListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4244,8 +12913,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationNeighbourReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4256,8 +12925,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4268,8 +12937,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeReply_Depth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4280,8 +12949,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4292,8 +12961,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4304,8 +12973,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4316,8 +12985,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4328,8 +12997,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4340,8 +13009,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReinvestRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4352,8 +13021,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoReinvestRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4364,8 +13033,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeLocationRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4376,8 +13045,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveProofReply_Proof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4388,8 +13057,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4400,8 +13069,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4412,8 +13081,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4424,8 +13093,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4436,8 +13105,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationEventListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4448,8 +13117,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4460,8 +13129,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4472,8 +13141,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest_SendBody); i {
+		file_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4484,8 +13153,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply_List); i {
+		file_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4496,8 +13165,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply_UserList); i {
+		file_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustApproveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4508,8 +13177,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply_LocationList); i {
+		file_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceAdjustListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4520,8 +13189,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply_List); i {
+		file_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveSnapshot_Wallets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4532,8 +13201,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply_List); i {
+		file_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReverseRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4544,8 +13213,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply_List); i {
+		file_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReserveMerkleReply_Leaves); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4556,7 +13225,151 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileDetailReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerVerifyReply_Accounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendAreaCheckReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserMoveRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLevelUpdateRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipLogListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyMetricsSeriesReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
@@ -4568,7 +13381,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
@@ -4587,7 +13400,7 @@ func file_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   57,
+			NumMessages:   157,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -651,6 +651,42 @@ func (m *UserInfoReply) validate(all bool) error {
 
 	// no validation rules for AreaAmount
 
+	for idx, item := range m.GetMatrix() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("Matrix[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("Matrix[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("Matrix[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AutoReinvest
+
 	if len(errors) > 0 {
 		return UserInfoReplyMultiError(errors)
 	}
@@ -1443,44 +1479,42 @@ var _ interface {
 	ErrorName() string
 } = FeeRewardListReplyValidationError{}
 
-// Validate checks the field values on WithdrawListRequest with the rules
+// Validate checks the field values on MyLocationListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListRequest) Validate() error {
+func (m *MyLocationListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListRequest with the rules
+// ValidateAll checks the field values on MyLocationListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListRequestMultiError, or nil if none found.
-func (m *WithdrawListRequest) ValidateAll() error {
+// MyLocationListRequestMultiError, or nil if none found.
+func (m *MyLocationListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListRequest) validate(all bool) error {
+func (m *MyLocationListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
 	if len(errors) > 0 {
-		return WithdrawListRequestMultiError(errors)
+		return MyLocationListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListRequest.ValidateAll() if the designated
+// MyLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by MyLocationListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawListRequestMultiError []error
+type MyLocationListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListRequestMultiError) Error() string {
+func (m MyLocationListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1489,11 +1523,11 @@ func (m WithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m MyLocationListRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawListRequestValidationError is the validation error returned by
-// WithdrawListRequest.Validate if the designated constraints aren't met.
-type WithdrawListRequestValidationError struct {
+// MyLocationListRequestValidationError is the validation error returned by
+// MyLocationListRequest.Validate if the designated constraints aren't met.
+type MyLocationListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1501,24 +1535,24 @@ type WithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListRequestValidationError) Field() string { return e.field }
+func (e MyLocationListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e MyLocationListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e MyLocationListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListRequestValidationError) Key() bool { return e.key }
+func (e MyLocationListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListRequestValidationError) ErrorName() string {
-	return "WithdrawListRequestValidationError"
+func (e MyLocationListRequestValidationError) ErrorName() string {
+	return "MyLocationListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListRequestValidationError) Error() string {
+func (e MyLocationListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1530,14 +1564,14 @@ func (e WithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListRequest.%s: %s%s",
+		"invalid %sMyLocationListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListRequestValidationError{}
+var _ error = MyLocationListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1545,47 +1579,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListRequestValidationError{}
+} = MyLocationListRequestValidationError{}
 
-// Validate checks the field values on WithdrawListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply) Validate() error {
+// Validate checks the field values on MyLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MyLocationListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply with the rules
+// ValidateAll checks the field values on MyLocationListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReplyMultiError, or nil if none found.
-func (m *WithdrawListReply) ValidateAll() error {
+// MyLocationListReplyMultiError, or nil if none found.
+func (m *MyLocationListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply) validate(all bool) error {
+func (m *MyLocationListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
+	for idx, item := range m.GetLocations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, MyLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, MyLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1593,8 +1627,8 @@ func (m *WithdrawListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return WithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
+				return MyLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1604,19 +1638,19 @@ func (m *WithdrawListReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WithdrawListReplyMultiError(errors)
+		return MyLocationListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawListReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawListReplyMultiError []error
+// MyLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by MyLocationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type MyLocationListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReplyMultiError) Error() string {
+func (m MyLocationListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1625,11 +1659,11 @@ func (m WithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m MyLocationListReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawListReplyValidationError is the validation error returned by
-// WithdrawListReply.Validate if the designated constraints aren't met.
-type WithdrawListReplyValidationError struct {
+// MyLocationListReplyValidationError is the validation error returned by
+// MyLocationListReply.Validate if the designated constraints aren't met.
+type MyLocationListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1637,24 +1671,24 @@ type WithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReplyValidationError) Field() string { return e.field }
+func (e MyLocationListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e MyLocationListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e MyLocationListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReplyValidationError) Key() bool { return e.key }
+func (e MyLocationListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReplyValidationError) ErrorName() string {
-	return "WithdrawListReplyValidationError"
+func (e MyLocationListReplyValidationError) ErrorName() string {
+	return "MyLocationListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReplyValidationError) Error() string {
+func (e MyLocationListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1666,14 +1700,14 @@ func (e WithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply.%s: %s%s",
+		"invalid %sMyLocationListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReplyValidationError{}
+var _ error = MyLocationListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1681,46 +1715,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReplyValidationError{}
+} = MyLocationListReplyValidationError{}
 
-// Validate checks the field values on RecommendListRequest with the rules
+// Validate checks the field values on LocationNeighbourRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListRequest) Validate() error {
+func (m *LocationNeighbourRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LocationNeighbourRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListRequestMultiError, or nil if none found.
-func (m *RecommendListRequest) ValidateAll() error {
+// LocationNeighbourRequestMultiError, or nil if none found.
+func (m *LocationNeighbourRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListRequest) validate(all bool) error {
+func (m *LocationNeighbourRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
 	if len(errors) > 0 {
-		return RecommendListRequestMultiError(errors)
+		return LocationNeighbourRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListRequestMultiError is an error wrapping multiple validation
-// errors returned by RecommendListRequest.ValidateAll() if the designated
+// LocationNeighbourRequestMultiError is an error wrapping multiple validation
+// errors returned by LocationNeighbourRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendListRequestMultiError []error
+type LocationNeighbourRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListRequestMultiError) Error() string {
+func (m LocationNeighbourRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1729,11 +1761,11 @@ func (m RecommendListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+func (m LocationNeighbourRequestMultiError) AllErrors() []error { return m }
 
-// RecommendListRequestValidationError is the validation error returned by
-// RecommendListRequest.Validate if the designated constraints aren't met.
-type RecommendListRequestValidationError struct {
+// LocationNeighbourRequestValidationError is the validation error returned by
+// LocationNeighbourRequest.Validate if the designated constraints aren't met.
+type LocationNeighbourRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1741,24 +1773,24 @@ type RecommendListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListRequestValidationError) Field() string { return e.field }
+func (e LocationNeighbourRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+func (e LocationNeighbourRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+func (e LocationNeighbourRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListRequestValidationError) Key() bool { return e.key }
+func (e LocationNeighbourRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListRequestValidationError) ErrorName() string {
-	return "RecommendListRequestValidationError"
+func (e LocationNeighbourRequestValidationError) ErrorName() string {
+	return "LocationNeighbourRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListRequestValidationError) Error() string {
+func (e LocationNeighbourRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1770,14 +1802,14 @@ func (e RecommendListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListRequest.%s: %s%s",
+		"invalid %sLocationNeighbourRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListRequestValidationError{}
+var _ error = LocationNeighbourRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1785,47 +1817,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListRequestValidationError{}
+} = LocationNeighbourRequestValidationError{}
 
-// Validate checks the field values on RecommendListReply with the rules
+// Validate checks the field values on LocationNeighbourReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply) Validate() error {
+func (m *LocationNeighbourReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply with the rules
+// ValidateAll checks the field values on LocationNeighbourReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReplyMultiError, or nil if none found.
-func (m *RecommendListReply) ValidateAll() error {
+// LocationNeighbourReplyMultiError, or nil if none found.
+func (m *LocationNeighbourReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply) validate(all bool) error {
+func (m *LocationNeighbourReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecommends() {
+	// no validation rules for MatrixId
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Position
+
+	for idx, item := range m.GetRowPeers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
+					errors = append(errors, LocationNeighbourReplyValidationError{
+						field:  fmt.Sprintf("RowPeers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
+					errors = append(errors, LocationNeighbourReplyValidationError{
+						field:  fmt.Sprintf("RowPeers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1833,8 +1873,42 @@ func (m *RecommendListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RecommendListReplyValidationError{
-					field:  fmt.Sprintf("Recommends[%v]", idx),
+				return LocationNeighbourReplyValidationError{
+					field:  fmt.Sprintf("RowPeers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetColPeers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LocationNeighbourReplyValidationError{
+						field:  fmt.Sprintf("ColPeers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LocationNeighbourReplyValidationError{
+						field:  fmt.Sprintf("ColPeers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LocationNeighbourReplyValidationError{
+					field:  fmt.Sprintf("ColPeers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1844,19 +1918,19 @@ func (m *RecommendListReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RecommendListReplyMultiError(errors)
+		return LocationNeighbourReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReplyMultiError is an error wrapping multiple validation errors
-// returned by RecommendListReply.ValidateAll() if the designated constraints
-// aren't met.
-type RecommendListReplyMultiError []error
+// LocationNeighbourReplyMultiError is an error wrapping multiple validation
+// errors returned by LocationNeighbourReply.ValidateAll() if the designated
+// constraints aren't met.
+type LocationNeighbourReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReplyMultiError) Error() string {
+func (m LocationNeighbourReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1865,11 +1939,11 @@ func (m RecommendListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+func (m LocationNeighbourReplyMultiError) AllErrors() []error { return m }
 
-// RecommendListReplyValidationError is the validation error returned by
-// RecommendListReply.Validate if the designated constraints aren't met.
-type RecommendListReplyValidationError struct {
+// LocationNeighbourReplyValidationError is the validation error returned by
+// LocationNeighbourReply.Validate if the designated constraints aren't met.
+type LocationNeighbourReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1877,24 +1951,24 @@ type RecommendListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReplyValidationError) Field() string { return e.field }
+func (e LocationNeighbourReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+func (e LocationNeighbourReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+func (e LocationNeighbourReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReplyValidationError) Key() bool { return e.key }
+func (e LocationNeighbourReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReplyValidationError) ErrorName() string {
-	return "RecommendListReplyValidationError"
+func (e LocationNeighbourReplyValidationError) ErrorName() string {
+	return "LocationNeighbourReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReplyValidationError) Error() string {
+func (e LocationNeighbourReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1906,14 +1980,14 @@ func (e RecommendListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply.%s: %s%s",
+		"invalid %sLocationNeighbourReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReplyValidationError{}
+var _ error = LocationNeighbourReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1921,73 +1995,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReplyValidationError{}
+} = LocationNeighbourReplyValidationError{}
 
-// Validate checks the field values on WithdrawRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest) Validate() error {
+// Validate checks the field values on WithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest with the rules
+// ValidateAll checks the field values on WithdrawListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawRequestMultiError, or nil if none found.
-func (m *WithdrawRequest) ValidateAll() error {
+// WithdrawListRequestMultiError, or nil if none found.
+func (m *WithdrawListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest) validate(all bool) error {
+func (m *WithdrawListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WithdrawRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return WithdrawRequestMultiError(errors)
+		return WithdrawListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequestMultiError is an error wrapping multiple validation errors
-// returned by WithdrawRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawRequestMultiError []error
+// WithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequestMultiError) Error() string {
+func (m WithdrawListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1996,34 +2043,36 @@ func (m WithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawRequestValidationError is the validation error returned by
-// WithdrawRequest.Validate if the designated constraints aren't met.
-type WithdrawRequestValidationError struct {
-	field  string
+// WithdrawListRequestValidationError is the validation error returned by
+// WithdrawListRequest.Validate if the designated constraints aren't met.
+type WithdrawListRequestValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e WithdrawRequestValidationError) Field() string { return e.field }
+func (e WithdrawListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequestValidationError) Key() bool { return e.key }
+func (e WithdrawListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+func (e WithdrawListRequestValidationError) ErrorName() string {
+	return "WithdrawListRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequestValidationError) Error() string {
+func (e WithdrawListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2035,14 +2084,14 @@ func (e WithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest.%s: %s%s",
+		"invalid %sWithdrawListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequestValidationError{}
+var _ error = WithdrawListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2050,46 +2099,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequestValidationError{}
+} = WithdrawListRequestValidationError{}
 
-// Validate checks the field values on WithdrawReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WithdrawReply) Validate() error {
+// Validate checks the field values on WithdrawListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
-// nil if none found.
-func (m *WithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListReplyMultiError, or nil if none found.
+func (m *WithdrawListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawReply) validate(all bool) error {
+func (m *WithdrawListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return WithdrawReplyMultiError(errors)
+		return WithdrawListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
+// WithdrawListReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawListReply.ValidateAll() if the designated constraints
 // aren't met.
-type WithdrawReplyMultiError []error
+type WithdrawListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawReplyMultiError) Error() string {
+func (m WithdrawListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2098,11 +2179,11 @@ func (m WithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawReplyValidationError is the validation error returned by
-// WithdrawReply.Validate if the designated constraints aren't met.
-type WithdrawReplyValidationError struct {
+// WithdrawListReplyValidationError is the validation error returned by
+// WithdrawListReply.Validate if the designated constraints aren't met.
+type WithdrawListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2110,22 +2191,24 @@ type WithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawReplyValidationError) Field() string { return e.field }
+func (e WithdrawListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawReplyValidationError) Key() bool { return e.key }
+func (e WithdrawListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+func (e WithdrawListReplyValidationError) ErrorName() string {
+	return "WithdrawListReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawReplyValidationError) Error() string {
+func (e WithdrawListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2137,14 +2220,14 @@ func (e WithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawReply.%s: %s%s",
+		"invalid %sWithdrawListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawReplyValidationError{}
+var _ error = WithdrawListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2152,48 +2235,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawReplyValidationError{}
+} = WithdrawListReplyValidationError{}
 
-// Validate checks the field values on AdminRewardListRequest with the rules
+// Validate checks the field values on RecommendTreeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListRequest) Validate() error {
+func (m *RecommendTreeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// ValidateAll checks the field values on RecommendTreeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListRequestMultiError, or nil if none found.
-func (m *AdminRewardListRequest) ValidateAll() error {
+// RecommendTreeRequestMultiError, or nil if none found.
+func (m *RecommendTreeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListRequest) validate(all bool) error {
+func (m *RecommendTreeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
 	// no validation rules for Page
 
-	// no validation rules for Address
+	// no validation rules for Sort
 
 	if len(errors) > 0 {
-		return AdminRewardListRequestMultiError(errors)
+		return RecommendTreeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// RecommendTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendTreeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListRequestMultiError []error
+type RecommendTreeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListRequestMultiError) Error() string {
+func (m RecommendTreeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2202,11 +2287,11 @@ func (m AdminRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+func (m RecommendTreeRequestMultiError) AllErrors() []error { return m }
 
-// AdminRewardListRequestValidationError is the validation error returned by
-// AdminRewardListRequest.Validate if the designated constraints aren't met.
-type AdminRewardListRequestValidationError struct {
+// RecommendTreeRequestValidationError is the validation error returned by
+// RecommendTreeRequest.Validate if the designated constraints aren't met.
+type RecommendTreeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2214,24 +2299,24 @@ type AdminRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+func (e RecommendTreeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+func (e RecommendTreeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+func (e RecommendTreeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+func (e RecommendTreeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListRequestValidationError) ErrorName() string {
-	return "AdminRewardListRequestValidationError"
+func (e RecommendTreeRequestValidationError) ErrorName() string {
+	return "RecommendTreeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListRequestValidationError) Error() string {
+func (e RecommendTreeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2243,14 +2328,14 @@ func (e AdminRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListRequest.%s: %s%s",
+		"invalid %sRecommendTreeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListRequestValidationError{}
+var _ error = RecommendTreeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2258,47 +2343,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListRequestValidationError{}
+} = RecommendTreeRequestValidationError{}
 
-// Validate checks the field values on AdminRewardListReply with the rules
+// Validate checks the field values on RecommendTreeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply) Validate() error {
+func (m *RecommendTreeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply with the rules
+// ValidateAll checks the field values on RecommendTreeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReplyMultiError, or nil if none found.
-func (m *AdminRewardListReply) ValidateAll() error {
+// RecommendTreeReplyMultiError, or nil if none found.
+func (m *RecommendTreeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply) validate(all bool) error {
+func (m *RecommendTreeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for TeamNum
+
+	// no validation rules for ActiveNum
+
+	// no validation rules for TeamAmount
+
+	for idx, item := range m.GetDepths() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Depths[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Depths[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2306,8 +2401,42 @@ func (m *AdminRewardListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
+				return RecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Depths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2319,19 +2448,19 @@ func (m *AdminRewardListReply) validate(all bool) error {
 	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminRewardListReplyMultiError(errors)
+		return RecommendTreeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminRewardListReplyMultiError []error
+// RecommendTreeReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendTreeReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendTreeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReplyMultiError) Error() string {
+func (m RecommendTreeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2340,11 +2469,11 @@ func (m AdminRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+func (m RecommendTreeReplyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReplyValidationError is the validation error returned by
-// AdminRewardListReply.Validate if the designated constraints aren't met.
-type AdminRewardListReplyValidationError struct {
+// RecommendTreeReplyValidationError is the validation error returned by
+// RecommendTreeReply.Validate if the designated constraints aren't met.
+type RecommendTreeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2352,24 +2481,24 @@ type AdminRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+func (e RecommendTreeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+func (e RecommendTreeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+func (e RecommendTreeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+func (e RecommendTreeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReplyValidationError) ErrorName() string {
-	return "AdminRewardListReplyValidationError"
+func (e RecommendTreeReplyValidationError) ErrorName() string {
+	return "RecommendTreeReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReplyValidationError) Error() string {
+func (e RecommendTreeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2381,14 +2510,14 @@ func (e AdminRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply.%s: %s%s",
+		"invalid %sRecommendTreeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReplyValidationError{}
+var _ error = RecommendTreeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2396,48 +2525,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReplyValidationError{}
+} = RecommendTreeReplyValidationError{}
 
-// Validate checks the field values on AdminUserListRequest with the rules
+// Validate checks the field values on RecommendListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListRequest) Validate() error {
+func (m *RecommendListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListRequest with the rules
+// ValidateAll checks the field values on RecommendListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListRequestMultiError, or nil if none found.
-func (m *AdminUserListRequest) ValidateAll() error {
+// RecommendListRequestMultiError, or nil if none found.
+func (m *RecommendListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListRequest) validate(all bool) error {
+func (m *RecommendListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+		return RecommendListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// RecommendListRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserListRequestMultiError []error
+type RecommendListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
+func (m RecommendListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2446,11 +2573,11 @@ func (m AdminUserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+func (m RecommendListRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserListRequestValidationError is the validation error returned by
-// AdminUserListRequest.Validate if the designated constraints aren't met.
-type AdminUserListRequestValidationError struct {
+// RecommendListRequestValidationError is the validation error returned by
+// RecommendListRequest.Validate if the designated constraints aren't met.
+type RecommendListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2458,24 +2585,24 @@ type AdminUserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListRequestValidationError) Field() string { return e.field }
+func (e RecommendListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+func (e RecommendListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+func (e RecommendListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+func (e RecommendListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListRequestValidationError) ErrorName() string {
-	return "AdminUserListRequestValidationError"
+func (e RecommendListRequestValidationError) ErrorName() string {
+	return "RecommendListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListRequestValidationError) Error() string {
+func (e RecommendListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2487,14 +2614,14 @@ func (e AdminUserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListRequest.%s: %s%s",
+		"invalid %sRecommendListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListRequestValidationError{}
+var _ error = RecommendListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2502,47 +2629,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListRequestValidationError{}
+} = RecommendListRequestValidationError{}
 
-// Validate checks the field values on AdminUserListReply with the rules
+// Validate checks the field values on RecommendListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply) Validate() error {
+func (m *RecommendListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply with the rules
+// ValidateAll checks the field values on RecommendListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReplyMultiError, or nil if none found.
-func (m *AdminUserListReply) ValidateAll() error {
+// RecommendListReplyMultiError, or nil if none found.
+func (m *RecommendListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply) validate(all bool) error {
+func (m *RecommendListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	for idx, item := range m.GetRecommends() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2550,8 +2677,8 @@ func (m *AdminUserListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminUserListReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return RecommendListReplyValidationError{
+					field:  fmt.Sprintf("Recommends[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2560,22 +2687,20 @@ func (m *AdminUserListReply) validate(all bool) error {
 
 	}
 
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminUserListReplyMultiError(errors)
+		return RecommendListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// RecommendListReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendListReply.ValidateAll() if the designated constraints
 // aren't met.
-type AdminUserListReplyMultiError []error
+type RecommendListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReplyMultiError) Error() string {
+func (m RecommendListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2584,11 +2709,11 @@ func (m AdminUserListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+func (m RecommendListReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserListReplyValidationError is the validation error returned by
-// AdminUserListReply.Validate if the designated constraints aren't met.
-type AdminUserListReplyValidationError struct {
+// RecommendListReplyValidationError is the validation error returned by
+// RecommendListReply.Validate if the designated constraints aren't met.
+type RecommendListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2596,24 +2721,24 @@ type AdminUserListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReplyValidationError) Field() string { return e.field }
+func (e RecommendListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+func (e RecommendListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+func (e RecommendListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+func (e RecommendListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReplyValidationError) ErrorName() string {
-	return "AdminUserListReplyValidationError"
+func (e RecommendListReplyValidationError) ErrorName() string {
+	return "RecommendListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReplyValidationError) Error() string {
+func (e RecommendListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2625,14 +2750,14 @@ func (e AdminUserListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply.%s: %s%s",
+		"invalid %sRecommendListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReplyValidationError{}
+var _ error = RecommendListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2640,48 +2765,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReplyValidationError{}
+} = RecommendListReplyValidationError{}
 
-// Validate checks the field values on AdminLocationListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListRequest) Validate() error {
+// Validate checks the field values on WithdrawRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListRequestMultiError, or nil if none found.
-func (m *AdminLocationListRequest) ValidateAll() error {
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListRequest) validate(all bool) error {
+func (m *WithdrawRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminLocationListRequestMultiError(errors)
+		return WithdrawRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListRequestMultiError []error
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListRequestMultiError) Error() string {
+func (m WithdrawRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2690,11 +2840,11 @@ func (m AdminLocationListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationListRequestValidationError is the validation error returned by
-// AdminLocationListRequest.Validate if the designated constraints aren't met.
-type AdminLocationListRequestValidationError struct {
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2702,24 +2852,22 @@ type AdminLocationListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+func (e WithdrawRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListRequestValidationError) ErrorName() string {
-	return "AdminLocationListRequestValidationError"
-}
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListRequestValidationError) Error() string {
+func (e WithdrawRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2731,14 +2879,14 @@ func (e AdminLocationListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListRequest.%s: %s%s",
+		"invalid %sWithdrawRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListRequestValidationError{}
+var _ error = WithdrawRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2746,80 +2894,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListRequestValidationError{}
+} = WithdrawRequestValidationError{}
 
-// Validate checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListReply) Validate() error {
+// Validate checks the field values on WithdrawReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WithdrawReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationListReplyMultiError, or nil if none found.
-func (m *AdminLocationListReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
+// nil if none found.
+func (m *WithdrawReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply) validate(all bool) error {
+func (m *WithdrawReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminLocationListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminLocationListReplyMultiError(errors)
+		return WithdrawReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReplyMultiError []error
+// WithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReplyMultiError) Error() string {
+func (m WithdrawReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2828,11 +2942,11 @@ func (m AdminLocationListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReplyValidationError is the validation error returned by
-// AdminLocationListReply.Validate if the designated constraints aren't met.
-type AdminLocationListReplyValidationError struct {
+// WithdrawReplyValidationError is the validation error returned by
+// WithdrawReply.Validate if the designated constraints aren't met.
+type WithdrawReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2840,24 +2954,22 @@ type AdminLocationListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+func (e WithdrawReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+func (e WithdrawReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReplyValidationError) ErrorName() string {
-	return "AdminLocationListReplyValidationError"
-}
+func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReplyValidationError) Error() string {
+func (e WithdrawReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2869,14 +2981,14 @@ func (e AdminLocationListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply.%s: %s%s",
+		"invalid %sWithdrawReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReplyValidationError{}
+var _ error = WithdrawReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2884,48 +2996,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReplyValidationError{}
+} = WithdrawReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListRequest) Validate() error {
+// Validate checks the field values on TransferRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListRequestMultiError, or nil if none found.
-func (m *AdminWithdrawListRequest) ValidateAll() error {
+// TransferRequestMultiError, or nil if none found.
+func (m *TransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListRequest) validate(all bool) error {
+func (m *TransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminWithdrawListRequestMultiError(errors)
+		return TransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawListRequestMultiError []error
+// TransferRequestMultiError is an error wrapping multiple validation errors
+// returned by TransferRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListRequestMultiError) Error() string {
+func (m TransferRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2934,11 +3071,11 @@ func (m AdminWithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m TransferRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListRequestValidationError is the validation error returned by
-// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawListRequestValidationError struct {
+// TransferRequestValidationError is the validation error returned by
+// TransferRequest.Validate if the designated constraints aren't met.
+type TransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2946,24 +3083,22 @@ type AdminWithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+func (e TransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e TransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e TransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+func (e TransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListRequestValidationError) ErrorName() string {
-	return "AdminWithdrawListRequestValidationError"
-}
+func (e TransferRequestValidationError) ErrorName() string { return "TransferRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListRequestValidationError) Error() string {
+func (e TransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2975,14 +3110,14 @@ func (e AdminWithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		"invalid %sTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListRequestValidationError{}
+var _ error = TransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2990,80 +3125,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListRequestValidationError{}
+} = TransferRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply) Validate() error {
+// Validate checks the field values on TransferReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransferReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReplyMultiError, or nil if none found.
-func (m *AdminWithdrawListReply) ValidateAll() error {
+// ValidateAll checks the field values on TransferReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferReplyMultiError, or
+// nil if none found.
+func (m *TransferReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply) validate(all bool) error {
+func (m *TransferReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminWithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
-	// no validation rules for Count
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReplyMultiError(errors)
+		return TransferReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawListReplyMultiError []error
+// TransferReplyMultiError is an error wrapping multiple validation errors
+// returned by TransferReply.ValidateAll() if the designated constraints
+// aren't met.
+type TransferReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReplyMultiError) Error() string {
+func (m TransferReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3072,11 +3175,11 @@ func (m AdminWithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m TransferReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReplyValidationError is the validation error returned by
-// AdminWithdrawListReply.Validate if the designated constraints aren't met.
-type AdminWithdrawListReplyValidationError struct {
+// TransferReplyValidationError is the validation error returned by
+// TransferReply.Validate if the designated constraints aren't met.
+type TransferReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3084,24 +3187,22 @@ type AdminWithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+func (e TransferReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e TransferReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e TransferReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+func (e TransferReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReplyValidationError) ErrorName() string {
-	return "AdminWithdrawListReplyValidationError"
-}
+func (e TransferReplyValidationError) ErrorName() string { return "TransferReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReplyValidationError) Error() string {
+func (e TransferReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3113,14 +3214,14 @@ func (e AdminWithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		"invalid %sTransferReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReplyValidationError{}
+var _ error = TransferReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3128,44 +3229,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReplyValidationError{}
+} = TransferReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawRequest) Validate() error {
+// Validate checks the field values on ConvertRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConvertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawRequestMultiError, or nil if none found.
-func (m *AdminWithdrawRequest) ValidateAll() error {
+// ValidateAll checks the field values on ConvertRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConvertRequestMultiError,
+// or nil if none found.
+func (m *ConvertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawRequest) validate(all bool) error {
+func (m *ConvertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConvertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConvertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConvertRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminWithdrawRequestMultiError(errors)
+		return ConvertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawRequestMultiError []error
+// ConvertRequestMultiError is an error wrapping multiple validation errors
+// returned by ConvertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConvertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawRequestMultiError) Error() string {
+func (m ConvertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3174,11 +3304,11 @@ func (m AdminWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m ConvertRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawRequestValidationError is the validation error returned by
-// AdminWithdrawRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawRequestValidationError struct {
+// ConvertRequestValidationError is the validation error returned by
+// ConvertRequest.Validate if the designated constraints aren't met.
+type ConvertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3186,24 +3316,22 @@ type AdminWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+func (e ConvertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e ConvertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e ConvertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+func (e ConvertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawRequestValidationError) ErrorName() string {
-	return "AdminWithdrawRequestValidationError"
-}
+func (e ConvertRequestValidationError) ErrorName() string { return "ConvertRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawRequestValidationError) Error() string {
+func (e ConvertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3215,14 +3343,14 @@ func (e AdminWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		"invalid %sConvertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawRequestValidationError{}
+var _ error = ConvertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3230,44 +3358,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawRequestValidationError{}
+} = ConvertRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawReply) Validate() error {
+// Validate checks the field values on ConvertReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConvertReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawReplyMultiError, or nil if none found.
-func (m *AdminWithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on ConvertReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConvertReplyMultiError, or
+// nil if none found.
+func (m *ConvertReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawReply) validate(all bool) error {
+func (m *ConvertReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for FromAmount
+
+	// no validation rules for ToAmount
+
+	// no validation rules for Price
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return AdminWithdrawReplyMultiError(errors)
+		return ConvertReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminWithdrawReplyMultiError []error
+// ConvertReplyMultiError is an error wrapping multiple validation errors
+// returned by ConvertReply.ValidateAll() if the designated constraints aren't met.
+type ConvertReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawReplyMultiError) Error() string {
+func (m ConvertReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3276,11 +3413,11 @@ func (m AdminWithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+func (m ConvertReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawReplyValidationError is the validation error returned by
-// AdminWithdrawReply.Validate if the designated constraints aren't met.
-type AdminWithdrawReplyValidationError struct {
+// ConvertReplyValidationError is the validation error returned by
+// ConvertReply.Validate if the designated constraints aren't met.
+type ConvertReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3288,24 +3425,22 @@ type AdminWithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+func (e ConvertReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e ConvertReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e ConvertReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+func (e ConvertReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawReplyValidationError) ErrorName() string {
-	return "AdminWithdrawReplyValidationError"
-}
+func (e ConvertReplyValidationError) ErrorName() string { return "ConvertReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawReplyValidationError) Error() string {
+func (e ConvertReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3317,14 +3452,14 @@ func (e AdminWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawReply.%s: %s%s",
+		"invalid %sConvertReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawReplyValidationError{}
+var _ error = ConvertReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3332,44 +3467,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawReplyValidationError{}
+} = ConvertReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+// Validate checks the field values on ReinvestRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReinvestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReinvestRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// ReinvestRequestMultiError, or nil if none found.
+func (m *ReinvestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
+func (m *ReinvestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReinvestRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReinvestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+// ReinvestRequestMultiError is an error wrapping multiple validation errors
+// returned by ReinvestRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReinvestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m ReinvestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3378,11 +3542,11 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m ReinvestRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// ReinvestRequestValidationError is the validation error returned by
+// ReinvestRequest.Validate if the designated constraints aren't met.
+type ReinvestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3390,24 +3554,22 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e ReinvestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e ReinvestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e ReinvestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e ReinvestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
-}
+func (e ReinvestRequestValidationError) ErrorName() string { return "ReinvestRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e ReinvestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3419,14 +3581,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sReinvestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = ReinvestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3434,44 +3596,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = ReinvestRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+// Validate checks the field values on ReinvestReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReinvestReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// ValidateAll checks the field values on ReinvestReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReinvestReplyMultiError, or
+// nil if none found.
+func (m *ReinvestReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *ReinvestReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return ReinvestReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+// ReinvestReplyMultiError is an error wrapping multiple validation errors
+// returned by ReinvestReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReinvestReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m ReinvestReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3480,11 +3644,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m ReinvestReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// ReinvestReplyValidationError is the validation error returned by
+// ReinvestReply.Validate if the designated constraints aren't met.
+type ReinvestReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3492,24 +3656,22 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e ReinvestReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e ReinvestReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e ReinvestReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e ReinvestReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
-}
+func (e ReinvestReplyValidationError) ErrorName() string { return "ReinvestReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e ReinvestReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3521,14 +3683,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sReinvestReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = ReinvestReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3536,44 +3698,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = ReinvestReplyValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on SetAutoReinvestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAutoReinvestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
+// ValidateAll checks the field values on SetAutoReinvestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// SetAutoReinvestRequestMultiError, or nil if none found.
+func (m *SetAutoReinvestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *SetAutoReinvestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetAutoReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetAutoReinvestRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetAutoReinvestRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return SetAutoReinvestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// SetAutoReinvestRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAutoReinvestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetAutoReinvestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m SetAutoReinvestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3582,11 +3773,11 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m SetAutoReinvestRequestMultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// SetAutoReinvestRequestValidationError is the validation error returned by
+// SetAutoReinvestRequest.Validate if the designated constraints aren't met.
+type SetAutoReinvestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3594,22 +3785,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e SetAutoReinvestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e SetAutoReinvestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e SetAutoReinvestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e SetAutoReinvestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e SetAutoReinvestRequestValidationError) ErrorName() string {
+	return "SetAutoReinvestRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e SetAutoReinvestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3621,14 +3814,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sSetAutoReinvestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = SetAutoReinvestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3636,44 +3829,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = SetAutoReinvestRequestValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on SetAutoReinvestReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAutoReinvestReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on SetAutoReinvestReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAutoReinvestReplyMultiError, or nil if none found.
+func (m *SetAutoReinvestReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *SetAutoReinvestReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return SetAutoReinvestReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// SetAutoReinvestReplyMultiError is an error wrapping multiple validation
+// errors returned by SetAutoReinvestReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetAutoReinvestReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m SetAutoReinvestReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3682,11 +3877,11 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m SetAutoReinvestReplyMultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// SetAutoReinvestReplyValidationError is the validation error returned by
+// SetAutoReinvestReply.Validate if the designated constraints aren't met.
+type SetAutoReinvestReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3694,22 +3889,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e SetAutoReinvestReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e SetAutoReinvestReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e SetAutoReinvestReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e SetAutoReinvestReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e SetAutoReinvestReplyValidationError) ErrorName() string {
+	return "SetAutoReinvestReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e SetAutoReinvestReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3721,14 +3918,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sSetAutoReinvestReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = SetAutoReinvestReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3736,44 +3933,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = SetAutoReinvestReplyValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on UpgradeLocationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeLocationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
+// ValidateAll checks the field values on UpgradeLocationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// UpgradeLocationRequestMultiError, or nil if none found.
+func (m *UpgradeLocationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *UpgradeLocationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpgradeLocationRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpgradeLocationRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeLocationRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return UpgradeLocationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// UpgradeLocationRequestMultiError is an error wrapping multiple validation
+// errors returned by UpgradeLocationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradeLocationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m UpgradeLocationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3782,11 +4008,11 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m UpgradeLocationRequestMultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// UpgradeLocationRequestValidationError is the validation error returned by
+// UpgradeLocationRequest.Validate if the designated constraints aren't met.
+type UpgradeLocationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3794,22 +4020,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e UpgradeLocationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e UpgradeLocationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e UpgradeLocationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e UpgradeLocationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e UpgradeLocationRequestValidationError) ErrorName() string {
+	return "UpgradeLocationRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e UpgradeLocationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3821,14 +4049,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sUpgradeLocationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = UpgradeLocationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3836,62 +4064,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = UpgradeLocationRequestValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on UpgradeLocationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeLocationReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on UpgradeLocationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpgradeLocationReplyMultiError, or nil if none found.
+func (m *UpgradeLocationReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *UpgradeLocationReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TodayTotalUser
-
-	// no validation rules for TotalUser
-
-	// no validation rules for AllBalance
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayWithdraw
-
-	// no validation rules for AllWithdraw
-
-	// no validation rules for AllReward
-
-	// no validation rules for AllSystemRewardAndFee
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return UpgradeLocationReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// UpgradeLocationReplyMultiError is an error wrapping multiple validation
+// errors returned by UpgradeLocationReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradeLocationReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m UpgradeLocationReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3900,11 +4112,11 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+func (m UpgradeLocationReplyMultiError) AllErrors() []error { return m }
 
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+// UpgradeLocationReplyValidationError is the validation error returned by
+// UpgradeLocationReply.Validate if the designated constraints aren't met.
+type UpgradeLocationReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3912,22 +4124,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e UpgradeLocationReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e UpgradeLocationReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e UpgradeLocationReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e UpgradeLocationReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e UpgradeLocationReplyValidationError) ErrorName() string {
+	return "UpgradeLocationReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e UpgradeLocationReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3939,14 +4153,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sUpgradeLocationReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = UpgradeLocationReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3954,46 +4168,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = UpgradeLocationReplyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
+// Validate checks the field values on ReserveProofRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *ReserveProofRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReserveProofRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// ReserveProofRequestMultiError, or nil if none found.
+func (m *ReserveProofRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *ReserveProofRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return ReserveProofRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// ReserveProofRequestMultiError is an error wrapping multiple validation
+// errors returned by ReserveProofRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveProofRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m ReserveProofRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4002,11 +4214,11 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m ReserveProofRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// ReserveProofRequestValidationError is the validation error returned by
+// ReserveProofRequest.Validate if the designated constraints aren't met.
+type ReserveProofRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4014,24 +4226,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e ReserveProofRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e ReserveProofRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e ReserveProofRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e ReserveProofRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e ReserveProofRequestValidationError) ErrorName() string {
+	return "ReserveProofRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e ReserveProofRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4043,14 +4255,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sReserveProofRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = ReserveProofRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4058,47 +4270,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = ReserveProofRequestValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+// Validate checks the field values on ReserveProofReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReserveProofReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReserveProofReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// ReserveProofReplyMultiError, or nil if none found.
+func (m *ReserveProofReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *ReserveProofReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	// no validation rules for SnapshotId
+
+	// no validation rules for MerkleRoot
+
+	// no validation rules for Address
+
+	// no validation rules for Balance
+
+	// no validation rules for Leaf
+
+	// no validation rules for Index
+
+	for idx, item := range m.GetProof() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ReserveProofReplyValidationError{
+						field:  fmt.Sprintf("Proof[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ReserveProofReplyValidationError{
+						field:  fmt.Sprintf("Proof[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4106,8 +4330,8 @@ func (m *AdminUserRecommendReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return ReserveProofReplyValidationError{
+					field:  fmt.Sprintf("Proof[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4116,20 +4340,24 @@ func (m *AdminUserRecommendReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Nonce
+
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return ReserveProofReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+// ReserveProofReplyMultiError is an error wrapping multiple validation errors
+// returned by ReserveProofReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReserveProofReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m ReserveProofReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4138,11 +4366,11 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m ReserveProofReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// ReserveProofReplyValidationError is the validation error returned by
+// ReserveProofReply.Validate if the designated constraints aren't met.
+type ReserveProofReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4150,24 +4378,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e ReserveProofReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e ReserveProofReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e ReserveProofReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e ReserveProofReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e ReserveProofReplyValidationError) ErrorName() string {
+	return "ReserveProofReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e ReserveProofReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4179,14 +4407,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sReserveProofReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = ReserveProofReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4194,48 +4422,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = ReserveProofReplyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+// Validate checks the field values on StatementRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatementRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// StatementRequestMultiError, or nil if none found.
+func (m *StatementRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *StatementRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Start
 
-	// no validation rules for Page
+	// no validation rules for End
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return StatementRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+// StatementRequestMultiError is an error wrapping multiple validation errors
+// returned by StatementRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StatementRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m StatementRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4244,11 +4472,11 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+func (m StatementRequestMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+// StatementRequestValidationError is the validation error returned by
+// StatementRequest.Validate if the designated constraints aren't met.
+type StatementRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4256,24 +4484,22 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e StatementRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e StatementRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e StatementRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e StatementRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
-}
+func (e StatementRequestValidationError) ErrorName() string { return "StatementRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e StatementRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4285,14 +4511,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sStatementRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = StatementRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4300,47 +4526,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = StatementRequestValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+// Validate checks the field values on StatementReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatementReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// ValidateAll checks the field values on StatementReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatementReplyMultiError,
+// or nil if none found.
+func (m *StatementReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *StatementReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	for idx, item := range m.GetList() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, StatementReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, StatementReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4348,8 +4574,8 @@ func (m *AdminMonthRecommendReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return StatementReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4358,22 +4584,11691 @@ func (m *AdminMonthRecommendReply) validate(all bool) error {
 
 	}
 
-	// no validation rules for Count
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for OpeningUsdt
+
+	// no validation rules for OpeningDhb
+
+	// no validation rules for ClosingUsdt
+
+	// no validation rules for ClosingDhb
+
+	if len(errors) > 0 {
+		return StatementReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatementReplyMultiError is an error wrapping multiple validation errors
+// returned by StatementReply.ValidateAll() if the designated constraints
+// aren't met.
+type StatementReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatementReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatementReplyMultiError) AllErrors() []error { return m }
+
+// StatementReplyValidationError is the validation error returned by
+// StatementReply.Validate if the designated constraints aren't met.
+type StatementReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatementReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatementReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatementReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatementReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatementReplyValidationError) ErrorName() string { return "StatementReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatementReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatementReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatementReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatementReplyValidationError{}
+
+// Validate checks the field values on ExitLocationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExitLocationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExitLocationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExitLocationRequestMultiError, or nil if none found.
+func (m *ExitLocationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExitLocationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExitLocationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExitLocationRequestMultiError is an error wrapping multiple validation
+// errors returned by ExitLocationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExitLocationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExitLocationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExitLocationRequestMultiError) AllErrors() []error { return m }
+
+// ExitLocationRequestValidationError is the validation error returned by
+// ExitLocationRequest.Validate if the designated constraints aren't met.
+type ExitLocationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExitLocationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExitLocationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExitLocationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExitLocationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExitLocationRequestValidationError) ErrorName() string {
+	return "ExitLocationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExitLocationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExitLocationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExitLocationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExitLocationRequestValidationError{}
+
+// Validate checks the field values on ExitLocationReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExitLocationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExitLocationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExitLocationReplyMultiError, or nil if none found.
+func (m *ExitLocationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExitLocationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Refund
+
+	// no validation rules for Penalty
+
+	if len(errors) > 0 {
+		return ExitLocationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExitLocationReplyMultiError is an error wrapping multiple validation errors
+// returned by ExitLocationReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExitLocationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExitLocationReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExitLocationReplyMultiError) AllErrors() []error { return m }
+
+// ExitLocationReplyValidationError is the validation error returned by
+// ExitLocationReply.Validate if the designated constraints aren't met.
+type ExitLocationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExitLocationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExitLocationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExitLocationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExitLocationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExitLocationReplyValidationError) ErrorName() string {
+	return "ExitLocationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExitLocationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExitLocationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExitLocationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExitLocationReplyValidationError{}
+
+// Validate checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListRequestMultiError, or nil if none found.
+func (m *AdminRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+
+// AdminRewardListRequestValidationError is the validation error returned by
+// AdminRewardListRequest.Validate if the designated constraints aren't met.
+type AdminRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListRequestValidationError) ErrorName() string {
+	return "AdminRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListRequestValidationError{}
+
+// Validate checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReplyMultiError, or nil if none found.
+func (m *AdminRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReplyValidationError is the validation error returned by
+// AdminRewardListReply.Validate if the designated constraints aren't met.
+type AdminRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReplyValidationError) ErrorName() string {
+	return "AdminRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReplyValidationError{}
+
+// Validate checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListRequestMultiError, or nil if none found.
+func (m *AdminUserListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminUserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserListRequestValidationError is the validation error returned by
+// AdminUserListRequest.Validate if the designated constraints aren't met.
+type AdminUserListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListRequestValidationError) ErrorName() string {
+	return "AdminUserListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListRequestValidationError{}
+
+// Validate checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminUserListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListRequestMultiError, or nil if none found.
+func (m *AdminLocationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminLocationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationListRequestValidationError is the validation error returned by
+// AdminLocationListRequest.Validate if the designated constraints aren't met.
+type AdminLocationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListRequestValidationError) ErrorName() string {
+	return "AdminLocationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListReplyMultiError, or nil if none found.
+func (m *AdminLocationListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLocationListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReplyValidationError is the validation error returned by
+// AdminLocationListReply.Validate if the designated constraints aren't met.
+type AdminLocationListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReplyValidationError) ErrorName() string {
+	return "AdminLocationListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationEventListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationEventListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationEventListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLocationEventListRequestMultiError, or nil if none found.
+func (m *AdminLocationEventListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationEventListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LocationId
+
+	if len(errors) > 0 {
+		return AdminLocationEventListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationEventListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationEventListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationEventListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationEventListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationEventListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationEventListRequestValidationError is the validation error
+// returned by AdminLocationEventListRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationEventListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationEventListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationEventListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationEventListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationEventListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationEventListRequestValidationError) ErrorName() string {
+	return "AdminLocationEventListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationEventListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationEventListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationEventListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationEventListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationEventListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationEventListReplyMultiError, or nil if none found.
+func (m *AdminLocationEventListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationEventListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationEventListReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for MatrixId
+
+	// no validation rules for Status
+
+	// no validation rules for Current
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Consistent
+
+	if len(errors) > 0 {
+		return AdminLocationEventListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationEventListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationEventListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationEventListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationEventListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationEventListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationEventListReplyValidationError is the validation error returned
+// by AdminLocationEventListReply.Validate if the designated constraints
+// aren't met.
+type AdminLocationEventListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationEventListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationEventListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationEventListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationEventListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationEventListReplyValidationError) ErrorName() string {
+	return "AdminLocationEventListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationEventListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationEventListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationEventListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationEventListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminWithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListRequestValidationError is the validation error returned by
+// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReplyValidationError is the validation error returned by
+// AdminWithdrawListReply.Validate if the designated constraints aren't met.
+type AdminWithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRequestValidationError is the validation error returned by
+// AdminWithdrawRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReplyValidationError is the validation error returned by
+// AdminWithdrawReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthReplyValidationError{}
+
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeRequestValidationError{}
+
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeReplyValidationError{}
+
+// Validate checks the field values on AdminAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllRequestValidationError{}
+
+// Validate checks the field values on AdminAllReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAllReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TodayTotalUser
+
+	// no validation rules for TotalUser
+
+	// no validation rules for AllBalance
+
+	// no validation rules for TodayLocation
+
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayWithdraw
+
+	// no validation rules for AllWithdraw
+
+	// no validation rules for AllReward
+
+	// no validation rules for AllSystemRewardAndFee
+
+	if len(errors) > 0 {
+		return AdminAllReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllReplyValidationError{}
+
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AdminUserRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminUserRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
+type AdminMonthRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMonthRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminRecommendTreeBackfillRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminRecommendTreeBackfillRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendTreeBackfillRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminRecommendTreeBackfillRequestMultiError, or nil if none found.
+func (m *AdminRecommendTreeBackfillRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendTreeBackfillRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminRecommendTreeBackfillRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendTreeBackfillRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminRecommendTreeBackfillRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRecommendTreeBackfillRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendTreeBackfillRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendTreeBackfillRequestMultiError) AllErrors() []error { return m }
+
+// AdminRecommendTreeBackfillRequestValidationError is the validation error
+// returned by AdminRecommendTreeBackfillRequest.Validate if the designated
+// constraints aren't met.
+type AdminRecommendTreeBackfillRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendTreeBackfillRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendTreeBackfillRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendTreeBackfillRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendTreeBackfillRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendTreeBackfillRequestValidationError) ErrorName() string {
+	return "AdminRecommendTreeBackfillRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendTreeBackfillRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendTreeBackfillRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendTreeBackfillRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendTreeBackfillRequestValidationError{}
+
+// Validate checks the field values on AdminRecommendTreeBackfillReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendTreeBackfillReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendTreeBackfillReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRecommendTreeBackfillReplyMultiError, or nil if none found.
+func (m *AdminRecommendTreeBackfillReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendTreeBackfillReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRecommendTreeBackfillReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendTreeBackfillReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminRecommendTreeBackfillReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRecommendTreeBackfillReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendTreeBackfillReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendTreeBackfillReplyMultiError) AllErrors() []error { return m }
+
+// AdminRecommendTreeBackfillReplyValidationError is the validation error
+// returned by AdminRecommendTreeBackfillReply.Validate if the designated
+// constraints aren't met.
+type AdminRecommendTreeBackfillReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendTreeBackfillReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendTreeBackfillReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendTreeBackfillReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendTreeBackfillReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendTreeBackfillReplyValidationError) ErrorName() string {
+	return "AdminRecommendTreeBackfillReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendTreeBackfillReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendTreeBackfillReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendTreeBackfillReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendTreeBackfillReplyValidationError{}
+
+// Validate checks the field values on AdminTeamStatsRebuildRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTeamStatsRebuildRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTeamStatsRebuildRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTeamStatsRebuildRequestMultiError, or nil if none found.
+func (m *AdminTeamStatsRebuildRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTeamStatsRebuildRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminTeamStatsRebuildRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTeamStatsRebuildRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminTeamStatsRebuildRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminTeamStatsRebuildRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTeamStatsRebuildRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTeamStatsRebuildRequestMultiError) AllErrors() []error { return m }
+
+// AdminTeamStatsRebuildRequestValidationError is the validation error returned
+// by AdminTeamStatsRebuildRequest.Validate if the designated constraints
+// aren't met.
+type AdminTeamStatsRebuildRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTeamStatsRebuildRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTeamStatsRebuildRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTeamStatsRebuildRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTeamStatsRebuildRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTeamStatsRebuildRequestValidationError) ErrorName() string {
+	return "AdminTeamStatsRebuildRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTeamStatsRebuildRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTeamStatsRebuildRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTeamStatsRebuildRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTeamStatsRebuildRequestValidationError{}
+
+// Validate checks the field values on AdminTeamStatsRebuildReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminTeamStatsRebuildReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminTeamStatsRebuildReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminTeamStatsRebuildReplyMultiError, or nil if none found.
+func (m *AdminTeamStatsRebuildReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminTeamStatsRebuildReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminTeamStatsRebuildReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminTeamStatsRebuildReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminTeamStatsRebuildReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminTeamStatsRebuildReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminTeamStatsRebuildReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminTeamStatsRebuildReplyMultiError) AllErrors() []error { return m }
+
+// AdminTeamStatsRebuildReplyValidationError is the validation error returned
+// by AdminTeamStatsRebuildReply.Validate if the designated constraints aren't met.
+type AdminTeamStatsRebuildReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminTeamStatsRebuildReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminTeamStatsRebuildReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminTeamStatsRebuildReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminTeamStatsRebuildReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminTeamStatsRebuildReplyValidationError) ErrorName() string {
+	return "AdminTeamStatsRebuildReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminTeamStatsRebuildReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminTeamStatsRebuildReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminTeamStatsRebuildReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminTeamStatsRebuildReplyValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustRequestMultiError, or nil if none found.
+func (m *AdminBalanceAdjustRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminBalanceAdjustRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminBalanceAdjustRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminBalanceAdjustRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceAdjustRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminBalanceAdjustRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustRequestMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustRequestValidationError is the validation error returned by
+// AdminBalanceAdjustRequest.Validate if the designated constraints aren't met.
+type AdminBalanceAdjustRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustRequestValidationError) ErrorName() string {
+	return "AdminBalanceAdjustRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustRequestValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustReplyMultiError, or nil if none found.
+func (m *AdminBalanceAdjustReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceAdjustReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceAdjustReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustReplyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustReplyValidationError is the validation error returned by
+// AdminBalanceAdjustReply.Validate if the designated constraints aren't met.
+type AdminBalanceAdjustReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustReplyValidationError) ErrorName() string {
+	return "AdminBalanceAdjustReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustReplyValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustApproveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminBalanceAdjustApproveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustApproveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustApproveRequestMultiError, or nil if none found.
+func (m *AdminBalanceAdjustApproveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustApproveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminBalanceAdjustApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminBalanceAdjustApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminBalanceAdjustApproveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustApproveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustApproveRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminBalanceAdjustApproveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceAdjustApproveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustApproveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustApproveRequestMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustApproveRequestValidationError is the validation error
+// returned by AdminBalanceAdjustApproveRequest.Validate if the designated
+// constraints aren't met.
+type AdminBalanceAdjustApproveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustApproveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustApproveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustApproveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustApproveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustApproveRequestValidationError) ErrorName() string {
+	return "AdminBalanceAdjustApproveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustApproveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustApproveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustApproveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustApproveRequestValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustApproveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustApproveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustApproveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustApproveReplyMultiError, or nil if none found.
+func (m *AdminBalanceAdjustApproveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustApproveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustApproveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustApproveReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminBalanceAdjustApproveReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminBalanceAdjustApproveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustApproveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustApproveReplyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustApproveReplyValidationError is the validation error
+// returned by AdminBalanceAdjustApproveReply.Validate if the designated
+// constraints aren't met.
+type AdminBalanceAdjustApproveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustApproveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustApproveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustApproveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustApproveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustApproveReplyValidationError) ErrorName() string {
+	return "AdminBalanceAdjustApproveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustApproveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustApproveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustApproveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustApproveReplyValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustListRequestMultiError, or nil if none found.
+func (m *AdminBalanceAdjustListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminBalanceAdjustListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminBalanceAdjustListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustListRequestMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustListRequestValidationError is the validation error
+// returned by AdminBalanceAdjustListRequest.Validate if the designated
+// constraints aren't met.
+type AdminBalanceAdjustListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustListRequestValidationError) ErrorName() string {
+	return "AdminBalanceAdjustListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustListRequestValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustListReplyMultiError, or nil if none found.
+func (m *AdminBalanceAdjustListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminBalanceAdjustListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminBalanceAdjustListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminBalanceAdjustListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminBalanceAdjustListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceAdjustListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminBalanceAdjustListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminBalanceAdjustListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceAdjustListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceAdjustListReplyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceAdjustListReplyValidationError is the validation error returned
+// by AdminBalanceAdjustListReply.Validate if the designated constraints
+// aren't met.
+type AdminBalanceAdjustListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceAdjustListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceAdjustListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceAdjustListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceAdjustListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceAdjustListReplyValidationError) ErrorName() string {
+	return "AdminBalanceAdjustListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceAdjustListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceAdjustListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceAdjustListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceAdjustListReplyValidationError{}
+
+// Validate checks the field values on ReserveSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReserveSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveSnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveSnapshotMultiError, or nil if none found.
+func (m *ReserveSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Onchain
+
+	// no validation rules for UserBalance
+
+	// no validation rules for PendingWithdraw
+
+	// no validation rules for Liabilities
+
+	// no validation rules for Ratio
+
+	// no validation rules for MerkleRoot
+
+	for idx, item := range m.GetWallets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReserveSnapshotValidationError{
+						field:  fmt.Sprintf("Wallets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReserveSnapshotValidationError{
+						field:  fmt.Sprintf("Wallets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReserveSnapshotValidationError{
+					field:  fmt.Sprintf("Wallets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ReserveSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReserveSnapshotMultiError is an error wrapping multiple validation errors
+// returned by ReserveSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type ReserveSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveSnapshotMultiError) AllErrors() []error { return m }
+
+// ReserveSnapshotValidationError is the validation error returned by
+// ReserveSnapshot.Validate if the designated constraints aren't met.
+type ReserveSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveSnapshotValidationError) ErrorName() string { return "ReserveSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReserveSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveSnapshotValidationError{}
+
+// Validate checks the field values on AdminDepositReverseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReverseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReverseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReverseRequestMultiError, or nil if none found.
+func (m *AdminDepositReverseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReverseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositReverseRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositReverseRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositReverseRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositReverseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReverseRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReverseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReverseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReverseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReverseRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositReverseRequestValidationError is the validation error returned
+// by AdminDepositReverseRequest.Validate if the designated constraints aren't met.
+type AdminDepositReverseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReverseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReverseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReverseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReverseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReverseRequestValidationError) ErrorName() string {
+	return "AdminDepositReverseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReverseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReverseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReverseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReverseRequestValidationError{}
+
+// Validate checks the field values on AdminDepositReverseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReverseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReverseReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReverseReplyMultiError, or nil if none found.
+func (m *AdminDepositReverseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReverseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LocationId
+
+	// no validation rules for Amount
+
+	// no validation rules for RewardNum
+
+	// no validation rules for RewardAmount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminDepositReverseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReverseReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositReverseReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositReverseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReverseReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReverseReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositReverseReplyValidationError is the validation error returned by
+// AdminDepositReverseReply.Validate if the designated constraints aren't met.
+type AdminDepositReverseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReverseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReverseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReverseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReverseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReverseReplyValidationError) ErrorName() string {
+	return "AdminDepositReverseReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReverseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReverseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReverseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReverseReplyValidationError{}
+
+// Validate checks the field values on AdminReserveSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveSnapshotRequestMultiError, or nil if none found.
+func (m *AdminReserveSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminReserveSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminReserveSnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminReserveSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// AdminReserveSnapshotRequestValidationError is the validation error returned
+// by AdminReserveSnapshotRequest.Validate if the designated constraints
+// aren't met.
+type AdminReserveSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveSnapshotRequestValidationError) ErrorName() string {
+	return "AdminReserveSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveSnapshotRequestValidationError{}
+
+// Validate checks the field values on AdminReserveSnapshotReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveSnapshotReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveSnapshotReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveSnapshotReplyMultiError, or nil if none found.
+func (m *AdminReserveSnapshotReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveSnapshotReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminReserveSnapshotReplyValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminReserveSnapshotReplyValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminReserveSnapshotReplyValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminReserveSnapshotReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveSnapshotReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReserveSnapshotReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminReserveSnapshotReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveSnapshotReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveSnapshotReplyMultiError) AllErrors() []error { return m }
+
+// AdminReserveSnapshotReplyValidationError is the validation error returned by
+// AdminReserveSnapshotReply.Validate if the designated constraints aren't met.
+type AdminReserveSnapshotReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveSnapshotReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveSnapshotReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveSnapshotReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveSnapshotReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveSnapshotReplyValidationError) ErrorName() string {
+	return "AdminReserveSnapshotReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveSnapshotReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveSnapshotReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveSnapshotReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveSnapshotReplyValidationError{}
+
+// Validate checks the field values on AdminReserveListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveListRequestMultiError, or nil if none found.
+func (m *AdminReserveListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminReserveListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReserveListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReserveListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveListRequestMultiError) AllErrors() []error { return m }
+
+// AdminReserveListRequestValidationError is the validation error returned by
+// AdminReserveListRequest.Validate if the designated constraints aren't met.
+type AdminReserveListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveListRequestValidationError) ErrorName() string {
+	return "AdminReserveListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveListRequestValidationError{}
+
+// Validate checks the field values on AdminReserveListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveListReplyMultiError, or nil if none found.
+func (m *AdminReserveListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReserveListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReserveListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReserveListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminReserveListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReserveListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReserveListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveListReplyMultiError) AllErrors() []error { return m }
+
+// AdminReserveListReplyValidationError is the validation error returned by
+// AdminReserveListReply.Validate if the designated constraints aren't met.
+type AdminReserveListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveListReplyValidationError) ErrorName() string {
+	return "AdminReserveListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveListReplyValidationError{}
+
+// Validate checks the field values on AdminReserveMerkleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveMerkleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveMerkleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveMerkleRequestMultiError, or nil if none found.
+func (m *AdminReserveMerkleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveMerkleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapshotId
+
+	if len(errors) > 0 {
+		return AdminReserveMerkleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveMerkleRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReserveMerkleRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminReserveMerkleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveMerkleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveMerkleRequestMultiError) AllErrors() []error { return m }
+
+// AdminReserveMerkleRequestValidationError is the validation error returned by
+// AdminReserveMerkleRequest.Validate if the designated constraints aren't met.
+type AdminReserveMerkleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveMerkleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveMerkleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveMerkleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveMerkleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveMerkleRequestValidationError) ErrorName() string {
+	return "AdminReserveMerkleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveMerkleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveMerkleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveMerkleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveMerkleRequestValidationError{}
+
+// Validate checks the field values on AdminReserveMerkleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReserveMerkleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReserveMerkleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReserveMerkleReplyMultiError, or nil if none found.
+func (m *AdminReserveMerkleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReserveMerkleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapshotId
+
+	// no validation rules for MerkleRoot
+
+	for idx, item := range m.GetLeaves() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReserveMerkleReplyValidationError{
+						field:  fmt.Sprintf("Leaves[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReserveMerkleReplyValidationError{
+						field:  fmt.Sprintf("Leaves[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReserveMerkleReplyValidationError{
+					field:  fmt.Sprintf("Leaves[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminReserveMerkleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReserveMerkleReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReserveMerkleReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReserveMerkleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReserveMerkleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReserveMerkleReplyMultiError) AllErrors() []error { return m }
+
+// AdminReserveMerkleReplyValidationError is the validation error returned by
+// AdminReserveMerkleReply.Validate if the designated constraints aren't met.
+type AdminReserveMerkleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReserveMerkleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReserveMerkleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReserveMerkleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReserveMerkleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReserveMerkleReplyValidationError) ErrorName() string {
+	return "AdminReserveMerkleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReserveMerkleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReserveMerkleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReserveMerkleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReserveMerkleReplyValidationError{}
+
+// Validate checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileRequestMultiError, or nil if none found.
+func (m *AdminReconcileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	if len(errors) > 0 {
+		return AdminReconcileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileRequestMultiError) AllErrors() []error { return m }
+
+// AdminReconcileRequestValidationError is the validation error returned by
+// AdminReconcileRequest.Validate if the designated constraints aren't met.
+type AdminReconcileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileRequestValidationError) ErrorName() string {
+	return "AdminReconcileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileRequestValidationError{}
+
+// Validate checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileReplyMultiError, or nil if none found.
+func (m *AdminReconcileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReconcileReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReconcileReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReconcileReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminReconcileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileReplyMultiError) AllErrors() []error { return m }
+
+// AdminReconcileReplyValidationError is the validation error returned by
+// AdminReconcileReply.Validate if the designated constraints aren't met.
+type AdminReconcileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileReplyValidationError) ErrorName() string {
+	return "AdminReconcileReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileReplyValidationError{}
+
+// Validate checks the field values on AdminReconcileListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileListRequestMultiError, or nil if none found.
+func (m *AdminReconcileListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminReconcileListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileListRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminReconcileListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileListRequestMultiError) AllErrors() []error { return m }
+
+// AdminReconcileListRequestValidationError is the validation error returned by
+// AdminReconcileListRequest.Validate if the designated constraints aren't met.
+type AdminReconcileListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileListRequestValidationError) ErrorName() string {
+	return "AdminReconcileListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileListRequestValidationError{}
+
+// Validate checks the field values on AdminReconcileListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileListReplyMultiError, or nil if none found.
+func (m *AdminReconcileListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReconcileListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReconcileListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReconcileListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminReconcileListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileListReplyMultiError) AllErrors() []error { return m }
+
+// AdminReconcileListReplyValidationError is the validation error returned by
+// AdminReconcileListReply.Validate if the designated constraints aren't met.
+type AdminReconcileListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileListReplyValidationError) ErrorName() string {
+	return "AdminReconcileListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileListReplyValidationError{}
+
+// Validate checks the field values on AdminReconcileDetailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileDetailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileDetailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileDetailRequestMultiError, or nil if none found.
+func (m *AdminReconcileDetailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileDetailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResultId
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminReconcileDetailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileDetailRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminReconcileDetailRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminReconcileDetailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileDetailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileDetailRequestMultiError) AllErrors() []error { return m }
+
+// AdminReconcileDetailRequestValidationError is the validation error returned
+// by AdminReconcileDetailRequest.Validate if the designated constraints
+// aren't met.
+type AdminReconcileDetailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileDetailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileDetailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileDetailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileDetailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileDetailRequestValidationError) ErrorName() string {
+	return "AdminReconcileDetailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileDetailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileDetailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileDetailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileDetailRequestValidationError{}
+
+// Validate checks the field values on AdminReconcileDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileDetailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileDetailReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileDetailReplyMultiError, or nil if none found.
+func (m *AdminReconcileDetailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileDetailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReconcileDetailReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReconcileDetailReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReconcileDetailReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminReconcileDetailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileDetailReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileDetailReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminReconcileDetailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileDetailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileDetailReplyMultiError) AllErrors() []error { return m }
+
+// AdminReconcileDetailReplyValidationError is the validation error returned by
+// AdminReconcileDetailReply.Validate if the designated constraints aren't met.
+type AdminReconcileDetailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileDetailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileDetailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileDetailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileDetailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileDetailReplyValidationError) ErrorName() string {
+	return "AdminReconcileDetailReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileDetailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileDetailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileDetailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileDetailReplyValidationError{}
+
+// Validate checks the field values on AdminLedgerVerifyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerVerifyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerVerifyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerVerifyRequestMultiError, or nil if none found.
+func (m *AdminLedgerVerifyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerVerifyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Opening
+
+	// no validation rules for Fix
+
+	if len(errors) > 0 {
+		return AdminLedgerVerifyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerVerifyRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerVerifyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerVerifyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerVerifyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerVerifyRequestMultiError) AllErrors() []error { return m }
+
+// AdminLedgerVerifyRequestValidationError is the validation error returned by
+// AdminLedgerVerifyRequest.Validate if the designated constraints aren't met.
+type AdminLedgerVerifyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerVerifyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerVerifyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerVerifyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerVerifyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerVerifyRequestValidationError) ErrorName() string {
+	return "AdminLedgerVerifyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerVerifyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerVerifyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerVerifyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerVerifyRequestValidationError{}
+
+// Validate checks the field values on AdminLedgerVerifyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerVerifyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerVerifyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerVerifyReplyMultiError, or nil if none found.
+func (m *AdminLedgerVerifyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerVerifyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLedgerVerifyReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLedgerVerifyReplyValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLedgerVerifyReplyValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	// no validation rules for Opening
+
+	if len(errors) > 0 {
+		return AdminLedgerVerifyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerVerifyReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerVerifyReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerVerifyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerVerifyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerVerifyReplyMultiError) AllErrors() []error { return m }
+
+// AdminLedgerVerifyReplyValidationError is the validation error returned by
+// AdminLedgerVerifyReply.Validate if the designated constraints aren't met.
+type AdminLedgerVerifyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerVerifyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerVerifyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerVerifyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerVerifyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerVerifyReplyValidationError) ErrorName() string {
+	return "AdminLedgerVerifyReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerVerifyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerVerifyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerVerifyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerVerifyReplyValidationError{}
+
+// Validate checks the field values on AdminRecommendAreaCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendAreaCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendAreaCheckRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRecommendAreaCheckRequestMultiError, or nil if none found.
+func (m *AdminRecommendAreaCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendAreaCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fix
+
+	if len(errors) > 0 {
+		return AdminRecommendAreaCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendAreaCheckRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminRecommendAreaCheckRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRecommendAreaCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendAreaCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendAreaCheckRequestMultiError) AllErrors() []error { return m }
+
+// AdminRecommendAreaCheckRequestValidationError is the validation error
+// returned by AdminRecommendAreaCheckRequest.Validate if the designated
+// constraints aren't met.
+type AdminRecommendAreaCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendAreaCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendAreaCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendAreaCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendAreaCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendAreaCheckRequestValidationError) ErrorName() string {
+	return "AdminRecommendAreaCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendAreaCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendAreaCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendAreaCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendAreaCheckRequestValidationError{}
+
+// Validate checks the field values on AdminRecommendAreaCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendAreaCheckReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendAreaCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRecommendAreaCheckReplyMultiError, or nil if none found.
+func (m *AdminRecommendAreaCheckReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendAreaCheckReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRecommendAreaCheckReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRecommendAreaCheckReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRecommendAreaCheckReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	// no validation rules for Fixed
+
+	if len(errors) > 0 {
+		return AdminRecommendAreaCheckReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendAreaCheckReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminRecommendAreaCheckReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminRecommendAreaCheckReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendAreaCheckReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendAreaCheckReplyMultiError) AllErrors() []error { return m }
+
+// AdminRecommendAreaCheckReplyValidationError is the validation error returned
+// by AdminRecommendAreaCheckReply.Validate if the designated constraints
+// aren't met.
+type AdminRecommendAreaCheckReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendAreaCheckReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendAreaCheckReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendAreaCheckReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendAreaCheckReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendAreaCheckReplyValidationError) ErrorName() string {
+	return "AdminRecommendAreaCheckReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendAreaCheckReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendAreaCheckReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendAreaCheckReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendAreaCheckReplyValidationError{}
+
+// Validate checks the field values on AdminUserMoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserMoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserMoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserMoveRequestMultiError, or nil if none found.
+func (m *AdminUserMoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserMoveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUserMoveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUserMoveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUserMoveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUserMoveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserMoveRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserMoveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserMoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserMoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserMoveRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserMoveRequestValidationError is the validation error returned by
+// AdminUserMoveRequest.Validate if the designated constraints aren't met.
+type AdminUserMoveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserMoveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserMoveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserMoveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserMoveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserMoveRequestValidationError) ErrorName() string {
+	return "AdminUserMoveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserMoveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserMoveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserMoveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserMoveRequestValidationError{}
+
+// Validate checks the field values on AdminUserMoveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserMoveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserMoveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserMoveReplyMultiError, or nil if none found.
+func (m *AdminUserMoveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserMoveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminUserMoveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserMoveReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserMoveReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserMoveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserMoveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserMoveReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserMoveReplyValidationError is the validation error returned by
+// AdminUserMoveReply.Validate if the designated constraints aren't met.
+type AdminUserMoveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserMoveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserMoveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserMoveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserMoveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserMoveReplyValidationError) ErrorName() string {
+	return "AdminUserMoveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserMoveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserMoveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserMoveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserMoveReplyValidationError{}
+
+// Validate checks the field values on AdminVipLevelListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLevelListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLevelListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLevelListRequestMultiError, or nil if none found.
+func (m *AdminVipLevelListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLevelListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipLevelListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLevelListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLevelListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipLevelListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLevelListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLevelListRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipLevelListRequestValidationError is the validation error returned by
+// AdminVipLevelListRequest.Validate if the designated constraints aren't met.
+type AdminVipLevelListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLevelListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLevelListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLevelListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLevelListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLevelListRequestValidationError) ErrorName() string {
+	return "AdminVipLevelListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLevelListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLevelListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLevelListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLevelListRequestValidationError{}
+
+// Validate checks the field values on AdminVipLevelListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLevelListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLevelListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLevelListReplyMultiError, or nil if none found.
+func (m *AdminVipLevelListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLevelListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLevels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminVipLevelListReplyValidationError{
+						field:  fmt.Sprintf("Levels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminVipLevelListReplyValidationError{
+						field:  fmt.Sprintf("Levels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminVipLevelListReplyValidationError{
+					field:  fmt.Sprintf("Levels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminVipLevelListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLevelListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLevelListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipLevelListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLevelListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLevelListReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipLevelListReplyValidationError is the validation error returned by
+// AdminVipLevelListReply.Validate if the designated constraints aren't met.
+type AdminVipLevelListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLevelListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLevelListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLevelListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLevelListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLevelListReplyValidationError) ErrorName() string {
+	return "AdminVipLevelListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLevelListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLevelListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLevelListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLevelListReplyValidationError{}
+
+// Validate checks the field values on AdminVipLevelUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLevelUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLevelUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLevelUpdateRequestMultiError, or nil if none found.
+func (m *AdminVipLevelUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLevelUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminVipLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminVipLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminVipLevelUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminVipLevelUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLevelUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminVipLevelUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminVipLevelUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLevelUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLevelUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipLevelUpdateRequestValidationError is the validation error returned
+// by AdminVipLevelUpdateRequest.Validate if the designated constraints aren't met.
+type AdminVipLevelUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLevelUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLevelUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLevelUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLevelUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLevelUpdateRequestValidationError) ErrorName() string {
+	return "AdminVipLevelUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLevelUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLevelUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLevelUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLevelUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminVipLevelUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLevelUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLevelUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLevelUpdateReplyMultiError, or nil if none found.
+func (m *AdminVipLevelUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLevelUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipLevelUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLevelUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLevelUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipLevelUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLevelUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLevelUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipLevelUpdateReplyValidationError is the validation error returned by
+// AdminVipLevelUpdateReply.Validate if the designated constraints aren't met.
+type AdminVipLevelUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLevelUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLevelUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLevelUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLevelUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLevelUpdateReplyValidationError) ErrorName() string {
+	return "AdminVipLevelUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLevelUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLevelUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLevelUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLevelUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminVipRecalcRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipRecalcRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipRecalcRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipRecalcRequestMultiError, or nil if none found.
+func (m *AdminVipRecalcRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipRecalcRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipRecalcRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipRecalcRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipRecalcRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipRecalcRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipRecalcRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipRecalcRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipRecalcRequestValidationError is the validation error returned by
+// AdminVipRecalcRequest.Validate if the designated constraints aren't met.
+type AdminVipRecalcRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipRecalcRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipRecalcRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipRecalcRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipRecalcRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipRecalcRequestValidationError) ErrorName() string {
+	return "AdminVipRecalcRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipRecalcRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipRecalcRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipRecalcRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipRecalcRequestValidationError{}
+
+// Validate checks the field values on AdminVipRecalcReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipRecalcReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipRecalcReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipRecalcReplyMultiError, or nil if none found.
+func (m *AdminVipRecalcReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipRecalcReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminVipRecalcReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipRecalcReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipRecalcReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipRecalcReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipRecalcReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipRecalcReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipRecalcReplyValidationError is the validation error returned by
+// AdminVipRecalcReply.Validate if the designated constraints aren't met.
+type AdminVipRecalcReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipRecalcReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipRecalcReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipRecalcReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipRecalcReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipRecalcReplyValidationError) ErrorName() string {
+	return "AdminVipRecalcReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipRecalcReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipRecalcReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipRecalcReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipRecalcReplyValidationError{}
+
+// Validate checks the field values on AdminVipLogListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLogListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLogListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLogListRequestMultiError, or nil if none found.
+func (m *AdminVipLogListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLogListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminVipLogListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLogListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLogListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipLogListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLogListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLogListRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipLogListRequestValidationError is the validation error returned by
+// AdminVipLogListRequest.Validate if the designated constraints aren't met.
+type AdminVipLogListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLogListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLogListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLogListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLogListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLogListRequestValidationError) ErrorName() string {
+	return "AdminVipLogListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLogListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLogListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLogListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLogListRequestValidationError{}
+
+// Validate checks the field values on AdminVipLogListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipLogListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipLogListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipLogListReplyMultiError, or nil if none found.
+func (m *AdminVipLogListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipLogListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminVipLogListReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminVipLogListReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminVipLogListReplyValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminVipLogListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipLogListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLogListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipLogListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipLogListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipLogListReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipLogListReplyValidationError is the validation error returned by
+// AdminVipLogListReply.Validate if the designated constraints aren't met.
+type AdminVipLogListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipLogListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipLogListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipLogListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipLogListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipLogListReplyValidationError) ErrorName() string {
+	return "AdminVipLogListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipLogListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipLogListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipLogListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipLogListReplyValidationError{}
+
+// Validate checks the field values on AdminDhbPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDhbPriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDhbPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDhbPriceRequestMultiError, or nil if none found.
+func (m *AdminDhbPriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDhbPriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDhbPriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDhbPriceRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDhbPriceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDhbPriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDhbPriceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDhbPriceRequestMultiError) AllErrors() []error { return m }
+
+// AdminDhbPriceRequestValidationError is the validation error returned by
+// AdminDhbPriceRequest.Validate if the designated constraints aren't met.
+type AdminDhbPriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDhbPriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDhbPriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDhbPriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDhbPriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDhbPriceRequestValidationError) ErrorName() string {
+	return "AdminDhbPriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDhbPriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDhbPriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDhbPriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDhbPriceRequestValidationError{}
+
+// Validate checks the field values on AdminDhbPriceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDhbPriceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDhbPriceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDhbPriceReplyMultiError, or nil if none found.
+func (m *AdminDhbPriceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDhbPriceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Price
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return AdminDhbPriceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDhbPriceReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminDhbPriceReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminDhbPriceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDhbPriceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDhbPriceReplyMultiError) AllErrors() []error { return m }
+
+// AdminDhbPriceReplyValidationError is the validation error returned by
+// AdminDhbPriceReply.Validate if the designated constraints aren't met.
+type AdminDhbPriceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDhbPriceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDhbPriceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDhbPriceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDhbPriceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDhbPriceReplyValidationError) ErrorName() string {
+	return "AdminDhbPriceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDhbPriceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDhbPriceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDhbPriceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDhbPriceReplyValidationError{}
+
+// Validate checks the field values on AdminDailyMetricsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyMetricsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyMetricsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyMetricsRequestMultiError, or nil if none found.
+func (m *AdminDailyMetricsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyMetricsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	if len(errors) > 0 {
+		return AdminDailyMetricsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyMetricsRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDailyMetricsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyMetricsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyMetricsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyMetricsRequestMultiError) AllErrors() []error { return m }
+
+// AdminDailyMetricsRequestValidationError is the validation error returned by
+// AdminDailyMetricsRequest.Validate if the designated constraints aren't met.
+type AdminDailyMetricsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyMetricsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyMetricsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyMetricsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyMetricsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyMetricsRequestValidationError) ErrorName() string {
+	return "AdminDailyMetricsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyMetricsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyMetricsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyMetricsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyMetricsRequestValidationError{}
+
+// Validate checks the field values on AdminDailyMetricsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyMetricsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyMetricsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyMetricsReplyMultiError, or nil if none found.
+func (m *AdminDailyMetricsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyMetricsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDailyMetricsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDailyMetricsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDailyMetricsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminDailyMetricsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyMetricsReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDailyMetricsReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyMetricsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyMetricsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyMetricsReplyMultiError) AllErrors() []error { return m }
+
+// AdminDailyMetricsReplyValidationError is the validation error returned by
+// AdminDailyMetricsReply.Validate if the designated constraints aren't met.
+type AdminDailyMetricsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyMetricsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyMetricsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyMetricsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyMetricsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyMetricsReplyValidationError) ErrorName() string {
+	return "AdminDailyMetricsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyMetricsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyMetricsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyMetricsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyMetricsReplyValidationError{}
+
+// Validate checks the field values on AdminDailyMetricsSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyMetricsSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyMetricsSeriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDailyMetricsSeriesRequestMultiError, or nil if none found.
+func (m *AdminDailyMetricsSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyMetricsSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Granularity
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AdminDailyMetricsSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyMetricsSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyMetricsSeriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyMetricsSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyMetricsSeriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyMetricsSeriesRequestMultiError) AllErrors() []error { return m }
+
+// AdminDailyMetricsSeriesRequestValidationError is the validation error
+// returned by AdminDailyMetricsSeriesRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyMetricsSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyMetricsSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyMetricsSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyMetricsSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyMetricsSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyMetricsSeriesRequestValidationError) ErrorName() string {
+	return "AdminDailyMetricsSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyMetricsSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyMetricsSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyMetricsSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyMetricsSeriesRequestValidationError{}
+
+// Validate checks the field values on AdminDailyMetricsSeriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyMetricsSeriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyMetricsSeriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyMetricsSeriesReplyMultiError, or nil if none found.
+func (m *AdminDailyMetricsSeriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyMetricsSeriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Granularity
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDailyMetricsSeriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDailyMetricsSeriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDailyMetricsSeriesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminDailyMetricsSeriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyMetricsSeriesReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyMetricsSeriesReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDailyMetricsSeriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyMetricsSeriesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyMetricsSeriesReplyMultiError) AllErrors() []error { return m }
+
+// AdminDailyMetricsSeriesReplyValidationError is the validation error returned
+// by AdminDailyMetricsSeriesReply.Validate if the designated constraints
+// aren't met.
+type AdminDailyMetricsSeriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyMetricsSeriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyMetricsSeriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyMetricsSeriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyMetricsSeriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyMetricsSeriesReplyValidationError) ErrorName() string {
+	return "AdminDailyMetricsSeriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyMetricsSeriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyMetricsSeriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyMetricsSeriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyMetricsSeriesReplyValidationError{}
+
+// Validate checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRequestValidationError{}
+
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfig() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminConfigReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminConfigReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigReplyValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateReplyValidationError{}
+
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EthAuthorizeRequest_SendBodyValidationError{}
+
+// Validate checks the field values on UserInfoReply_Matrix with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserInfoReply_Matrix) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserInfoReply_Matrix with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserInfoReply_MatrixMultiError, or nil if none found.
+func (m *UserInfoReply_Matrix) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserInfoReply_Matrix) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MatrixId
+
+	// no validation rules for Name
+
+	// no validation rules for Level
+
+	// no validation rules for Status
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for RowNum
+
+	// no validation rules for ColNum
+
+	if len(errors) > 0 {
+		return UserInfoReply_MatrixMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserInfoReply_MatrixMultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_Matrix.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfoReply_MatrixMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserInfoReply_MatrixMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserInfoReply_MatrixMultiError) AllErrors() []error { return m }
+
+// UserInfoReply_MatrixValidationError is the validation error returned by
+// UserInfoReply_Matrix.Validate if the designated constraints aren't met.
+type UserInfoReply_MatrixValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserInfoReply_MatrixValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserInfoReply_MatrixValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserInfoReply_MatrixValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserInfoReply_MatrixValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserInfoReply_MatrixValidationError) ErrorName() string {
+	return "UserInfoReply_MatrixValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserInfoReply_MatrixValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserInfoReply_Matrix.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserInfoReply_MatrixValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserInfoReply_MatrixValidationError{}
+
+// Validate checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListReply_ListValidationError{}
+
+// Validate checks the field values on RecommendRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RecommendRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListReply_ListValidationError{}
+
+// Validate checks the field values on FeeRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return FeeRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListReply_ListValidationError{}
+
+// Validate checks the field values on MyLocationListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MyLocationListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MyLocationListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MyLocationListReply_ListMultiError, or nil if none found.
+func (m *MyLocationListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MyLocationListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MatrixId
+
+	// no validation rules for Level
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for StopDate
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	// no validation rules for Progress
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for RowReward
+
+	// no validation rules for ColReward
+
+	// no validation rules for RecommendReward
+
+	// no validation rules for RecommendVipReward
+
+	// no validation rules for FeeReward
+
+	// no validation rules for Total
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return MyLocationListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// MyLocationListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by MyLocationListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type MyLocationListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MyLocationListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MyLocationListReply_ListMultiError) AllErrors() []error { return m }
+
+// MyLocationListReply_ListValidationError is the validation error returned by
+// MyLocationListReply_List.Validate if the designated constraints aren't met.
+type MyLocationListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MyLocationListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MyLocationListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MyLocationListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MyLocationListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MyLocationListReply_ListValidationError) ErrorName() string {
+	return "MyLocationListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MyLocationListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMyLocationListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MyLocationListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MyLocationListReply_ListValidationError{}
+
+// Validate checks the field values on LocationNeighbourReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocationNeighbourReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocationNeighbourReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocationNeighbourReply_ListMultiError, or nil if none found.
+func (m *LocationNeighbourReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocationNeighbourReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Level
+
+	// no validation rules for Progress
+
+	// no validation rules for Status
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	if len(errors) > 0 {
+		return LocationNeighbourReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocationNeighbourReply_ListMultiError is an error wrapping multiple
+// validation errors returned by LocationNeighbourReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type LocationNeighbourReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocationNeighbourReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocationNeighbourReply_ListMultiError) AllErrors() []error { return m }
+
+// LocationNeighbourReply_ListValidationError is the validation error returned
+// by LocationNeighbourReply_List.Validate if the designated constraints
+// aren't met.
+type LocationNeighbourReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationNeighbourReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationNeighbourReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationNeighbourReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationNeighbourReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationNeighbourReply_ListValidationError) ErrorName() string {
+	return "LocationNeighbourReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocationNeighbourReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationNeighbourReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationNeighbourReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationNeighbourReply_ListValidationError{}
+
+// Validate checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return WithdrawListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListReply_ListValidationError{}
+
+// Validate checks the field values on RecommendTreeReply_Depth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeReply_Depth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeReply_Depth with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeReply_DepthMultiError, or nil if none found.
+func (m *RecommendTreeReply_Depth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeReply_Depth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Depth
+
+	// no validation rules for Num
+
+	// no validation rules for ActiveNum
+
+	if len(errors) > 0 {
+		return RecommendTreeReply_DepthMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeReply_DepthMultiError is an error wrapping multiple validation
+// errors returned by RecommendTreeReply_Depth.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendTreeReply_DepthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeReply_DepthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeReply_DepthMultiError) AllErrors() []error { return m }
+
+// RecommendTreeReply_DepthValidationError is the validation error returned by
+// RecommendTreeReply_Depth.Validate if the designated constraints aren't met.
+type RecommendTreeReply_DepthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeReply_DepthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeReply_DepthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeReply_DepthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeReply_DepthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeReply_DepthValidationError) ErrorName() string {
+	return "RecommendTreeReply_DepthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeReply_DepthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeReply_Depth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeReply_DepthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeReply_DepthValidationError{}
+
+// Validate checks the field values on RecommendTreeReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeReply_ListMultiError, or nil if none found.
+func (m *RecommendTreeReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for TeamNum
+
+	// no validation rules for ActiveNum
+
+	// no validation rules for TeamAmount
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return RecommendTreeReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendTreeReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendTreeReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeReply_ListMultiError) AllErrors() []error { return m }
+
+// RecommendTreeReply_ListValidationError is the validation error returned by
+// RecommendTreeReply_List.Validate if the designated constraints aren't met.
+type RecommendTreeReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeReply_ListValidationError) ErrorName() string {
+	return "RecommendTreeReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeReply_ListValidationError{}
+
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RecommendListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListReply_ListValidationError{}
+
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return WithdrawRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawRequest_SendBodyValidationError{}
+
+// Validate checks the field values on TransferRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferRequest_SendBodyMultiError, or nil if none found.
+func (m *TransferRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return TransferRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by TransferRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type TransferRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// TransferRequest_SendBodyValidationError is the validation error returned by
+// TransferRequest_SendBody.Validate if the designated constraints aren't met.
+type TransferRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferRequest_SendBodyValidationError) ErrorName() string {
+	return "TransferRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferRequest_SendBodyValidationError{}
+
+// Validate checks the field values on ConvertRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertRequest_SendBodyMultiError, or nil if none found.
+func (m *ConvertRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return ConvertRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by ConvertRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// ConvertRequest_SendBodyValidationError is the validation error returned by
+// ConvertRequest_SendBody.Validate if the designated constraints aren't met.
+type ConvertRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertRequest_SendBodyValidationError) ErrorName() string {
+	return "ConvertRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertRequest_SendBodyValidationError{}
+
+// Validate checks the field values on ReinvestRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReinvestRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReinvestRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReinvestRequest_SendBodyMultiError, or nil if none found.
+func (m *ReinvestRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReinvestRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return ReinvestRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReinvestRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by ReinvestRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type ReinvestRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReinvestRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReinvestRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// ReinvestRequest_SendBodyValidationError is the validation error returned by
+// ReinvestRequest_SendBody.Validate if the designated constraints aren't met.
+type ReinvestRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReinvestRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReinvestRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReinvestRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReinvestRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReinvestRequest_SendBodyValidationError) ErrorName() string {
+	return "ReinvestRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReinvestRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReinvestRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReinvestRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReinvestRequest_SendBodyValidationError{}
+
+// Validate checks the field values on SetAutoReinvestRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAutoReinvestRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAutoReinvestRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAutoReinvestRequest_SendBodyMultiError, or nil if none found.
+func (m *SetAutoReinvestRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAutoReinvestRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return SetAutoReinvestRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAutoReinvestRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by SetAutoReinvestRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type SetAutoReinvestRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAutoReinvestRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAutoReinvestRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// SetAutoReinvestRequest_SendBodyValidationError is the validation error
+// returned by SetAutoReinvestRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type SetAutoReinvestRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAutoReinvestRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAutoReinvestRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAutoReinvestRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAutoReinvestRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAutoReinvestRequest_SendBodyValidationError) ErrorName() string {
+	return "SetAutoReinvestRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAutoReinvestRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAutoReinvestRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAutoReinvestRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAutoReinvestRequest_SendBodyValidationError{}
+
+// Validate checks the field values on UpgradeLocationRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpgradeLocationRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpgradeLocationRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpgradeLocationRequest_SendBodyMultiError, or nil if none found.
+func (m *UpgradeLocationRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpgradeLocationRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return UpgradeLocationRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpgradeLocationRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by UpgradeLocationRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type UpgradeLocationRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpgradeLocationRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpgradeLocationRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// UpgradeLocationRequest_SendBodyValidationError is the validation error
+// returned by UpgradeLocationRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type UpgradeLocationRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeLocationRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeLocationRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeLocationRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeLocationRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeLocationRequest_SendBodyValidationError) ErrorName() string {
+	return "UpgradeLocationRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeLocationRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeLocationRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeLocationRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeLocationRequest_SendBodyValidationError{}
+
+// Validate checks the field values on ReserveProofReply_Proof with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReserveProofReply_Proof) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveProofReply_Proof with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveProofReply_ProofMultiError, or nil if none found.
+func (m *ReserveProofReply_Proof) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveProofReply_Proof) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return ReserveProofReply_ProofMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReserveProofReply_ProofMultiError is an error wrapping multiple validation
+// errors returned by ReserveProofReply_Proof.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveProofReply_ProofMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveProofReply_ProofMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveProofReply_ProofMultiError) AllErrors() []error { return m }
+
+// ReserveProofReply_ProofValidationError is the validation error returned by
+// ReserveProofReply_Proof.Validate if the designated constraints aren't met.
+type ReserveProofReply_ProofValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveProofReply_ProofValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveProofReply_ProofValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveProofReply_ProofValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveProofReply_ProofValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveProofReply_ProofValidationError) ErrorName() string {
+	return "ReserveProofReply_ProofValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReserveProofReply_ProofValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveProofReply_Proof.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveProofReply_ProofValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveProofReply_ProofValidationError{}
+
+// Validate checks the field values on StatementReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatementReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatementReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatementReply_ListMultiError, or nil if none found.
+func (m *StatementReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatementReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for CoinType
+
+	// no validation rules for Amount
+
+	// no validation rules for Balance
+
+	// no validation rules for Status
+
+	// no validation rules for Ref
+
+	if len(errors) > 0 {
+		return StatementReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatementReply_ListMultiError is an error wrapping multiple validation
+// errors returned by StatementReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type StatementReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatementReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatementReply_ListMultiError) AllErrors() []error { return m }
+
+// StatementReply_ListValidationError is the validation error returned by
+// StatementReply_List.Validate if the designated constraints aren't met.
+type StatementReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatementReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatementReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatementReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatementReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatementReply_ListValidationError) ErrorName() string {
+	return "StatementReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatementReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatementReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatementReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatementReply_ListValidationError{}
+
+// Validate checks the field values on AdminRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Address
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AdminRewardListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRewardListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReply_ListValidationError{}
+
+// Validate checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply_UserList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply_UserList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Vip
+
+	// no validation rules for MonthRecommend
+
+	// no validation rules for HistoryRecommend
+
+	// no validation rules for TeamNum
+
+	// no validation rules for TeamActiveNum
+
+	// no validation rules for TeamAmount
+
+	// no validation rules for AreaAmount
+
+	if len(errors) > 0 {
+		return AdminUserListReply_UserListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserListReply_UserListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReply_UserListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
+// aren't met.
+type AdminUserListReply_UserListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReply_UserListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReply_UserListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReply_UserListValidationError{}
+
+// Validate checks the field values on AdminLocationListReply_LocationList with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationListReply_LocationList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	// no validation rules for MatrixId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminLocationListReply_LocationListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReply_LocationListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReply_LocationListValidationError{}
+
+// Validate checks the field values on AdminLocationEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationEventListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLocationEventListReply_ListMultiError, or nil if none found.
+func (m *AdminLocationEventListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationEventListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Type
+
+	// no validation rules for BeforeStatus
+
+	// no validation rules for AfterStatus
+
+	// no validation rules for BeforeCurrent
+
+	// no validation rules for AfterCurrent
+
+	// no validation rules for BeforeRow
+
+	// no validation rules for AfterRow
+
+	// no validation rules for BeforeCol
+
+	// no validation rules for AfterCol
+
+	// no validation rules for StopDate
+
+	// no validation rules for TriggerType
+
+	// no validation rules for TriggerRef
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for BeforeLevel
+
+	// no validation rules for AfterLevel
+
+	// no validation rules for BeforeMax
+
+	// no validation rules for AfterMax
+
+	if len(errors) > 0 {
+		return AdminLocationEventListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationEventListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationEventListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationEventListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationEventListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationEventListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminLocationEventListReply_ListValidationError is the validation error
+// returned by AdminLocationEventListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminLocationEventListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationEventListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationEventListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationEventListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationEventListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationEventListReply_ListValidationError) ErrorName() string {
+	return "AdminLocationEventListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationEventListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationEventListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationEventListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationEventListReply_ListValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawListReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawListReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReply_ListValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminUserRecommendReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AdminUserRecommendReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserRecommendReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendReply_ListValidationError is the validation error returned
+// by AdminUserRecommendReply_List.Validate if the designated constraints
+// aren't met.
+type AdminUserRecommendReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminUserRecommendReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendReply_ListValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for RecommendAddress
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReply_ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminMonthRecommendReply_ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReply_ListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReply_ListValidationError is the validation error
+// returned by AdminMonthRecommendReply_List.Validate if the designated
+// constraints aren't met.
+type AdminMonthRecommendReply_ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminMonthRecommendReply_ListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReply_ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReply_ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReply_ListValidationError{}
+
+// Validate checks the field values on AdminBalanceAdjustRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminBalanceAdjustRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceAdjustRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminBalanceAdjustRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceAdjustRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Direction
+
+	// no validation rules for CoinType
+
+	// no validation rules for Amount
+
+	// no validation rules for Reason
+
+	// no validation rules for Ticket
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return AdminBalanceAdjustRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// AdminBalanceAdjustRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminBalanceAdjustRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+type AdminBalanceAdjustRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m AdminBalanceAdjustRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4382,11 +16277,12 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AdminBalanceAdjustRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// AdminBalanceAdjustRequest_SendBodyValidationError is the validation error
+// returned by AdminBalanceAdjustRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminBalanceAdjustRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4394,24 +16290,24 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminBalanceAdjustRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e AdminBalanceAdjustRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4423,14 +16319,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sAdminBalanceAdjustRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = AdminBalanceAdjustRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4438,48 +16334,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = AdminBalanceAdjustRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+// Validate checks the field values on
+// AdminBalanceAdjustApproveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceAdjustApproveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminBalanceAdjustApproveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminBalanceAdjustApproveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminBalanceAdjustApproveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *AdminBalanceAdjustApproveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Id
 
-	// no validation rules for Page
+	// no validation rules for Approve
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return AdminBalanceAdjustApproveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// AdminBalanceAdjustApproveRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminBalanceAdjustApproveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceAdjustApproveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m AdminBalanceAdjustApproveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4488,11 +16387,12 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m AdminBalanceAdjustApproveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// AdminBalanceAdjustApproveRequest_SendBodyValidationError is the validation
+// error returned by AdminBalanceAdjustApproveRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminBalanceAdjustApproveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4500,24 +16400,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminBalanceAdjustApproveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e AdminBalanceAdjustApproveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4529,14 +16429,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sAdminBalanceAdjustApproveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = AdminBalanceAdjustApproveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4544,80 +16444,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = AdminBalanceAdjustApproveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on AdminBalanceAdjustListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminBalanceAdjustListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminBalanceAdjustListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminBalanceAdjustListReply_ListMultiError, or nil if none found.
+func (m *AdminBalanceAdjustListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *AdminBalanceAdjustListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Address
 
-	}
+	// no validation rules for Direction
 
-	// no validation rules for Count
+	// no validation rules for CoinType
+
+	// no validation rules for Amount
+
+	// no validation rules for Reason
+
+	// no validation rules for Ticket
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for ApprovedBy
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
+		return AdminBalanceAdjustListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigReplyMultiError []error
+// AdminBalanceAdjustListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminBalanceAdjustListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceAdjustListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m AdminBalanceAdjustListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4626,11 +16514,12 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m AdminBalanceAdjustListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// AdminBalanceAdjustListReply_ListValidationError is the validation error
+// returned by AdminBalanceAdjustListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminBalanceAdjustListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4638,22 +16527,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e AdminBalanceAdjustListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e AdminBalanceAdjustListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e AdminBalanceAdjustListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e AdminBalanceAdjustListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e AdminBalanceAdjustListReply_ListValidationError) ErrorName() string {
+	return "AdminBalanceAdjustListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e AdminBalanceAdjustListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4665,14 +16556,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sAdminBalanceAdjustListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = AdminBalanceAdjustListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4680,73 +16571,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = AdminBalanceAdjustListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// Validate checks the field values on ReserveSnapshot_Wallets with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *ReserveSnapshot_Wallets) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// ValidateAll checks the field values on ReserveSnapshot_Wallets with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// ReserveSnapshot_WalletsMultiError, or nil if none found.
+func (m *ReserveSnapshot_Wallets) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *ReserveSnapshot_Wallets) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Address
+
+	// no validation rules for Balance
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return ReserveSnapshot_WalletsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// ReserveSnapshot_WalletsMultiError is an error wrapping multiple validation
+// errors returned by ReserveSnapshot_Wallets.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+type ReserveSnapshot_WalletsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m ReserveSnapshot_WalletsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4755,11 +16621,11 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m ReserveSnapshot_WalletsMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// ReserveSnapshot_WalletsValidationError is the validation error returned by
+// ReserveSnapshot_Wallets.Validate if the designated constraints aren't met.
+type ReserveSnapshot_WalletsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4767,24 +16633,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e ReserveSnapshot_WalletsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e ReserveSnapshot_WalletsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e ReserveSnapshot_WalletsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e ReserveSnapshot_WalletsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e ReserveSnapshot_WalletsValidationError) ErrorName() string {
+	return "ReserveSnapshot_WalletsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e ReserveSnapshot_WalletsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4796,14 +16662,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sReserveSnapshot_Wallets.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = ReserveSnapshot_WalletsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4811,44 +16677,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
+} = ReserveSnapshot_WalletsValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+// Validate checks the field values on AdminDepositReverseRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositReverseRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositReverseRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositReverseRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositReverseRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *AdminDepositReverseRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Hash
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return AdminDepositReverseRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// AdminDepositReverseRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositReverseRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+type AdminDepositReverseRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m AdminDepositReverseRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4857,11 +16729,12 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositReverseRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// AdminDepositReverseRequest_SendBodyValidationError is the validation error
+// returned by AdminDepositReverseRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminDepositReverseRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4869,24 +16742,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminDepositReverseRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositReverseRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositReverseRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositReverseRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e AdminDepositReverseRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositReverseRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e AdminDepositReverseRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4898,14 +16771,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sAdminDepositReverseRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = AdminDepositReverseRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4913,48 +16786,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = AdminDepositReverseRequest_SendBodyValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// Validate checks the field values on AdminReserveMerkleReply_Leaves with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminReserveMerkleReply_Leaves) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminReserveMerkleReply_Leaves with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminReserveMerkleReply_LeavesMultiError, or nil if none found.
+func (m *AdminReserveMerkleReply_Leaves) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminReserveMerkleReply_Leaves) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
 	// no validation rules for Address
 
-	// no validation rules for Code
+	// no validation rules for Balance
+
+	// no validation rules for Leaf
+
+	// no validation rules for Nonce
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminReserveMerkleReply_LeavesMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// AdminReserveMerkleReply_LeavesMultiError is an error wrapping multiple
+// validation errors returned by AdminReserveMerkleReply_Leaves.ValidateAll()
+// if the designated constraints aren't met.
+type AdminReserveMerkleReply_LeavesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminReserveMerkleReply_LeavesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4963,12 +16842,12 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminReserveMerkleReply_LeavesMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// AdminReserveMerkleReply_LeavesValidationError is the validation error
+// returned by AdminReserveMerkleReply_Leaves.Validate if the designated
+// constraints aren't met.
+type AdminReserveMerkleReply_LeavesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4976,24 +16855,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminReserveMerkleReply_LeavesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminReserveMerkleReply_LeavesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminReserveMerkleReply_LeavesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminReserveMerkleReply_LeavesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminReserveMerkleReply_LeavesValidationError) ErrorName() string {
+	return "AdminReserveMerkleReply_LeavesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminReserveMerkleReply_LeavesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5005,14 +16884,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminReserveMerkleReply_Leaves.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminReserveMerkleReply_LeavesValidationError{}
 
 var _ interface {
 	Field() string
@@ -5020,52 +16899,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminReserveMerkleReply_LeavesValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
+// Validate checks the field values on AdminReconcileReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+func (m *AdminReconcileReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminReconcileReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// AdminReconcileReply_ListMultiError, or nil if none found.
+func (m *AdminReconcileReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminReconcileReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Day
 
-	// no validation rules for LocationStatus
+	// no validation rules for Name
 
-	// no validation rules for Type
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Num
+
+	// no validation rules for Alert
 
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminReconcileReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
+// AdminReconcileReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type RewardListReply_ListMultiError []error
+type AdminReconcileReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminReconcileReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5074,11 +16959,11 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminReconcileReply_ListMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminReconcileReply_ListValidationError is the validation error returned by
+// AdminReconcileReply_List.Validate if the designated constraints aren't met.
+type AdminReconcileReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5086,24 +16971,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminReconcileReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminReconcileReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminReconcileReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminReconcileReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminReconcileReply_ListValidationError) ErrorName() string {
+	return "AdminReconcileReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminReconcileReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5115,14 +17000,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminReconcileReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminReconcileReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5130,52 +17015,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = AdminReconcileReply_ListValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
+// Validate checks the field values on AdminReconcileListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+func (m *AdminReconcileListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminReconcileListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileListReply_ListMultiError, or nil if none found.
+func (m *AdminReconcileListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminReconcileListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Day
 
-	// no validation rules for Type
+	// no validation rules for Name
 
-	// no validation rules for Reason
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Num
+
+	// no validation rules for Alert
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminReconcileListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+// AdminReconcileListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminReconcileListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminReconcileListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminReconcileListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5184,12 +17077,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminReconcileListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
-// constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+// AdminReconcileListReply_ListValidationError is the validation error returned
+// by AdminReconcileListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminReconcileListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5197,24 +17090,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminReconcileListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminReconcileListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminReconcileListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminReconcileListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminReconcileListReply_ListValidationError) ErrorName() string {
+	return "AdminReconcileListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminReconcileListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5226,14 +17119,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminReconcileListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminReconcileListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5241,48 +17134,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminReconcileListReply_ListValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminReconcileDetailReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+func (m *AdminReconcileDetailReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminReconcileDetailReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminReconcileDetailReply_ListMultiError, or nil if none found.
+func (m *AdminReconcileDetailReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminReconcileDetailReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for UserId
 
-	// no validation rules for Amount
+	// no validation rules for Address
+
+	// no validation rules for RecordId
+
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminReconcileDetailReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+// AdminReconcileDetailReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminReconcileDetailReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminReconcileDetailReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminReconcileDetailReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5291,11 +17192,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminReconcileDetailReply_ListMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminReconcileDetailReply_ListValidationError is the validation error
+// returned by AdminReconcileDetailReply_List.Validate if the designated
+// constraints aren't met.
+type AdminReconcileDetailReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5303,24 +17205,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminReconcileDetailReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminReconcileDetailReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminReconcileDetailReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminReconcileDetailReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminReconcileDetailReply_ListValidationError) ErrorName() string {
+	return "AdminReconcileDetailReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminReconcileDetailReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5332,14 +17234,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminReconcileDetailReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminReconcileDetailReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5347,52 +17249,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminReconcileDetailReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLedgerVerifyReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+func (m *AdminLedgerVerifyReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLedgerVerifyReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// AdminLedgerVerifyReply_ListMultiError, or nil if none found.
+func (m *AdminLedgerVerifyReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminLedgerVerifyReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for UserId
 
-	// no validation rules for Amount
+	// no validation rules for BalanceUsdt
 
-	// no validation rules for Type
+	// no validation rules for LedgerBalanceUsdt
 
-	// no validation rules for Status
+	// no validation rules for BalanceDhb
+
+	// no validation rules for LedgerBalanceDhb
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminLedgerVerifyReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+// AdminLedgerVerifyReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerVerifyReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLedgerVerifyReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminLedgerVerifyReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5401,11 +17305,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLedgerVerifyReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminLedgerVerifyReply_ListValidationError is the validation error returned
+// by AdminLedgerVerifyReply_List.Validate if the designated constraints
+// aren't met.
+type AdminLedgerVerifyReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5413,24 +17318,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLedgerVerifyReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLedgerVerifyReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLedgerVerifyReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLedgerVerifyReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminLedgerVerifyReply_ListValidationError) ErrorName() string {
+	return "AdminLedgerVerifyReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminLedgerVerifyReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5442,14 +17347,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminLedgerVerifyReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminLedgerVerifyReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5457,48 +17362,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminLedgerVerifyReply_ListValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLedgerVerifyReply_Accounts with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+func (m *AdminLedgerVerifyReply_Accounts) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminLedgerVerifyReply_Accounts with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLedgerVerifyReply_AccountsMultiError, or nil if none found.
+func (m *AdminLedgerVerifyReply_Accounts) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminLedgerVerifyReply_Accounts) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for Account
 
-	// no validation rules for Status
+	// no validation rules for CoinType
+
+	// no validation rules for Balance
 
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminLedgerVerifyReply_AccountsMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListReply_ListMultiError []error
+// AdminLedgerVerifyReply_AccountsMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerVerifyReply_Accounts.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLedgerVerifyReply_AccountsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminLedgerVerifyReply_AccountsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5507,11 +17414,12 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLedgerVerifyReply_AccountsMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminLedgerVerifyReply_AccountsValidationError is the validation error
+// returned by AdminLedgerVerifyReply_Accounts.Validate if the designated
+// constraints aren't met.
+type AdminLedgerVerifyReply_AccountsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5519,24 +17427,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLedgerVerifyReply_AccountsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLedgerVerifyReply_AccountsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLedgerVerifyReply_AccountsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLedgerVerifyReply_AccountsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminLedgerVerifyReply_AccountsValidationError) ErrorName() string {
+	return "AdminLedgerVerifyReply_AccountsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminLedgerVerifyReply_AccountsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5548,14 +17456,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminLedgerVerifyReply_Accounts.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminLedgerVerifyReply_AccountsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5563,48 +17471,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminLedgerVerifyReply_AccountsValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminRecommendAreaCheckReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminRecommendAreaCheckReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminRecommendAreaCheckReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminRecommendAreaCheckReply_ListMultiError, or nil if none found.
+func (m *AdminRecommendAreaCheckReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminRecommendAreaCheckReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for RecommendCode
 
-	// no validation rules for Amount
+	// no validation rules for Num
+
+	// no validation rules for ExpectNum
+
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminRecommendAreaCheckReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// AdminRecommendAreaCheckReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminRecommendAreaCheckReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+type AdminRecommendAreaCheckReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminRecommendAreaCheckReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5613,11 +17527,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminRecommendAreaCheckReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminRecommendAreaCheckReply_ListValidationError is the validation error
+// returned by AdminRecommendAreaCheckReply_List.Validate if the designated
+// constraints aren't met.
+type AdminRecommendAreaCheckReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5625,24 +17540,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminRecommendAreaCheckReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminRecommendAreaCheckReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminRecommendAreaCheckReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminRecommendAreaCheckReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminRecommendAreaCheckReply_ListValidationError) ErrorName() string {
+	return "AdminRecommendAreaCheckReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminRecommendAreaCheckReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5654,14 +17569,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminRecommendAreaCheckReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminRecommendAreaCheckReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5669,54 +17584,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminRecommendAreaCheckReply_ListValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserMoveRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+func (m *AdminUserMoveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserMoveRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUserMoveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUserMoveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminUserMoveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
+	// no validation rules for UserId
 
-	// no validation rules for Address
+	// no validation rules for RecommendUserId
 
 	// no validation rules for Reason
 
+	// no validation rules for Recompute
+
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminUserMoveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminUserMoveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminUserMoveRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUserMoveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminUserMoveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5725,11 +17638,12 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUserMoveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminUserMoveRequest_SendBodyValidationError is the validation error
+// returned by AdminUserMoveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminUserMoveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5737,24 +17651,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminUserMoveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUserMoveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUserMoveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUserMoveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminUserMoveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUserMoveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminUserMoveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5766,14 +17680,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminUserMoveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminUserMoveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5781,60 +17695,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminUserMoveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
+// Validate checks the field values on AdminVipLevelListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+func (m *AdminVipLevelListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// ValidateAll checks the field values on AdminVipLevelListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// AdminVipLevelListReply_ListMultiError, or nil if none found.
+func (m *AdminVipLevelListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AdminVipLevelListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Level
 
-	// no validation rules for BalanceUsdt
+	// no validation rules for NeedRecommend
 
-	// no validation rules for BalanceDhb
+	// no validation rules for NeedTeam
 
-	// no validation rules for Vip
+	// no validation rules for NeedArea
 
-	// no validation rules for MonthRecommend
+	// no validation rules for Rate
 
-	// no validation rules for HistoryRecommend
+	// no validation rules for ExpireDays
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AdminVipLevelListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// AdminVipLevelListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminVipLevelListReply_List.ValidateAll() if
 // the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+type AdminVipLevelListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AdminVipLevelListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5843,12 +17755,12 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AdminVipLevelListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
+// AdminVipLevelListReply_ListValidationError is the validation error returned
+// by AdminVipLevelListReply_List.Validate if the designated constraints
 // aren't met.
-type AdminUserListReply_UserListValidationError struct {
+type AdminVipLevelListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5856,24 +17768,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AdminVipLevelListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AdminVipLevelListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AdminVipLevelListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AdminVipLevelListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AdminVipLevelListReply_ListValidationError) ErrorName() string {
+	return "AdminVipLevelListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AdminVipLevelListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5885,14 +17797,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAdminVipLevelListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AdminVipLevelListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5900,62 +17812,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AdminVipLevelListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
+// Validate checks the field values on AdminVipLevelUpdateRequest_SendBody with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+func (m *AdminVipLevelUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// ValidateAll checks the field values on AdminVipLevelUpdateRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// AdminVipLevelUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminVipLevelUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *AdminVipLevelUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for Row
+	// no validation rules for Level
 
-	// no validation rules for Col
+	// no validation rules for NeedRecommend
 
-	// no validation rules for Status
+	// no validation rules for NeedTeam
 
-	// no validation rules for CurrentLevel
+	// no validation rules for NeedArea
 
-	// no validation rules for Current
+	// no validation rules for Rate
 
-	// no validation rules for CurrentMax
+	// no validation rules for ExpireDays
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return AdminVipLevelUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// AdminVipLevelUpdateRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
+// AdminVipLevelUpdateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+type AdminVipLevelUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m AdminVipLevelUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5964,12 +17872,12 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminVipLevelUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
+// AdminVipLevelUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminVipLevelUpdateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+type AdminVipLevelUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5977,24 +17885,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminVipLevelUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e AdminVipLevelUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6006,14 +17914,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sAdminVipLevelUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = AdminVipLevelUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6021,58 +17929,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = AdminVipLevelUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminVipLogListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+func (m *AdminVipLogListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
+// ValidateAll checks the field values on AdminVipLogListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// AdminVipLogListReply_ListMultiError, or nil if none found.
+func (m *AdminVipLogListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminVipLogListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
+	// no validation rules for Address
 
-	// no validation rules for Amount
+	// no validation rules for BeforeVip
 
-	// no validation rules for RelAmount
+	// no validation rules for AfterVip
 
-	// no validation rules for Type
+	// no validation rules for Reason
 
-	// no validation rules for Status
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminVipLogListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminVipLogListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminVipLogListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminVipLogListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminVipLogListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6081,12 +17987,11 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminVipLogListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminVipLogListReply_ListValidationError is the validation error returned by
+// AdminVipLogListReply_List.Validate if the designated constraints aren't met.
+type AdminVipLogListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6094,24 +17999,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminVipLogListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminVipLogListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminVipLogListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminVipLogListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminVipLogListReply_ListValidationError) ErrorName() string {
+	return "AdminVipLogListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminVipLogListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6123,14 +18028,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminVipLogListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminVipLogListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6138,52 +18043,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminVipLogListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply_List with the
+// Validate checks the field values on AdminDailyMetricsReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply_List) Validate() error {
+func (m *AdminDailyMetricsReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply_List with the
+// ValidateAll checks the field values on AdminDailyMetricsReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminUserRecommendReply_List) ValidateAll() error {
+// AdminDailyMetricsReply_ListMultiError, or nil if none found.
+func (m *AdminDailyMetricsReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply_List) validate(all bool) error {
+func (m *AdminDailyMetricsReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Address
+	// no validation rules for Name
 
-	// no validation rules for Id
+	// no validation rules for Num
 
-	// no validation rules for CreatedAt
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReply_ListMultiError(errors)
+		return AdminDailyMetricsReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// AdminDailyMetricsReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyMetricsReply_List.ValidateAll() if
 // the designated constraints aren't met.
-type AdminUserRecommendReply_ListMultiError []error
+type AdminDailyMetricsReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReply_ListMultiError) Error() string {
+func (m AdminDailyMetricsReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6192,12 +18095,12 @@ func (m AdminUserRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDailyMetricsReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReply_ListValidationError is the validation error returned
-// by AdminUserRecommendReply_List.Validate if the designated constraints
+// AdminDailyMetricsReply_ListValidationError is the validation error returned
+// by AdminDailyMetricsReply_List.Validate if the designated constraints
 // aren't met.
-type AdminUserRecommendReply_ListValidationError struct {
+type AdminDailyMetricsReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6205,24 +18108,24 @@ type AdminUserRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminDailyMetricsReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDailyMetricsReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDailyMetricsReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDailyMetricsReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminUserRecommendReply_ListValidationError"
+func (e AdminDailyMetricsReply_ListValidationError) ErrorName() string {
+	return "AdminDailyMetricsReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReply_ListValidationError) Error() string {
+func (e AdminDailyMetricsReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6234,14 +18137,14 @@ func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		"invalid %sAdminDailyMetricsReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReply_ListValidationError{}
+var _ error = AdminDailyMetricsReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6249,52 +18152,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReply_ListValidationError{}
+} = AdminDailyMetricsReply_ListValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply_List) Validate() error {
+// Validate checks the field values on AdminDailyMetricsSeriesReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDailyMetricsSeriesReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyMetricsSeriesReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDailyMetricsSeriesReply_ListMultiError, or nil if none found.
+func (m *AdminDailyMetricsSeriesReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply_List) validate(all bool) error {
+func (m *AdminDailyMetricsSeriesReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Period
 
-	// no validation rules for RecommendAddress
+	// no validation rules for Name
 
-	// no validation rules for Id
+	// no validation rules for Num
 
-	// no validation rules for CreatedAt
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReply_ListMultiError(errors)
+		return AdminDailyMetricsSeriesReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type AdminMonthRecommendReply_ListMultiError []error
+// AdminDailyMetricsSeriesReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDailyMetricsSeriesReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyMetricsSeriesReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReply_ListMultiError) Error() string {
+func (m AdminDailyMetricsSeriesReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6303,12 +18208,12 @@ func (m AdminMonthRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDailyMetricsSeriesReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReply_ListValidationError is the validation error
-// returned by AdminMonthRecommendReply_List.Validate if the designated
+// AdminDailyMetricsSeriesReply_ListValidationError is the validation error
+// returned by AdminDailyMetricsSeriesReply_List.Validate if the designated
 // constraints aren't met.
-type AdminMonthRecommendReply_ListValidationError struct {
+type AdminDailyMetricsSeriesReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6316,24 +18221,24 @@ type AdminMonthRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminDailyMetricsSeriesReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDailyMetricsSeriesReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDailyMetricsSeriesReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDailyMetricsSeriesReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminMonthRecommendReply_ListValidationError"
+func (e AdminDailyMetricsSeriesReply_ListValidationError) ErrorName() string {
+	return "AdminDailyMetricsSeriesReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReply_ListValidationError) Error() string {
+func (e AdminDailyMetricsSeriesReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6345,14 +18250,14 @@ func (e AdminMonthRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
+		"invalid %sAdminDailyMetricsSeriesReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReply_ListValidationError{}
+var _ error = AdminDailyMetricsSeriesReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6360,7 +18265,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReply_ListValidationError{}
+} = AdminDailyMetricsSeriesReply_ListValidationError{}
 
 // Validate checks the field values on AdminConfigReply_List with the rules
 // defined in the proto definition for this message. If any rules are
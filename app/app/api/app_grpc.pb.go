@@ -27,9 +27,20 @@ type AppClient interface {
 	RewardList(ctx context.Context, in *RewardListRequest, opts ...grpc.CallOption) (*RewardListReply, error)
 	RecommendRewardList(ctx context.Context, in *RecommendRewardListRequest, opts ...grpc.CallOption) (*RecommendRewardListReply, error)
 	FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...grpc.CallOption) (*FeeRewardListReply, error)
+	MyLocationList(ctx context.Context, in *MyLocationListRequest, opts ...grpc.CallOption) (*MyLocationListReply, error)
+	LocationNeighbour(ctx context.Context, in *LocationNeighbourRequest, opts ...grpc.CallOption) (*LocationNeighbourReply, error)
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
+	RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...grpc.CallOption) (*RecommendTreeReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertReply, error)
+	Reinvest(ctx context.Context, in *ReinvestRequest, opts ...grpc.CallOption) (*ReinvestReply, error)
+	SetAutoReinvest(ctx context.Context, in *SetAutoReinvestRequest, opts ...grpc.CallOption) (*SetAutoReinvestReply, error)
+	UpgradeLocation(ctx context.Context, in *UpgradeLocationRequest, opts ...grpc.CallOption) (*UpgradeLocationReply, error)
+	ReserveProof(ctx context.Context, in *ReserveProofRequest, opts ...grpc.CallOption) (*ReserveProofReply, error)
+	Statement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementReply, error)
+	ExitLocation(ctx context.Context, in *ExitLocationRequest, opts ...grpc.CallOption) (*ExitLocationReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	//
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
@@ -59,6 +70,29 @@ type AppClient interface {
 	AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error)
+	AdminLocationEventList(ctx context.Context, in *AdminLocationEventListRequest, opts ...grpc.CallOption) (*AdminLocationEventListReply, error)
+	AdminRecommendTreeBackfill(ctx context.Context, in *AdminRecommendTreeBackfillRequest, opts ...grpc.CallOption) (*AdminRecommendTreeBackfillReply, error)
+	AdminTeamStatsRebuild(ctx context.Context, in *AdminTeamStatsRebuildRequest, opts ...grpc.CallOption) (*AdminTeamStatsRebuildReply, error)
+	AdminBalanceAdjust(ctx context.Context, in *AdminBalanceAdjustRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustReply, error)
+	AdminBalanceAdjustApprove(ctx context.Context, in *AdminBalanceAdjustApproveRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustApproveReply, error)
+	AdminBalanceAdjustList(ctx context.Context, in *AdminBalanceAdjustListRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustListReply, error)
+	AdminDepositReverse(ctx context.Context, in *AdminDepositReverseRequest, opts ...grpc.CallOption) (*AdminDepositReverseReply, error)
+	AdminReserveSnapshot(ctx context.Context, in *AdminReserveSnapshotRequest, opts ...grpc.CallOption) (*AdminReserveSnapshotReply, error)
+	AdminReserveList(ctx context.Context, in *AdminReserveListRequest, opts ...grpc.CallOption) (*AdminReserveListReply, error)
+	AdminReserveMerkle(ctx context.Context, in *AdminReserveMerkleRequest, opts ...grpc.CallOption) (*AdminReserveMerkleReply, error)
+	AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error)
+	AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...grpc.CallOption) (*AdminReconcileListReply, error)
+	AdminReconcileDetail(ctx context.Context, in *AdminReconcileDetailRequest, opts ...grpc.CallOption) (*AdminReconcileDetailReply, error)
+	AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error)
+	AdminRecommendAreaCheck(ctx context.Context, in *AdminRecommendAreaCheckRequest, opts ...grpc.CallOption) (*AdminRecommendAreaCheckReply, error)
+	AdminUserMove(ctx context.Context, in *AdminUserMoveRequest, opts ...grpc.CallOption) (*AdminUserMoveReply, error)
+	AdminVipLevelList(ctx context.Context, in *AdminVipLevelListRequest, opts ...grpc.CallOption) (*AdminVipLevelListReply, error)
+	AdminVipLevelUpdate(ctx context.Context, in *AdminVipLevelUpdateRequest, opts ...grpc.CallOption) (*AdminVipLevelUpdateReply, error)
+	AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...grpc.CallOption) (*AdminVipRecalcReply, error)
+	AdminVipLogList(ctx context.Context, in *AdminVipLogListRequest, opts ...grpc.CallOption) (*AdminVipLogListReply, error)
+	AdminDhbPrice(ctx context.Context, in *AdminDhbPriceRequest, opts ...grpc.CallOption) (*AdminDhbPriceReply, error)
+	AdminDailyMetrics(ctx context.Context, in *AdminDailyMetricsRequest, opts ...grpc.CallOption) (*AdminDailyMetricsReply, error)
+	AdminDailyMetricsSeries(ctx context.Context, in *AdminDailyMetricsSeriesRequest, opts ...grpc.CallOption) (*AdminDailyMetricsSeriesReply, error)
 }
 
 type appClient struct {
@@ -114,6 +148,24 @@ func (c *appClient) FeeRewardList(ctx context.Context, in *FeeRewardListRequest,
 	return out, nil
 }
 
+func (c *appClient) MyLocationList(ctx context.Context, in *MyLocationListRequest, opts ...grpc.CallOption) (*MyLocationListReply, error) {
+	out := new(MyLocationListReply)
+	err := c.cc.Invoke(ctx, "/api.App/MyLocationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) LocationNeighbour(ctx context.Context, in *LocationNeighbourRequest, opts ...grpc.CallOption) (*LocationNeighbourReply, error) {
+	out := new(LocationNeighbourReply)
+	err := c.cc.Invoke(ctx, "/api.App/LocationNeighbour", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error) {
 	out := new(WithdrawListReply)
 	err := c.cc.Invoke(ctx, "/api.App/WithdrawList", in, out, opts...)
@@ -123,6 +175,15 @@ func (c *appClient) WithdrawList(ctx context.Context, in *WithdrawListRequest, o
 	return out, nil
 }
 
+func (c *appClient) RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...grpc.CallOption) (*RecommendTreeReply, error) {
+	out := new(RecommendTreeReply)
+	err := c.cc.Invoke(ctx, "/api.App/RecommendTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error) {
 	out := new(RecommendListReply)
 	err := c.cc.Invoke(ctx, "/api.App/RecommendList", in, out, opts...)
@@ -141,6 +202,78 @@ func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, "/api.App/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertReply, error) {
+	out := new(ConvertReply)
+	err := c.cc.Invoke(ctx, "/api.App/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Reinvest(ctx context.Context, in *ReinvestRequest, opts ...grpc.CallOption) (*ReinvestReply, error) {
+	out := new(ReinvestReply)
+	err := c.cc.Invoke(ctx, "/api.App/Reinvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) SetAutoReinvest(ctx context.Context, in *SetAutoReinvestRequest, opts ...grpc.CallOption) (*SetAutoReinvestReply, error) {
+	out := new(SetAutoReinvestReply)
+	err := c.cc.Invoke(ctx, "/api.App/SetAutoReinvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) UpgradeLocation(ctx context.Context, in *UpgradeLocationRequest, opts ...grpc.CallOption) (*UpgradeLocationReply, error) {
+	out := new(UpgradeLocationReply)
+	err := c.cc.Invoke(ctx, "/api.App/UpgradeLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) ReserveProof(ctx context.Context, in *ReserveProofRequest, opts ...grpc.CallOption) (*ReserveProofReply, error) {
+	out := new(ReserveProofReply)
+	err := c.cc.Invoke(ctx, "/api.App/ReserveProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Statement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementReply, error) {
+	out := new(StatementReply)
+	err := c.cc.Invoke(ctx, "/api.App/Statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) ExitLocation(ctx context.Context, in *ExitLocationRequest, opts ...grpc.CallOption) (*ExitLocationReply, error) {
+	out := new(ExitLocationReply)
+	err := c.cc.Invoke(ctx, "/api.App/ExitLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, "/api.App/Deposit", in, out, opts...)
@@ -177,6 +310,213 @@ func (c *appClient) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) AdminLocationEventList(ctx context.Context, in *AdminLocationEventListRequest, opts ...grpc.CallOption) (*AdminLocationEventListReply, error) {
+	out := new(AdminLocationEventListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLocationEventList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRecommendTreeBackfill(ctx context.Context, in *AdminRecommendTreeBackfillRequest, opts ...grpc.CallOption) (*AdminRecommendTreeBackfillReply, error) {
+	out := new(AdminRecommendTreeBackfillReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminRecommendTreeBackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminTeamStatsRebuild(ctx context.Context, in *AdminTeamStatsRebuildRequest, opts ...grpc.CallOption) (*AdminTeamStatsRebuildReply, error) {
+	out := new(AdminTeamStatsRebuildReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminTeamStatsRebuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminBalanceAdjust(ctx context.Context, in *AdminBalanceAdjustRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustReply, error) {
+	out := new(AdminBalanceAdjustReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminBalanceAdjust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminBalanceAdjustApprove(ctx context.Context, in *AdminBalanceAdjustApproveRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustApproveReply, error) {
+	out := new(AdminBalanceAdjustApproveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminBalanceAdjustApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminBalanceAdjustList(ctx context.Context, in *AdminBalanceAdjustListRequest, opts ...grpc.CallOption) (*AdminBalanceAdjustListReply, error) {
+	out := new(AdminBalanceAdjustListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminBalanceAdjustList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositReverse(ctx context.Context, in *AdminDepositReverseRequest, opts ...grpc.CallOption) (*AdminDepositReverseReply, error) {
+	out := new(AdminDepositReverseReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositReverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReserveSnapshot(ctx context.Context, in *AdminReserveSnapshotRequest, opts ...grpc.CallOption) (*AdminReserveSnapshotReply, error) {
+	out := new(AdminReserveSnapshotReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReserveSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReserveList(ctx context.Context, in *AdminReserveListRequest, opts ...grpc.CallOption) (*AdminReserveListReply, error) {
+	out := new(AdminReserveListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReserveList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReserveMerkle(ctx context.Context, in *AdminReserveMerkleRequest, opts ...grpc.CallOption) (*AdminReserveMerkleReply, error) {
+	out := new(AdminReserveMerkleReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReserveMerkle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error) {
+	out := new(AdminReconcileReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...grpc.CallOption) (*AdminReconcileListReply, error) {
+	out := new(AdminReconcileListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReconcileList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReconcileDetail(ctx context.Context, in *AdminReconcileDetailRequest, opts ...grpc.CallOption) (*AdminReconcileDetailReply, error) {
+	out := new(AdminReconcileDetailReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReconcileDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error) {
+	out := new(AdminLedgerVerifyReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLedgerVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRecommendAreaCheck(ctx context.Context, in *AdminRecommendAreaCheckRequest, opts ...grpc.CallOption) (*AdminRecommendAreaCheckReply, error) {
+	out := new(AdminRecommendAreaCheckReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminRecommendAreaCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserMove(ctx context.Context, in *AdminUserMoveRequest, opts ...grpc.CallOption) (*AdminUserMoveReply, error) {
+	out := new(AdminUserMoveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminVipLevelList(ctx context.Context, in *AdminVipLevelListRequest, opts ...grpc.CallOption) (*AdminVipLevelListReply, error) {
+	out := new(AdminVipLevelListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminVipLevelList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminVipLevelUpdate(ctx context.Context, in *AdminVipLevelUpdateRequest, opts ...grpc.CallOption) (*AdminVipLevelUpdateReply, error) {
+	out := new(AdminVipLevelUpdateReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminVipLevelUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...grpc.CallOption) (*AdminVipRecalcReply, error) {
+	out := new(AdminVipRecalcReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminVipRecalc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminVipLogList(ctx context.Context, in *AdminVipLogListRequest, opts ...grpc.CallOption) (*AdminVipLogListReply, error) {
+	out := new(AdminVipLogListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminVipLogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDhbPrice(ctx context.Context, in *AdminDhbPriceRequest, opts ...grpc.CallOption) (*AdminDhbPriceReply, error) {
+	out := new(AdminDhbPriceReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDhbPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDailyMetrics(ctx context.Context, in *AdminDailyMetricsRequest, opts ...grpc.CallOption) (*AdminDailyMetricsReply, error) {
+	out := new(AdminDailyMetricsReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDailyMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDailyMetricsSeries(ctx context.Context, in *AdminDailyMetricsSeriesRequest, opts ...grpc.CallOption) (*AdminDailyMetricsSeriesReply, error) {
+	out := new(AdminDailyMetricsSeriesReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDailyMetricsSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServer is the server API for App service.
 // All implementations must embed UnimplementedAppServer
 // for forward compatibility
@@ -186,9 +526,20 @@ type AppServer interface {
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
+	MyLocationList(context.Context, *MyLocationListRequest) (*MyLocationListReply, error)
+	LocationNeighbour(context.Context, *LocationNeighbourRequest) (*LocationNeighbourReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
+	RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
+	Convert(context.Context, *ConvertRequest) (*ConvertReply, error)
+	Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error)
+	SetAutoReinvest(context.Context, *SetAutoReinvestRequest) (*SetAutoReinvestReply, error)
+	UpgradeLocation(context.Context, *UpgradeLocationRequest) (*UpgradeLocationReply, error)
+	ReserveProof(context.Context, *ReserveProofRequest) (*ReserveProofReply, error)
+	Statement(context.Context, *StatementRequest) (*StatementReply, error)
+	ExitLocation(context.Context, *ExitLocationRequest) (*ExitLocationReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	//
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
@@ -218,6 +569,29 @@ type AppServer interface {
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminLocationEventList(context.Context, *AdminLocationEventListRequest) (*AdminLocationEventListReply, error)
+	AdminRecommendTreeBackfill(context.Context, *AdminRecommendTreeBackfillRequest) (*AdminRecommendTreeBackfillReply, error)
+	AdminTeamStatsRebuild(context.Context, *AdminTeamStatsRebuildRequest) (*AdminTeamStatsRebuildReply, error)
+	AdminBalanceAdjust(context.Context, *AdminBalanceAdjustRequest) (*AdminBalanceAdjustReply, error)
+	AdminBalanceAdjustApprove(context.Context, *AdminBalanceAdjustApproveRequest) (*AdminBalanceAdjustApproveReply, error)
+	AdminBalanceAdjustList(context.Context, *AdminBalanceAdjustListRequest) (*AdminBalanceAdjustListReply, error)
+	AdminDepositReverse(context.Context, *AdminDepositReverseRequest) (*AdminDepositReverseReply, error)
+	AdminReserveSnapshot(context.Context, *AdminReserveSnapshotRequest) (*AdminReserveSnapshotReply, error)
+	AdminReserveList(context.Context, *AdminReserveListRequest) (*AdminReserveListReply, error)
+	AdminReserveMerkle(context.Context, *AdminReserveMerkleRequest) (*AdminReserveMerkleReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error)
+	AdminReconcileDetail(context.Context, *AdminReconcileDetailRequest) (*AdminReconcileDetailReply, error)
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
+	AdminRecommendAreaCheck(context.Context, *AdminRecommendAreaCheckRequest) (*AdminRecommendAreaCheckReply, error)
+	AdminUserMove(context.Context, *AdminUserMoveRequest) (*AdminUserMoveReply, error)
+	AdminVipLevelList(context.Context, *AdminVipLevelListRequest) (*AdminVipLevelListReply, error)
+	AdminVipLevelUpdate(context.Context, *AdminVipLevelUpdateRequest) (*AdminVipLevelUpdateReply, error)
+	AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error)
+	AdminVipLogList(context.Context, *AdminVipLogListRequest) (*AdminVipLogListReply, error)
+	AdminDhbPrice(context.Context, *AdminDhbPriceRequest) (*AdminDhbPriceReply, error)
+	AdminDailyMetrics(context.Context, *AdminDailyMetricsRequest) (*AdminDailyMetricsReply, error)
+	AdminDailyMetricsSeries(context.Context, *AdminDailyMetricsSeriesRequest) (*AdminDailyMetricsSeriesReply, error)
 	mustEmbedUnimplementedAppServer()
 }
 
@@ -240,15 +614,48 @@ func (UnimplementedAppServer) RecommendRewardList(context.Context, *RecommendRew
 func (UnimplementedAppServer) FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeRewardList not implemented")
 }
+func (UnimplementedAppServer) MyLocationList(context.Context, *MyLocationListRequest) (*MyLocationListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MyLocationList not implemented")
+}
+func (UnimplementedAppServer) LocationNeighbour(context.Context, *LocationNeighbourRequest) (*LocationNeighbourReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocationNeighbour not implemented")
+}
 func (UnimplementedAppServer) WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawList not implemented")
 }
+func (UnimplementedAppServer) RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendTree not implemented")
+}
 func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendList not implemented")
 }
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedAppServer) Transfer(context.Context, *TransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedAppServer) Convert(context.Context, *ConvertRequest) (*ConvertReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedAppServer) Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reinvest not implemented")
+}
+func (UnimplementedAppServer) SetAutoReinvest(context.Context, *SetAutoReinvestRequest) (*SetAutoReinvestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoReinvest not implemented")
+}
+func (UnimplementedAppServer) UpgradeLocation(context.Context, *UpgradeLocationRequest) (*UpgradeLocationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeLocation not implemented")
+}
+func (UnimplementedAppServer) ReserveProof(context.Context, *ReserveProofRequest) (*ReserveProofReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveProof not implemented")
+}
+func (UnimplementedAppServer) Statement(context.Context, *StatementRequest) (*StatementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Statement not implemented")
+}
+func (UnimplementedAppServer) ExitLocation(context.Context, *ExitLocationRequest) (*ExitLocationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitLocation not implemented")
+}
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -261,6 +668,75 @@ func (UnimplementedAppServer) AdminWithdrawEth(context.Context, *AdminWithdrawEt
 func (UnimplementedAppServer) AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminFee not implemented")
 }
+func (UnimplementedAppServer) AdminLocationEventList(context.Context, *AdminLocationEventListRequest) (*AdminLocationEventListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationEventList not implemented")
+}
+func (UnimplementedAppServer) AdminRecommendTreeBackfill(context.Context, *AdminRecommendTreeBackfillRequest) (*AdminRecommendTreeBackfillReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRecommendTreeBackfill not implemented")
+}
+func (UnimplementedAppServer) AdminTeamStatsRebuild(context.Context, *AdminTeamStatsRebuildRequest) (*AdminTeamStatsRebuildReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminTeamStatsRebuild not implemented")
+}
+func (UnimplementedAppServer) AdminBalanceAdjust(context.Context, *AdminBalanceAdjustRequest) (*AdminBalanceAdjustReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBalanceAdjust not implemented")
+}
+func (UnimplementedAppServer) AdminBalanceAdjustApprove(context.Context, *AdminBalanceAdjustApproveRequest) (*AdminBalanceAdjustApproveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBalanceAdjustApprove not implemented")
+}
+func (UnimplementedAppServer) AdminBalanceAdjustList(context.Context, *AdminBalanceAdjustListRequest) (*AdminBalanceAdjustListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBalanceAdjustList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositReverse(context.Context, *AdminDepositReverseRequest) (*AdminDepositReverseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReverse not implemented")
+}
+func (UnimplementedAppServer) AdminReserveSnapshot(context.Context, *AdminReserveSnapshotRequest) (*AdminReserveSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReserveSnapshot not implemented")
+}
+func (UnimplementedAppServer) AdminReserveList(context.Context, *AdminReserveListRequest) (*AdminReserveListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReserveList not implemented")
+}
+func (UnimplementedAppServer) AdminReserveMerkle(context.Context, *AdminReserveMerkleRequest) (*AdminReserveMerkleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReserveMerkle not implemented")
+}
+func (UnimplementedAppServer) AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcile not implemented")
+}
+func (UnimplementedAppServer) AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcileList not implemented")
+}
+func (UnimplementedAppServer) AdminReconcileDetail(context.Context, *AdminReconcileDetailRequest) (*AdminReconcileDetailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcileDetail not implemented")
+}
+func (UnimplementedAppServer) AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerVerify not implemented")
+}
+func (UnimplementedAppServer) AdminRecommendAreaCheck(context.Context, *AdminRecommendAreaCheckRequest) (*AdminRecommendAreaCheckReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRecommendAreaCheck not implemented")
+}
+func (UnimplementedAppServer) AdminUserMove(context.Context, *AdminUserMoveRequest) (*AdminUserMoveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserMove not implemented")
+}
+func (UnimplementedAppServer) AdminVipLevelList(context.Context, *AdminVipLevelListRequest) (*AdminVipLevelListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVipLevelList not implemented")
+}
+func (UnimplementedAppServer) AdminVipLevelUpdate(context.Context, *AdminVipLevelUpdateRequest) (*AdminVipLevelUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVipLevelUpdate not implemented")
+}
+func (UnimplementedAppServer) AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVipRecalc not implemented")
+}
+func (UnimplementedAppServer) AdminVipLogList(context.Context, *AdminVipLogListRequest) (*AdminVipLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVipLogList not implemented")
+}
+func (UnimplementedAppServer) AdminDhbPrice(context.Context, *AdminDhbPriceRequest) (*AdminDhbPriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDhbPrice not implemented")
+}
+func (UnimplementedAppServer) AdminDailyMetrics(context.Context, *AdminDailyMetricsRequest) (*AdminDailyMetricsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDailyMetrics not implemented")
+}
+func (UnimplementedAppServer) AdminDailyMetricsSeries(context.Context, *AdminDailyMetricsSeriesRequest) (*AdminDailyMetricsSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDailyMetricsSeries not implemented")
+}
 func (UnimplementedAppServer) mustEmbedUnimplementedAppServer() {}
 
 // UnsafeAppServer may be embedded to opt out of forward compatibility for this service.
@@ -364,53 +840,107 @@ func _App_FeeRewardList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_WithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawListRequest)
+func _App_MyLocationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MyLocationListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).WithdrawList(ctx, in)
+		return srv.(AppServer).MyLocationList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/WithdrawList",
+		FullMethod: "/api.App/MyLocationList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).WithdrawList(ctx, req.(*WithdrawListRequest))
+		return srv.(AppServer).MyLocationList(ctx, req.(*MyLocationListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecommendListRequest)
+func _App_LocationNeighbour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationNeighbourRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).RecommendList(ctx, in)
+		return srv.(AppServer).LocationNeighbour(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/RecommendList",
+		FullMethod: "/api.App/LocationNeighbour",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).RecommendList(ctx, req.(*RecommendListRequest))
+		return srv.(AppServer).LocationNeighbour(ctx, req.(*LocationNeighbourRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawRequest)
+func _App_WithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).Withdraw(ctx, in)
+		return srv.(AppServer).WithdrawList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/Withdraw",
+		FullMethod: "/api.App/WithdrawList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawList(ctx, req.(*WithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RecommendTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RecommendTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RecommendTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RecommendTree(ctx, req.(*RecommendTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RecommendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RecommendList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RecommendList(ctx, req.(*RecommendListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Withdraw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(AppServer).Withdraw(ctx, req.(*WithdrawRequest))
@@ -418,74 +948,632 @@ func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositRequest)
+func _App_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Reinvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinvestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Reinvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Reinvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Reinvest(ctx, req.(*ReinvestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_SetAutoReinvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoReinvestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).SetAutoReinvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/SetAutoReinvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).SetAutoReinvest(ctx, req.(*SetAutoReinvestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_UpgradeLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).UpgradeLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/UpgradeLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).UpgradeLocation(ctx, req.(*UpgradeLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_ReserveProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ReserveProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/ReserveProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ReserveProof(ctx, req.(*ReserveProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Statement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Statement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Statement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Statement(ctx, req.(*StatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_ExitLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ExitLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/ExitLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ExitLocation(ctx, req.(*ExitLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Deposit(ctx, req.(*DepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawEthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawEth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawEth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawEth(ctx, req.(*AdminWithdrawEthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminFee(ctx, req.(*AdminFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLocationEventList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationEventList(ctx, req.(*AdminLocationEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRecommendTreeBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRecommendTreeBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRecommendTreeBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminRecommendTreeBackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRecommendTreeBackfill(ctx, req.(*AdminRecommendTreeBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminTeamStatsRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTeamStatsRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminTeamStatsRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminTeamStatsRebuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminTeamStatsRebuild(ctx, req.(*AdminTeamStatsRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminBalanceAdjust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBalanceAdjustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminBalanceAdjust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminBalanceAdjust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminBalanceAdjust(ctx, req.(*AdminBalanceAdjustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminBalanceAdjustApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBalanceAdjustApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminBalanceAdjustApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminBalanceAdjustApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminBalanceAdjustApprove(ctx, req.(*AdminBalanceAdjustApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminBalanceAdjustList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBalanceAdjustListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminBalanceAdjustList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminBalanceAdjustList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminBalanceAdjustList(ctx, req.(*AdminBalanceAdjustListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositReverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositReverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositReverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositReverse(ctx, req.(*AdminDepositReverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminReserveSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReserveSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).Deposit(ctx, in)
+		return srv.(AppServer).AdminReserveSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/Deposit",
+		FullMethod: "/api.App/AdminReserveSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).Deposit(ctx, req.(*DepositRequest))
+		return srv.(AppServer).AdminReserveSnapshot(ctx, req.(*AdminReserveSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_AdminWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminWithdrawRequest)
+func _App_AdminReserveList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReserveListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).AdminWithdraw(ctx, in)
+		return srv.(AppServer).AdminReserveList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/AdminWithdraw",
+		FullMethod: "/api.App/AdminReserveList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+		return srv.(AppServer).AdminReserveList(ctx, req.(*AdminReserveListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminWithdrawEthRequest)
+func _App_AdminReserveMerkle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReserveMerkleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).AdminWithdrawEth(ctx, in)
+		return srv.(AppServer).AdminReserveMerkle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/AdminWithdrawEth",
+		FullMethod: "/api.App/AdminReserveMerkle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).AdminWithdrawEth(ctx, req.(*AdminWithdrawEthRequest))
+		return srv.(AppServer).AdminReserveMerkle(ctx, req.(*AdminReserveMerkleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_AdminFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminFeeRequest)
+func _App_AdminReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).AdminFee(ctx, in)
+		return srv.(AppServer).AdminReconcile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/AdminFee",
+		FullMethod: "/api.App/AdminReconcile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).AdminFee(ctx, req.(*AdminFeeRequest))
+		return srv.(AppServer).AdminReconcile(ctx, req.(*AdminReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminReconcileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminReconcileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminReconcileList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminReconcileList(ctx, req.(*AdminReconcileListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminReconcileDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminReconcileDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminReconcileDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminReconcileDetail(ctx, req.(*AdminReconcileDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLedgerVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLedgerVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLedgerVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRecommendAreaCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRecommendAreaCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRecommendAreaCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminRecommendAreaCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRecommendAreaCheck(ctx, req.(*AdminRecommendAreaCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserMove(ctx, req.(*AdminUserMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminVipLevelList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVipLevelListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminVipLevelList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminVipLevelList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminVipLevelList(ctx, req.(*AdminVipLevelListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminVipLevelUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVipLevelUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminVipLevelUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminVipLevelUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminVipLevelUpdate(ctx, req.(*AdminVipLevelUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminVipRecalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVipRecalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminVipRecalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminVipRecalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminVipRecalc(ctx, req.(*AdminVipRecalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminVipLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVipLogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminVipLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminVipLogList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminVipLogList(ctx, req.(*AdminVipLogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDhbPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDhbPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDhbPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDhbPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDhbPrice(ctx, req.(*AdminDhbPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDailyMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDailyMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDailyMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDailyMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDailyMetrics(ctx, req.(*AdminDailyMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDailyMetricsSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDailyMetricsSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDailyMetricsSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDailyMetricsSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDailyMetricsSeries(ctx, req.(*AdminDailyMetricsSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -517,10 +1605,22 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FeeRewardList",
 			Handler:    _App_FeeRewardList_Handler,
 		},
+		{
+			MethodName: "MyLocationList",
+			Handler:    _App_MyLocationList_Handler,
+		},
+		{
+			MethodName: "LocationNeighbour",
+			Handler:    _App_LocationNeighbour_Handler,
+		},
 		{
 			MethodName: "WithdrawList",
 			Handler:    _App_WithdrawList_Handler,
 		},
+		{
+			MethodName: "RecommendTree",
+			Handler:    _App_RecommendTree_Handler,
+		},
 		{
 			MethodName: "RecommendList",
 			Handler:    _App_RecommendList_Handler,
@@ -529,6 +1629,38 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _App_Withdraw_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _App_Transfer_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _App_Convert_Handler,
+		},
+		{
+			MethodName: "Reinvest",
+			Handler:    _App_Reinvest_Handler,
+		},
+		{
+			MethodName: "SetAutoReinvest",
+			Handler:    _App_SetAutoReinvest_Handler,
+		},
+		{
+			MethodName: "UpgradeLocation",
+			Handler:    _App_UpgradeLocation_Handler,
+		},
+		{
+			MethodName: "ReserveProof",
+			Handler:    _App_ReserveProof_Handler,
+		},
+		{
+			MethodName: "Statement",
+			Handler:    _App_Statement_Handler,
+		},
+		{
+			MethodName: "ExitLocation",
+			Handler:    _App_ExitLocation_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _App_Deposit_Handler,
@@ -545,6 +1677,98 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminFee",
 			Handler:    _App_AdminFee_Handler,
 		},
+		{
+			MethodName: "AdminLocationEventList",
+			Handler:    _App_AdminLocationEventList_Handler,
+		},
+		{
+			MethodName: "AdminRecommendTreeBackfill",
+			Handler:    _App_AdminRecommendTreeBackfill_Handler,
+		},
+		{
+			MethodName: "AdminTeamStatsRebuild",
+			Handler:    _App_AdminTeamStatsRebuild_Handler,
+		},
+		{
+			MethodName: "AdminBalanceAdjust",
+			Handler:    _App_AdminBalanceAdjust_Handler,
+		},
+		{
+			MethodName: "AdminBalanceAdjustApprove",
+			Handler:    _App_AdminBalanceAdjustApprove_Handler,
+		},
+		{
+			MethodName: "AdminBalanceAdjustList",
+			Handler:    _App_AdminBalanceAdjustList_Handler,
+		},
+		{
+			MethodName: "AdminDepositReverse",
+			Handler:    _App_AdminDepositReverse_Handler,
+		},
+		{
+			MethodName: "AdminReserveSnapshot",
+			Handler:    _App_AdminReserveSnapshot_Handler,
+		},
+		{
+			MethodName: "AdminReserveList",
+			Handler:    _App_AdminReserveList_Handler,
+		},
+		{
+			MethodName: "AdminReserveMerkle",
+			Handler:    _App_AdminReserveMerkle_Handler,
+		},
+		{
+			MethodName: "AdminReconcile",
+			Handler:    _App_AdminReconcile_Handler,
+		},
+		{
+			MethodName: "AdminReconcileList",
+			Handler:    _App_AdminReconcileList_Handler,
+		},
+		{
+			MethodName: "AdminReconcileDetail",
+			Handler:    _App_AdminReconcileDetail_Handler,
+		},
+		{
+			MethodName: "AdminLedgerVerify",
+			Handler:    _App_AdminLedgerVerify_Handler,
+		},
+		{
+			MethodName: "AdminRecommendAreaCheck",
+			Handler:    _App_AdminRecommendAreaCheck_Handler,
+		},
+		{
+			MethodName: "AdminUserMove",
+			Handler:    _App_AdminUserMove_Handler,
+		},
+		{
+			MethodName: "AdminVipLevelList",
+			Handler:    _App_AdminVipLevelList_Handler,
+		},
+		{
+			MethodName: "AdminVipLevelUpdate",
+			Handler:    _App_AdminVipLevelUpdate_Handler,
+		},
+		{
+			MethodName: "AdminVipRecalc",
+			Handler:    _App_AdminVipRecalc_Handler,
+		},
+		{
+			MethodName: "AdminVipLogList",
+			Handler:    _App_AdminVipLogList_Handler,
+		},
+		{
+			MethodName: "AdminDhbPrice",
+			Handler:    _App_AdminDhbPrice_Handler,
+		},
+		{
+			MethodName: "AdminDailyMetrics",
+			Handler:    _App_AdminDailyMetrics_Handler,
+		},
+		{
+			MethodName: "AdminDailyMetricsSeries",
+			Handler:    _App_AdminDailyMetricsSeries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/app.proto",
@@ -19,29 +19,97 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAppAdminBalanceAdjust = "/api.App/AdminBalanceAdjust"
+const OperationAppAdminBalanceAdjustApprove = "/api.App/AdminBalanceAdjustApprove"
+const OperationAppAdminBalanceAdjustList = "/api.App/AdminBalanceAdjustList"
+const OperationAppAdminDailyMetrics = "/api.App/AdminDailyMetrics"
+const OperationAppAdminDailyMetricsSeries = "/api.App/AdminDailyMetricsSeries"
+const OperationAppAdminDepositReverse = "/api.App/AdminDepositReverse"
+const OperationAppAdminDhbPrice = "/api.App/AdminDhbPrice"
 const OperationAppAdminFee = "/api.App/AdminFee"
+const OperationAppAdminLedgerVerify = "/api.App/AdminLedgerVerify"
+const OperationAppAdminLocationEventList = "/api.App/AdminLocationEventList"
+const OperationAppAdminRecommendAreaCheck = "/api.App/AdminRecommendAreaCheck"
+const OperationAppAdminRecommendTreeBackfill = "/api.App/AdminRecommendTreeBackfill"
+const OperationAppAdminReconcile = "/api.App/AdminReconcile"
+const OperationAppAdminReconcileDetail = "/api.App/AdminReconcileDetail"
+const OperationAppAdminReconcileList = "/api.App/AdminReconcileList"
+const OperationAppAdminReserveList = "/api.App/AdminReserveList"
+const OperationAppAdminReserveMerkle = "/api.App/AdminReserveMerkle"
+const OperationAppAdminReserveSnapshot = "/api.App/AdminReserveSnapshot"
+const OperationAppAdminTeamStatsRebuild = "/api.App/AdminTeamStatsRebuild"
+const OperationAppAdminUserMove = "/api.App/AdminUserMove"
+const OperationAppAdminVipLevelList = "/api.App/AdminVipLevelList"
+const OperationAppAdminVipLevelUpdate = "/api.App/AdminVipLevelUpdate"
+const OperationAppAdminVipLogList = "/api.App/AdminVipLogList"
+const OperationAppAdminVipRecalc = "/api.App/AdminVipRecalc"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
+const OperationAppConvert = "/api.App/Convert"
 const OperationAppDeposit = "/api.App/Deposit"
 const OperationAppEthAuthorize = "/api.App/EthAuthorize"
+const OperationAppExitLocation = "/api.App/ExitLocation"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
+const OperationAppLocationNeighbour = "/api.App/LocationNeighbour"
+const OperationAppMyLocationList = "/api.App/MyLocationList"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
+const OperationAppRecommendTree = "/api.App/RecommendTree"
+const OperationAppReinvest = "/api.App/Reinvest"
+const OperationAppReserveProof = "/api.App/ReserveProof"
 const OperationAppRewardList = "/api.App/RewardList"
+const OperationAppSetAutoReinvest = "/api.App/SetAutoReinvest"
+const OperationAppStatement = "/api.App/Statement"
+const OperationAppTransfer = "/api.App/Transfer"
+const OperationAppUpgradeLocation = "/api.App/UpgradeLocation"
 const OperationAppUserInfo = "/api.App/UserInfo"
 const OperationAppWithdraw = "/api.App/Withdraw"
 const OperationAppWithdrawList = "/api.App/WithdrawList"
 
 type AppHTTPServer interface {
+	AdminBalanceAdjust(context.Context, *AdminBalanceAdjustRequest) (*AdminBalanceAdjustReply, error)
+	AdminBalanceAdjustApprove(context.Context, *AdminBalanceAdjustApproveRequest) (*AdminBalanceAdjustApproveReply, error)
+	AdminBalanceAdjustList(context.Context, *AdminBalanceAdjustListRequest) (*AdminBalanceAdjustListReply, error)
+	AdminDailyMetrics(context.Context, *AdminDailyMetricsRequest) (*AdminDailyMetricsReply, error)
+	AdminDailyMetricsSeries(context.Context, *AdminDailyMetricsSeriesRequest) (*AdminDailyMetricsSeriesReply, error)
+	AdminDepositReverse(context.Context, *AdminDepositReverseRequest) (*AdminDepositReverseReply, error)
+	AdminDhbPrice(context.Context, *AdminDhbPriceRequest) (*AdminDhbPriceReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
+	AdminLocationEventList(context.Context, *AdminLocationEventListRequest) (*AdminLocationEventListReply, error)
+	AdminRecommendAreaCheck(context.Context, *AdminRecommendAreaCheckRequest) (*AdminRecommendAreaCheckReply, error)
+	AdminRecommendTreeBackfill(context.Context, *AdminRecommendTreeBackfillRequest) (*AdminRecommendTreeBackfillReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	AdminReconcileDetail(context.Context, *AdminReconcileDetailRequest) (*AdminReconcileDetailReply, error)
+	AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error)
+	AdminReserveList(context.Context, *AdminReserveListRequest) (*AdminReserveListReply, error)
+	AdminReserveMerkle(context.Context, *AdminReserveMerkleRequest) (*AdminReserveMerkleReply, error)
+	AdminReserveSnapshot(context.Context, *AdminReserveSnapshotRequest) (*AdminReserveSnapshotReply, error)
+	AdminTeamStatsRebuild(context.Context, *AdminTeamStatsRebuildRequest) (*AdminTeamStatsRebuildReply, error)
+	AdminUserMove(context.Context, *AdminUserMoveRequest) (*AdminUserMoveReply, error)
+	AdminVipLevelList(context.Context, *AdminVipLevelListRequest) (*AdminVipLevelListReply, error)
+	AdminVipLevelUpdate(context.Context, *AdminVipLevelUpdateRequest) (*AdminVipLevelUpdateReply, error)
+	AdminVipLogList(context.Context, *AdminVipLogListRequest) (*AdminVipLogListReply, error)
+	AdminVipRecalc(context.Context, *AdminVipRecalcRequest) (*AdminVipRecalcReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	Convert(context.Context, *ConvertRequest) (*ConvertReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
+	ExitLocation(context.Context, *ExitLocationRequest) (*ExitLocationReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
+	LocationNeighbour(context.Context, *LocationNeighbourRequest) (*LocationNeighbourReply, error)
+	MyLocationList(context.Context, *MyLocationListRequest) (*MyLocationListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
+	RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error)
+	Reinvest(context.Context, *ReinvestRequest) (*ReinvestReply, error)
+	ReserveProof(context.Context, *ReserveProofRequest) (*ReserveProofReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
+	SetAutoReinvest(context.Context, *SetAutoReinvestRequest) (*SetAutoReinvestReply, error)
+	Statement(context.Context, *StatementRequest) (*StatementReply, error)
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
+	UpgradeLocation(context.Context, *UpgradeLocationRequest) (*UpgradeLocationReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
@@ -54,13 +122,47 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/reward_list", _App_RewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_reward_list", _App_RecommendRewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/fee_reward_list", _App_FeeRewardList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/my_location_list", _App_MyLocationList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/location_neighbour", _App_LocationNeighbour0_HTTP_Handler(srv))
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/recommend_tree", _App_RecommendTree0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
+	r.POST("/api/app_server/transfer", _App_Transfer0_HTTP_Handler(srv))
+	r.POST("/api/app_server/convert", _App_Convert0_HTTP_Handler(srv))
+	r.POST("/api/app_server/reinvest", _App_Reinvest0_HTTP_Handler(srv))
+	r.POST("/api/app_server/set_auto_reinvest", _App_SetAutoReinvest0_HTTP_Handler(srv))
+	r.POST("/api/app_server/upgrade_location", _App_UpgradeLocation0_HTTP_Handler(srv))
+	r.GET("/api/app_server/reserve_proof", _App_ReserveProof0_HTTP_Handler(srv))
+	r.GET("/api/app_server/statement", _App_Statement0_HTTP_Handler(srv))
+	r.POST("/api/app_server/exit_location", _App_ExitLocation0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/location_event_list", _App_AdminLocationEventList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/recommend_tree_backfill", _App_AdminRecommendTreeBackfill0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/team_stats_rebuild", _App_AdminTeamStatsRebuild0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/balance_adjust", _App_AdminBalanceAdjust0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/balance_adjust_approve", _App_AdminBalanceAdjustApprove0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/balance_adjust_list", _App_AdminBalanceAdjustList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_reverse", _App_AdminDepositReverse0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reserve_snapshot", _App_AdminReserveSnapshot0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reserve_list", _App_AdminReserveList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reserve_merkle", _App_AdminReserveMerkle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile", _App_AdminReconcile0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile_list", _App_AdminReconcileList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile_detail", _App_AdminReconcileDetail0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/ledger_verify", _App_AdminLedgerVerify0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/recommend_area_check", _App_AdminRecommendAreaCheck0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/user_move", _App_AdminUserMove0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/vip_level_list", _App_AdminVipLevelList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/vip_level_update", _App_AdminVipLevelUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/vip_recalc", _App_AdminVipRecalc0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/vip_log_list", _App_AdminVipLogList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/dhb_price", _App_AdminDhbPrice0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/daily_metrics", _App_AdminDailyMetrics0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/daily_metrics_series", _App_AdminDailyMetricsSeries0_HTTP_Handler(srv))
 }
 
 func _App_EthAuthorize0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
@@ -161,6 +263,44 @@ func _App_FeeRewardList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _App_MyLocationList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MyLocationListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppMyLocationList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MyLocationList(ctx, req.(*MyLocationListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MyLocationListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_LocationNeighbour0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LocationNeighbourRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLocationNeighbour)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LocationNeighbour(ctx, req.(*LocationNeighbourRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocationNeighbourReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_WithdrawList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in WithdrawListRequest
@@ -180,6 +320,25 @@ func _App_WithdrawList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _App_RecommendTree0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecommendTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppRecommendTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecommendTree(ctx, req.(*RecommendTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecommendTreeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_RecommendList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RecommendListRequest
@@ -221,6 +380,173 @@ func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_Transfer0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Transfer(ctx, req.(*TransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Convert0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConvertRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppConvert)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Convert(ctx, req.(*ConvertRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConvertReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Reinvest0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReinvestRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppReinvest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reinvest(ctx, req.(*ReinvestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReinvestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_SetAutoReinvest0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetAutoReinvestRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppSetAutoReinvest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetAutoReinvest(ctx, req.(*SetAutoReinvestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetAutoReinvestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_UpgradeLocation0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpgradeLocationRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppUpgradeLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpgradeLocation(ctx, req.(*UpgradeLocationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpgradeLocationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_ReserveProof0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReserveProofRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppReserveProof)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReserveProof(ctx, req.(*ReserveProofRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReserveProofReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Statement0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StatementRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppStatement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Statement(ctx, req.(*StatementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StatementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_ExitLocation0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExitLocationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppExitLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExitLocation(ctx, req.(*ExitLocationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExitLocationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DepositRequest
@@ -297,53 +623,838 @@ func _App_AdminFee0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
-type AppHTTPClient interface {
-	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
-	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
-	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
-	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
-	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
-	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
-	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
-	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
-	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
-	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
-	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
-	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
-}
-
-type AppHTTPClientImpl struct {
-	cc *http.Client
+func _App_AdminLocationEventList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationEventListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationEventList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationEventList(ctx, req.(*AdminLocationEventListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationEventListReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewAppHTTPClient(client *http.Client) AppHTTPClient {
-	return &AppHTTPClientImpl{client}
+func _App_AdminRecommendTreeBackfill0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRecommendTreeBackfillRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRecommendTreeBackfill)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRecommendTreeBackfill(ctx, req.(*AdminRecommendTreeBackfillRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRecommendTreeBackfillReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
-	var out AdminFeeReply
-	pattern := "/api/admin_dhb/fee"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminFee))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminTeamStatsRebuild0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminTeamStatsRebuildRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminTeamStatsRebuild)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminTeamStatsRebuild(ctx, req.(*AdminTeamStatsRebuildRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminTeamStatsRebuildReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
-	var out AdminWithdrawReply
-	pattern := "/api/admin_dhb/withdraw"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminWithdraw))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminBalanceAdjust0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBalanceAdjustRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminBalanceAdjust)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminBalanceAdjust(ctx, req.(*AdminBalanceAdjustRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminBalanceAdjustReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
+}
+
+func _App_AdminBalanceAdjustApprove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBalanceAdjustApproveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminBalanceAdjustApprove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminBalanceAdjustApprove(ctx, req.(*AdminBalanceAdjustApproveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminBalanceAdjustApproveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminBalanceAdjustList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBalanceAdjustListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminBalanceAdjustList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminBalanceAdjustList(ctx, req.(*AdminBalanceAdjustListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminBalanceAdjustListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositReverse0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReverseRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositReverse)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReverse(ctx, req.(*AdminDepositReverseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositReverseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReserveSnapshot0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReserveSnapshotRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReserveSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReserveSnapshot(ctx, req.(*AdminReserveSnapshotRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReserveSnapshotReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReserveList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReserveListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReserveList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReserveList(ctx, req.(*AdminReserveListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReserveListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReserveMerkle0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReserveMerkleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReserveMerkle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReserveMerkle(ctx, req.(*AdminReserveMerkleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReserveMerkleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReconcile0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcile(ctx, req.(*AdminReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReconcileList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReconcileList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcileList(ctx, req.(*AdminReconcileListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReconcileDetail0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileDetailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReconcileDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcileDetail(ctx, req.(*AdminReconcileDetailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileDetailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLedgerVerify0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerVerifyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLedgerVerify)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerVerifyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRecommendAreaCheck0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRecommendAreaCheckRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRecommendAreaCheck)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRecommendAreaCheck(ctx, req.(*AdminRecommendAreaCheckRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRecommendAreaCheckReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserMove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserMoveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserMove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserMove(ctx, req.(*AdminUserMoveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserMoveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminVipLevelList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminVipLevelListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminVipLevelList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminVipLevelList(ctx, req.(*AdminVipLevelListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminVipLevelListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminVipLevelUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminVipLevelUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminVipLevelUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminVipLevelUpdate(ctx, req.(*AdminVipLevelUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminVipLevelUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminVipRecalc0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminVipRecalcRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminVipRecalc)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminVipRecalc(ctx, req.(*AdminVipRecalcRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminVipRecalcReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminVipLogList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminVipLogListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminVipLogList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminVipLogList(ctx, req.(*AdminVipLogListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminVipLogListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDhbPrice0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDhbPriceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDhbPrice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDhbPrice(ctx, req.(*AdminDhbPriceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDhbPriceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDailyMetrics0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDailyMetricsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDailyMetrics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDailyMetrics(ctx, req.(*AdminDailyMetricsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDailyMetricsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDailyMetricsSeries0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDailyMetricsSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDailyMetricsSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDailyMetricsSeries(ctx, req.(*AdminDailyMetricsSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDailyMetricsSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type AppHTTPClient interface {
+	AdminBalanceAdjust(ctx context.Context, req *AdminBalanceAdjustRequest, opts ...http.CallOption) (rsp *AdminBalanceAdjustReply, err error)
+	AdminBalanceAdjustApprove(ctx context.Context, req *AdminBalanceAdjustApproveRequest, opts ...http.CallOption) (rsp *AdminBalanceAdjustApproveReply, err error)
+	AdminBalanceAdjustList(ctx context.Context, req *AdminBalanceAdjustListRequest, opts ...http.CallOption) (rsp *AdminBalanceAdjustListReply, err error)
+	AdminDailyMetrics(ctx context.Context, req *AdminDailyMetricsRequest, opts ...http.CallOption) (rsp *AdminDailyMetricsReply, err error)
+	AdminDailyMetricsSeries(ctx context.Context, req *AdminDailyMetricsSeriesRequest, opts ...http.CallOption) (rsp *AdminDailyMetricsSeriesReply, err error)
+	AdminDepositReverse(ctx context.Context, req *AdminDepositReverseRequest, opts ...http.CallOption) (rsp *AdminDepositReverseReply, err error)
+	AdminDhbPrice(ctx context.Context, req *AdminDhbPriceRequest, opts ...http.CallOption) (rsp *AdminDhbPriceReply, err error)
+	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminLedgerVerify(ctx context.Context, req *AdminLedgerVerifyRequest, opts ...http.CallOption) (rsp *AdminLedgerVerifyReply, err error)
+	AdminLocationEventList(ctx context.Context, req *AdminLocationEventListRequest, opts ...http.CallOption) (rsp *AdminLocationEventListReply, err error)
+	AdminRecommendAreaCheck(ctx context.Context, req *AdminRecommendAreaCheckRequest, opts ...http.CallOption) (rsp *AdminRecommendAreaCheckReply, err error)
+	AdminRecommendTreeBackfill(ctx context.Context, req *AdminRecommendTreeBackfillRequest, opts ...http.CallOption) (rsp *AdminRecommendTreeBackfillReply, err error)
+	AdminReconcile(ctx context.Context, req *AdminReconcileRequest, opts ...http.CallOption) (rsp *AdminReconcileReply, err error)
+	AdminReconcileDetail(ctx context.Context, req *AdminReconcileDetailRequest, opts ...http.CallOption) (rsp *AdminReconcileDetailReply, err error)
+	AdminReconcileList(ctx context.Context, req *AdminReconcileListRequest, opts ...http.CallOption) (rsp *AdminReconcileListReply, err error)
+	AdminReserveList(ctx context.Context, req *AdminReserveListRequest, opts ...http.CallOption) (rsp *AdminReserveListReply, err error)
+	AdminReserveMerkle(ctx context.Context, req *AdminReserveMerkleRequest, opts ...http.CallOption) (rsp *AdminReserveMerkleReply, err error)
+	AdminReserveSnapshot(ctx context.Context, req *AdminReserveSnapshotRequest, opts ...http.CallOption) (rsp *AdminReserveSnapshotReply, err error)
+	AdminTeamStatsRebuild(ctx context.Context, req *AdminTeamStatsRebuildRequest, opts ...http.CallOption) (rsp *AdminTeamStatsRebuildReply, err error)
+	AdminUserMove(ctx context.Context, req *AdminUserMoveRequest, opts ...http.CallOption) (rsp *AdminUserMoveReply, err error)
+	AdminVipLevelList(ctx context.Context, req *AdminVipLevelListRequest, opts ...http.CallOption) (rsp *AdminVipLevelListReply, err error)
+	AdminVipLevelUpdate(ctx context.Context, req *AdminVipLevelUpdateRequest, opts ...http.CallOption) (rsp *AdminVipLevelUpdateReply, err error)
+	AdminVipLogList(ctx context.Context, req *AdminVipLogListRequest, opts ...http.CallOption) (rsp *AdminVipLogListReply, err error)
+	AdminVipRecalc(ctx context.Context, req *AdminVipRecalcRequest, opts ...http.CallOption) (rsp *AdminVipRecalcReply, err error)
+	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
+	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	Convert(ctx context.Context, req *ConvertRequest, opts ...http.CallOption) (rsp *ConvertReply, err error)
+	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
+	ExitLocation(ctx context.Context, req *ExitLocationRequest, opts ...http.CallOption) (rsp *ExitLocationReply, err error)
+	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
+	LocationNeighbour(ctx context.Context, req *LocationNeighbourRequest, opts ...http.CallOption) (rsp *LocationNeighbourReply, err error)
+	MyLocationList(ctx context.Context, req *MyLocationListRequest, opts ...http.CallOption) (rsp *MyLocationListReply, err error)
+	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
+	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
+	RecommendTree(ctx context.Context, req *RecommendTreeRequest, opts ...http.CallOption) (rsp *RecommendTreeReply, err error)
+	Reinvest(ctx context.Context, req *ReinvestRequest, opts ...http.CallOption) (rsp *ReinvestReply, err error)
+	ReserveProof(ctx context.Context, req *ReserveProofRequest, opts ...http.CallOption) (rsp *ReserveProofReply, err error)
+	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
+	SetAutoReinvest(ctx context.Context, req *SetAutoReinvestRequest, opts ...http.CallOption) (rsp *SetAutoReinvestReply, err error)
+	Statement(ctx context.Context, req *StatementRequest, opts ...http.CallOption) (rsp *StatementReply, err error)
+	Transfer(ctx context.Context, req *TransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
+	UpgradeLocation(ctx context.Context, req *UpgradeLocationRequest, opts ...http.CallOption) (rsp *UpgradeLocationReply, err error)
+	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
+	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
+}
+
+type AppHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAppHTTPClient(client *http.Client) AppHTTPClient {
+	return &AppHTTPClientImpl{client}
+}
+
+func (c *AppHTTPClientImpl) AdminBalanceAdjust(ctx context.Context, in *AdminBalanceAdjustRequest, opts ...http.CallOption) (*AdminBalanceAdjustReply, error) {
+	var out AdminBalanceAdjustReply
+	pattern := "/api/admin_dhb/balance_adjust"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminBalanceAdjust))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminBalanceAdjustApprove(ctx context.Context, in *AdminBalanceAdjustApproveRequest, opts ...http.CallOption) (*AdminBalanceAdjustApproveReply, error) {
+	var out AdminBalanceAdjustApproveReply
+	pattern := "/api/admin_dhb/balance_adjust_approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminBalanceAdjustApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminBalanceAdjustList(ctx context.Context, in *AdminBalanceAdjustListRequest, opts ...http.CallOption) (*AdminBalanceAdjustListReply, error) {
+	var out AdminBalanceAdjustListReply
+	pattern := "/api/admin_dhb/balance_adjust_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminBalanceAdjustList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDailyMetrics(ctx context.Context, in *AdminDailyMetricsRequest, opts ...http.CallOption) (*AdminDailyMetricsReply, error) {
+	var out AdminDailyMetricsReply
+	pattern := "/api/admin_dhb/daily_metrics"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDailyMetrics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDailyMetricsSeries(ctx context.Context, in *AdminDailyMetricsSeriesRequest, opts ...http.CallOption) (*AdminDailyMetricsSeriesReply, error) {
+	var out AdminDailyMetricsSeriesReply
+	pattern := "/api/admin_dhb/daily_metrics_series"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDailyMetricsSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositReverse(ctx context.Context, in *AdminDepositReverseRequest, opts ...http.CallOption) (*AdminDepositReverseReply, error) {
+	var out AdminDepositReverseReply
+	pattern := "/api/admin_dhb/deposit_reverse"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositReverse))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDhbPrice(ctx context.Context, in *AdminDhbPriceRequest, opts ...http.CallOption) (*AdminDhbPriceReply, error) {
+	var out AdminDhbPriceReply
+	pattern := "/api/admin_dhb/dhb_price"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDhbPrice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
+	var out AdminFeeReply
+	pattern := "/api/admin_dhb/fee"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminFee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...http.CallOption) (*AdminLedgerVerifyReply, error) {
+	var out AdminLedgerVerifyReply
+	pattern := "/api/admin_dhb/ledger_verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLedgerVerify))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLocationEventList(ctx context.Context, in *AdminLocationEventListRequest, opts ...http.CallOption) (*AdminLocationEventListReply, error) {
+	var out AdminLocationEventListReply
+	pattern := "/api/admin_dhb/location_event_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLocationEventList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminRecommendAreaCheck(ctx context.Context, in *AdminRecommendAreaCheckRequest, opts ...http.CallOption) (*AdminRecommendAreaCheckReply, error) {
+	var out AdminRecommendAreaCheckReply
+	pattern := "/api/admin_dhb/recommend_area_check"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminRecommendAreaCheck))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminRecommendTreeBackfill(ctx context.Context, in *AdminRecommendTreeBackfillRequest, opts ...http.CallOption) (*AdminRecommendTreeBackfillReply, error) {
+	var out AdminRecommendTreeBackfillReply
+	pattern := "/api/admin_dhb/recommend_tree_backfill"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminRecommendTreeBackfill))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...http.CallOption) (*AdminReconcileReply, error) {
+	var out AdminReconcileReply
+	pattern := "/api/admin_dhb/reconcile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReconcileDetail(ctx context.Context, in *AdminReconcileDetailRequest, opts ...http.CallOption) (*AdminReconcileDetailReply, error) {
+	var out AdminReconcileDetailReply
+	pattern := "/api/admin_dhb/reconcile_detail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReconcileDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...http.CallOption) (*AdminReconcileListReply, error) {
+	var out AdminReconcileListReply
+	pattern := "/api/admin_dhb/reconcile_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReconcileList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReserveList(ctx context.Context, in *AdminReserveListRequest, opts ...http.CallOption) (*AdminReserveListReply, error) {
+	var out AdminReserveListReply
+	pattern := "/api/admin_dhb/reserve_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReserveList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReserveMerkle(ctx context.Context, in *AdminReserveMerkleRequest, opts ...http.CallOption) (*AdminReserveMerkleReply, error) {
+	var out AdminReserveMerkleReply
+	pattern := "/api/admin_dhb/reserve_merkle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReserveMerkle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReserveSnapshot(ctx context.Context, in *AdminReserveSnapshotRequest, opts ...http.CallOption) (*AdminReserveSnapshotReply, error) {
+	var out AdminReserveSnapshotReply
+	pattern := "/api/admin_dhb/reserve_snapshot"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminReserveSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminTeamStatsRebuild(ctx context.Context, in *AdminTeamStatsRebuildRequest, opts ...http.CallOption) (*AdminTeamStatsRebuildReply, error) {
+	var out AdminTeamStatsRebuildReply
+	pattern := "/api/admin_dhb/team_stats_rebuild"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminTeamStatsRebuild))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminUserMove(ctx context.Context, in *AdminUserMoveRequest, opts ...http.CallOption) (*AdminUserMoveReply, error) {
+	var out AdminUserMoveReply
+	pattern := "/api/admin_dhb/user_move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminUserMove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminVipLevelList(ctx context.Context, in *AdminVipLevelListRequest, opts ...http.CallOption) (*AdminVipLevelListReply, error) {
+	var out AdminVipLevelListReply
+	pattern := "/api/admin_dhb/vip_level_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminVipLevelList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminVipLevelUpdate(ctx context.Context, in *AdminVipLevelUpdateRequest, opts ...http.CallOption) (*AdminVipLevelUpdateReply, error) {
+	var out AdminVipLevelUpdateReply
+	pattern := "/api/admin_dhb/vip_level_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminVipLevelUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminVipLogList(ctx context.Context, in *AdminVipLogListRequest, opts ...http.CallOption) (*AdminVipLogListReply, error) {
+	var out AdminVipLogListReply
+	pattern := "/api/admin_dhb/vip_log_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminVipLogList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminVipRecalc(ctx context.Context, in *AdminVipRecalcRequest, opts ...http.CallOption) (*AdminVipRecalcReply, error) {
+	var out AdminVipRecalcReply
+	pattern := "/api/admin_dhb/vip_recalc"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminVipRecalc))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
+	var out AdminWithdrawReply
+	pattern := "/api/admin_dhb/withdraw"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdraw))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
 func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...http.CallOption) (*AdminWithdrawEthReply, error) {
@@ -359,6 +1470,19 @@ func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithd
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) Convert(ctx context.Context, in *ConvertRequest, opts ...http.CallOption) (*ConvertReply, error) {
+	var out ConvertReply
+	pattern := "/api/app_server/convert"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppConvert))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, opts ...http.CallOption) (*DepositReply, error) {
 	var out DepositReply
 	pattern := "/api/admin_dhb/deposit"
@@ -385,6 +1509,19 @@ func (c *AppHTTPClientImpl) EthAuthorize(ctx context.Context, in *EthAuthorizeRe
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) ExitLocation(ctx context.Context, in *ExitLocationRequest, opts ...http.CallOption) (*ExitLocationReply, error) {
+	var out ExitLocationReply
+	pattern := "/api/app_server/exit_location"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppExitLocation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...http.CallOption) (*FeeRewardListReply, error) {
 	var out FeeRewardListReply
 	pattern := "/api/app_server/fee_reward_list"
@@ -398,6 +1535,32 @@ func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardList
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) LocationNeighbour(ctx context.Context, in *LocationNeighbourRequest, opts ...http.CallOption) (*LocationNeighbourReply, error) {
+	var out LocationNeighbourReply
+	pattern := "/api/app_server/location_neighbour"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppLocationNeighbour))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) MyLocationList(ctx context.Context, in *MyLocationListRequest, opts ...http.CallOption) (*MyLocationListReply, error) {
+	var out MyLocationListReply
+	pattern := "/api/app_server/my_location_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppMyLocationList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -424,6 +1587,45 @@ func (c *AppHTTPClientImpl) RecommendRewardList(ctx context.Context, in *Recomme
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...http.CallOption) (*RecommendTreeReply, error) {
+	var out RecommendTreeReply
+	pattern := "/api/app_server/recommend_tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppRecommendTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Reinvest(ctx context.Context, in *ReinvestRequest, opts ...http.CallOption) (*ReinvestReply, error) {
+	var out ReinvestReply
+	pattern := "/api/app_server/reinvest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppReinvest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) ReserveProof(ctx context.Context, in *ReserveProofRequest, opts ...http.CallOption) (*ReserveProofReply, error) {
+	var out ReserveProofReply
+	pattern := "/api/app_server/reserve_proof"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppReserveProof))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListRequest, opts ...http.CallOption) (*RewardListReply, error) {
 	var out RewardListReply
 	pattern := "/api/app_server/reward_list"
@@ -437,6 +1639,58 @@ func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListReques
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) SetAutoReinvest(ctx context.Context, in *SetAutoReinvestRequest, opts ...http.CallOption) (*SetAutoReinvestReply, error) {
+	var out SetAutoReinvestReply
+	pattern := "/api/app_server/set_auto_reinvest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppSetAutoReinvest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Statement(ctx context.Context, in *StatementRequest, opts ...http.CallOption) (*StatementReply, error) {
+	var out StatementReply
+	pattern := "/api/app_server/statement"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppStatement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Transfer(ctx context.Context, in *TransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/api/app_server/transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) UpgradeLocation(ctx context.Context, in *UpgradeLocationRequest, opts ...http.CallOption) (*UpgradeLocationReply, error) {
+	var out UpgradeLocationReply
+	pattern := "/api/app_server/upgrade_location"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppUpgradeLocation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...http.CallOption) (*UserInfoReply, error) {
 	var out UserInfoReply
 	pattern := "/api/app_server/user_info"
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"dhb/app/app/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+// 推荐链路检查，eg: recommendarea -conf ../../configs -fix
+var (
+	// flagconf is the config flag.
+	flagconf string
+	// flagfix 是否修复
+	flagfix bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&flagfix, "fix", false, "fix user_recommend_area, eg: -fix")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	uuc, cleanup, err := wireUserUseCase(bc.Data, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	diffs, err := uuc.CheckUserRecommendArea(context.Background(), flagfix)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, v := range diffs {
+		fmt.Printf("%s\t%d\t%d\t%s\n", v.Type, v.Num, v.ExpectNum, v.RecommendCode)
+	}
+	fmt.Printf("diff: %d, fixed: %t\n", len(diffs), flagfix && 0 < len(diffs))
+}
@@ -0,0 +1,20 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireUserUseCase init user usecase.
+func wireUserUseCase(*conf.Data, log.Logger) (*biz.UserUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
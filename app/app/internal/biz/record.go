@@ -2,27 +2,49 @@ package biz
 
 import (
 	"context"
+	v1 "dhb/app/app/api"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
 type EthUserRecord struct {
-	ID       int64
-	UserId   int64
-	Hash     string
-	Status   string
-	Type     string
-	Amount   string
-	CoinType string
+	ID              int64
+	UserId          int64
+	Hash            string
+	Status          string
+	Type            string
+	Amount          string
+	CoinType        string
+	BalanceRecordId int64 // 充值对应的余额记录
+	CreatedAt       time.Time
+}
+
+type DepositReversal struct {
+	ID              int64
+	Hash            string
+	EthUserRecordId int64
+	UserId          int64
+	LocationId      int64
+	Amount          int64
+	RewardNum       int64
+	RewardAmount    int64
+	Reason          string
+	CreatedBy       int64
+	CreatedAt       time.Time
 }
 
 type Location struct {
 	ID           int64
 	UserId       int64
+	MatrixId     int64
 	Status       string
+	Source       string // 入单来源 deposit 充值 reinvest 余额复投 auto_reinvest 自动复投
 	CurrentLevel int64
 	Current      int64
 	CurrentMax   int64
@@ -32,6 +54,165 @@ type Location struct {
 	CreatedAt    time.Time
 }
 
+// LocationMatrix 占位矩阵，不同档位或活动的占位互相独立排布和分红
+type LocationMatrix struct {
+	ID       int64
+	Name     string
+	LevelMin int64 // 可进入的最低档位
+	LevelMax int64 // 可进入的最高档位
+	ColMax   int64 // 每行列数
+	RowRange int64 // 同列分红的上下行范围
+	RowRate  int64 // 同行分红百分比
+	ColRate  int64 // 同列分红百分比
+	Status   string
+}
+
+// LocationEvent 占位变动流水，只追加不修改
+type LocationEvent struct {
+	ID             int64
+	LocationId     int64
+	UserId         int64
+	MatrixId       int64
+	Type           string // create 占位 credit 分红 stop 出局 shift 紧缩移位 upgrade 升级 exit 提前退出 reverse 充值冲正 move 移到新位置 rollback 冲正扣回分红
+	BeforeStatus   string
+	AfterStatus    string
+	BeforeCurrent  int64
+	AfterCurrent   int64
+	BeforeLevel    int64
+	AfterLevel     int64
+	BeforeMax      int64
+	AfterMax       int64
+	BeforeRow      int64
+	AfterRow       int64
+	BeforeCol      int64
+	AfterCol       int64
+	BeforeStopDate time.Time
+	AfterStopDate  time.Time
+	TriggerType    string // deposit 充值 reinvest 余额复投 auto_reinvest 自动复投 upgrade 余额升级 exit 提前退出 withdraw 提现 fee 手续费分红 deposit_reverse 充值冲正
+	TriggerRef     string // 充值hash，复投升级或退出的用户id，出局的占位id，提现id，手续费月份，冲正的充值hash
+	CorrelationId  string
+	CreatedAt      time.Time
+}
+
+// LocationTrigger 引起占位变动的业务，随上下文传到数据层记录流水
+type LocationTrigger struct {
+	Type          string
+	Ref           string
+	CorrelationId string
+}
+
+// 用来承载占位变动业务的上下文
+type contextLocationTriggerKey struct{}
+
+// NewLocationTriggerContext .
+func NewLocationTriggerContext(ctx context.Context, triggerType string, ref string) context.Context {
+	return context.WithValue(ctx, contextLocationTriggerKey{}, &LocationTrigger{
+		Type:          triggerType,
+		Ref:           ref,
+		CorrelationId: fmt.Sprintf("%s-%d", triggerType, time.Now().UTC().UnixNano()),
+	})
+}
+
+// LocationTriggerFromContext .
+func LocationTriggerFromContext(ctx context.Context) *LocationTrigger {
+	trigger, ok := ctx.Value(contextLocationTriggerKey{}).(*LocationTrigger)
+	if ok {
+		return trigger
+	}
+	return &LocationTrigger{}
+}
+
+// DhbReward 分红按比例折成dhb发放，随上下文传到数据层
+type DhbReward struct {
+	Rate  int64 // 折dhb的百分比
+	Price int64 // 1个dhb的usdt价格，系统精度
+}
+
+// 用来承载分红折dhb配置的上下文
+type contextDhbRewardKey struct{}
+
+// NewDhbRewardContext .
+func NewDhbRewardContext(ctx context.Context, rate int64, price int64) context.Context {
+	return context.WithValue(ctx, contextDhbRewardKey{}, &DhbReward{
+		Rate:  rate,
+		Price: price,
+	})
+}
+
+// DhbRewardFromContext .
+func DhbRewardFromContext(ctx context.Context) *DhbReward {
+	dhbReward, ok := ctx.Value(contextDhbRewardKey{}).(*DhbReward)
+	if ok {
+		return dhbReward
+	}
+	return &DhbReward{}
+}
+
+// DhbAmountByUsdt usdt按价格折算dhb数量，都是系统精度
+func DhbAmountByUsdt(value int64, price int64) int64 {
+	if 0 >= price {
+		return 0
+	}
+	return new(big.Int).Div(new(big.Int).Mul(big.NewInt(value), big.NewInt(10000000000)), big.NewInt(price)).Int64()
+}
+
+// UsdtAmountByDhb dhb按价格折算usdt，都是系统精度
+func UsdtAmountByDhb(amount int64, price int64) int64 {
+	return new(big.Int).Div(new(big.Int).Mul(big.NewInt(amount), big.NewInt(price)), big.NewInt(10000000000)).Int64()
+}
+
+// defaultDhbTokenAddress bsc主网dhb合约
+const defaultDhbTokenAddress = "0xb7864be857e00796e6f79e057b3ef1032cbe4a06"
+
+// getDhbTokenAddress 配置的dhb合约
+func getDhbTokenAddress(ctx context.Context, configRepo ConfigRepo) string {
+	configs, _ := configRepo.GetConfigByKeys(ctx, "dhb_token_address")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "dhb_token_address" == vConfig.KeyName && "" != strings.TrimSpace(vConfig.Value) {
+				return strings.TrimSpace(vConfig.Value)
+			}
+		}
+	}
+
+	return defaultDhbTokenAddress
+}
+
+// getDhbPrice 后台配置或者预言机同步的dhb价格，单位usdt
+func getDhbPrice(ctx context.Context, configRepo ConfigRepo) int64 {
+	var price int64
+	configs, _ := configRepo.GetConfigByKeys(ctx, "dhb_price")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "dhb_price" == vConfig.KeyName {
+				priceFloat, _ := strconv.ParseFloat(vConfig.Value, 10)
+				priceFloat *= 10000000000
+				price, _ = strconv.ParseInt(strconv.FormatFloat(priceFloat, 'f', 0, 64), 10, 64)
+			}
+		}
+	}
+
+	return price
+}
+
+// withDhbReward 读取分红折dhb的比例和价格放进上下文，没配置价格的不折
+func withDhbReward(ctx context.Context, configRepo ConfigRepo) context.Context {
+	var rate int64
+	configs, _ := configRepo.GetConfigByKeys(ctx, "reward_dhb_rate")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "reward_dhb_rate" == vConfig.KeyName {
+				rate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if 0 >= rate || 100 < rate {
+		return ctx
+	}
+
+	return NewDhbRewardContext(ctx, rate, getDhbPrice(ctx, configRepo))
+}
+
 type GlobalLock struct {
 	ID     int64
 	Status int64
@@ -52,20 +233,24 @@ type RecordUseCase struct {
 type EthUserRecordRepo interface {
 	GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
+	UpdateEthUserRecordStatus(ctx context.Context, id int64, fromStatus string, status string) error
+	GetEthUserRecordsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*EthUserRecord, error)
 }
 
 type LocationRepo interface {
 	CreateLocation(ctx context.Context, rel *Location) (*Location, error)
-	GetLocationLast(ctx context.Context) (*Location, error)
+	LockLocationMatrix(ctx context.Context, matrixId int64) error
+	GetLocationLast(ctx context.Context, matrixId int64) (*Location, error)
 	GetMyLocationLast(ctx context.Context, userId int64) (*Location, error)
 	GetMyStopLocationLast(ctx context.Context, userId int64) (*Location, error)
 	GetMyLocationRunningLast(ctx context.Context, userId int64) (*Location, error)
+	LockMyLocationRunningLast(ctx context.Context, userId int64) (*Location, error)
 	GetLocationsByUserId(ctx context.Context, userId int64) ([]*Location, error)
-	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64) ([]*Location, error)
+	GetRewardLocationByRowOrCol(ctx context.Context, matrix *LocationMatrix, row int64, col int64) ([]*Location, error)
 	GetRewardLocationByIds(ctx context.Context, ids ...int64) (map[int64]*Location, error)
 	UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error
 	GetLocations(ctx context.Context, b *Pagination, userId int64) ([]*Location, error, int64)
-	UpdateLocationRowAndCol(ctx context.Context, id int64) error
+	UpdateLocationRowAndCol(ctx context.Context, id int64, matrix *LocationMatrix) error
 	GetLocationsStopNotUpdate(ctx context.Context) ([]*Location, error)
 	LockGlobalLocation(ctx context.Context) (bool, error)
 	UnLockGlobalLocation(ctx context.Context) (bool, error)
@@ -73,6 +258,21 @@ type LocationRepo interface {
 	UnLockGlobalWithdraw(ctx context.Context) (bool, error)
 	GetLockGlobalLocation(ctx context.Context) (*GlobalLock, error)
 	GetLocationByIds(ctx context.Context, userIds ...int64) ([]*Location, error)
+	GetLocationMatrices(ctx context.Context) ([]*LocationMatrix, error)
+	GetLocationById(ctx context.Context, id int64) (*Location, error)
+	LockLocationById(ctx context.Context, id int64) (*Location, error)
+	GetLocationEventsByLocationId(ctx context.Context, locationId int64) ([]*LocationEvent, error)
+	GetLocationRunningAheadCount(ctx context.Context, matrixId int64, row int64, col int64) (int64, error)
+	GetLocationNeighbourCache(ctx context.Context, userId int64) (string, error)
+	SetLocationNeighbourCache(ctx context.Context, userId int64, value string, expiration time.Duration) error
+	GetLocationEventLastId(ctx context.Context) (int64, error)
+	GetLocationStopEventsAfterId(ctx context.Context, id int64) ([]*LocationEvent, error)
+	UpgradeLocation(ctx context.Context, id int64, currentLevel int64, currentMax int64) error
+	ExitLocation(ctx context.Context, id int64, stopDate time.Time) error
+	MoveLocation(ctx context.Context, id int64, stopDate time.Time) error
+	RollbackLocation(ctx context.Context, id int64, current int64, restore bool, stopDate time.Time) (*Location, error)
+	GetLocationEventsByTrigger(ctx context.Context, triggerType string, triggerRef string) ([]*LocationEvent, error)
+	ReverseLocation(ctx context.Context, id int64, stopDate time.Time) error
 }
 
 func NewRecordUseCase(
@@ -98,432 +298,1550 @@ func NewRecordUseCase(
 	}
 }
 
+// defaultLocationMatrix 未配置矩阵时沿用原有的全局占位规则
+func defaultLocationMatrix() *LocationMatrix {
+	return &LocationMatrix{
+		ID:       1,
+		Name:     "default",
+		LevelMin: 1,
+		LevelMax: 3,
+		ColMax:   3,
+		RowRange: 25,
+		RowRate:  5,
+		ColRate:  1,
+		Status:   "open",
+	}
+}
+
+// getLocationMatrices 全部占位矩阵
+func getLocationMatrices(ctx context.Context, locationRepo LocationRepo) []*LocationMatrix {
+	matrices, _ := locationRepo.GetLocationMatrices(ctx)
+	if 0 >= len(matrices) {
+		return []*LocationMatrix{defaultLocationMatrix()}
+	}
+
+	return matrices
+}
+
+// locationMatrixByLevel 新占位按档位进入对应矩阵
+func locationMatrixByLevel(matrices []*LocationMatrix, level int64) *LocationMatrix {
+	for _, v := range matrices {
+		if "open" == v.Status && v.LevelMin <= level && level <= v.LevelMax {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// locationMatrixById 已有占位所在的矩阵
+func locationMatrixById(matrices []*LocationMatrix, matrixId int64) *LocationMatrix {
+	for _, v := range matrices {
+		if matrixId == v.ID {
+			return v
+		}
+	}
+
+	matrix := defaultLocationMatrix() // 矩阵配置被删除时按默认规则处理
+	matrix.ID = matrixId
+	return matrix
+}
+
 func (ruc *RecordUseCase) GetEthUserRecordByTxHash(ctx context.Context, txHash ...string) (map[string]*EthUserRecord, error) {
 	return ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, txHash...)
 }
 
-func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+// locationConfig 入单分红配置
+type locationConfig struct {
+	recommendNeed   int64
+	timeAgain       int64
+	exitRefundRate  int64  // 提前退出返还未回本部分的百分比
+	exitPenaltyRate int64  // 提前退出返还部分扣除的违约金百分比
+	exitPenaltyTo   string // 违约金去向 system 系统 location 同行同列的占位
+	vipLevels       []*VipLevel
+	recommendLevels []*RecommendLevel
+	matrices        []*LocationMatrix
+}
 
+// locationPlan 一次入单的占位位置和分红对象
+type locationPlan struct {
+	userId                  int64
+	firstLocation           bool // 首次入单
+	matrix                  *LocationMatrix
+	currentLevel            int64
+	currentValue            int64
+	currentMax              int64
+	current                 int64 // 复投补上的额度
+	row                     int64
+	col                     int64
+	rewardLocations         []*Location
+	myUserRecommendUserId   int64
+	myUserRecommendUserInfo *UserInfo
+	recommendUpUserIds      []int64 // 推荐链路上级，由近到远
+	myLastStopLocation      *Location
+}
+
+// getLocationConfig .
+func (ruc *RecordUseCase) getLocationConfig(ctx context.Context) *locationConfig {
 	var (
-		configs           []*Config
-		recommendNeed     int64
-		recommendNeedVip1 int64
-		recommendNeedVip2 int64
-		recommendNeedVip3 int64
-		recommendNeedVip4 int64
-		recommendNeedVip5 int64
-		timeAgain         int64
+		configs []*Config
+		lc      = &locationConfig{}
 	)
 	// 配置
-	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "recommend_need_vip1", "recommend_need_vip2",
-		"recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5", "time_again")
+	configs, _ = ruc.configRepo.GetConfigByKeys(ctx, "recommend_need", "time_again",
+		"exit_refund_rate", "exit_penalty_rate", "exit_penalty_to")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "recommend_need" == vConfig.KeyName {
-				recommendNeed, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip1" == vConfig.KeyName {
-				recommendNeedVip1, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip2" == vConfig.KeyName {
-				recommendNeedVip2, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip3" == vConfig.KeyName {
-				recommendNeedVip3, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip4" == vConfig.KeyName {
-				recommendNeedVip4, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip5" == vConfig.KeyName {
-				recommendNeedVip5, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				lc.recommendNeed, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			} else if "time_again" == vConfig.KeyName {
-				timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				lc.timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "exit_refund_rate" == vConfig.KeyName {
+				lc.exitRefundRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "exit_penalty_rate" == vConfig.KeyName {
+				lc.exitPenaltyRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			} else if "exit_penalty_to" == vConfig.KeyName {
+				lc.exitPenaltyTo = vConfig.Value
 			}
 		}
 	}
-	fmt.Println(recommendNeed, recommendNeedVip1, recommendNeedVip2, recommendNeedVip3, recommendNeedVip4, recommendNeedVip5, timeAgain)
+	fmt.Println(lc.recommendNeed, lc.timeAgain)
 
-	for _, v := range ethUserRecord {
-		var (
-			lastLocation                    *Location
-			myLocations                     []*Location
-			currentValue                    int64
-			amount                          int64
-			locationCurrentLevel            int64
-			locationCurrent                 int64
-			locationCurrentMax              int64
-			locationRow                     int64
-			locationCol                     int64
-			currentLocation                 *Location
-			rewardLocations                 []*Location
-			userRecommend                   *UserRecommend
-			myUserRecommendUserId           int64
-			myUserRecommendUserInfo         *UserInfo
-			myUserRecommendUserLocationLast *Location
-			stopLocations                   []*Location
-			myLastStopLocation              *Location
-			err                             error
-		)
+	// 会员等级
+	lc.vipLevels = getVipLevels(ctx, ruc.userInfoRepo, ruc.configRepo)
 
-		//if "DHB" == v.CoinType {
-		//	continue
-		//}
+	// 多层级推荐
+	lc.recommendLevels, _ = ruc.userRecommendRepo.GetRecommendLevels(ctx)
 
-		// 获取当前用户的占位信息，已经有运行中的跳过
-		myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, v.UserId)
-		if nil == myLocations { // 查询异常跳过本次循环
-			continue
-		}
-		if 0 < len(myLocations) { // 也代表复投
-			tmpStatusRunning := false
-			for _, vMyLocations := range myLocations {
-				if "running" == vMyLocations.Status {
-					tmpStatusRunning = true
-					break
-				}
-			}
+	// 占位矩阵
+	lc.matrices = getLocationMatrices(ctx, ruc.locationRepo)
 
-			if tmpStatusRunning { // 有运行中直接跳过本次循环
-				continue
-			}
-		}
+	return lc
+}
 
-		// 先紧缩一次位置
-		stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-		if nil != stopLocations {
-			// 调整位置紧缩
-			for _, vStopLocations := range stopLocations {
+// locationLevelByAmount 链上充值金额对应的档位
+func locationLevelByAmount(amount string) int64 {
+	// todo
+	if "100000000000000000000" == amount {
+		return 1
+	} else if "200000000000000000000" == amount {
+		return 2
+	} else if "500000000000000000000" == amount {
+		return 3
+	}
 
-				if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
-					if nil != err {
-						return err
-					}
-					return nil
-				}); nil != err {
-					continue
+	return 0
+}
+
+// locationLevelValue 档位对应的入单金额和最大分红额度
+func locationLevelValue(level int64) (int64, int64) {
+	if 1 == level {
+		return 1000000000000, 5000000000000
+	} else if 2 == level {
+		return 2000000000000, 10000000000000
+	} else if 3 == level {
+		return 5000000000000, 25000000000000
+	}
+
+	return 0, 0
+}
+
+// compactLocations 调整位置紧缩
+func (ruc *RecordUseCase) compactLocations(ctx context.Context, matrices []*LocationMatrix) {
+	stopLocations, _ := ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
+	if nil != stopLocations {
+		// 调整位置紧缩
+		for _, vStopLocations := range stopLocations {
+
+			if err := ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				if err := ruc.locationRepo.LockLocationMatrix(ctx, vStopLocations.MatrixId); nil != err { // 和入单排队
+					return err
 				}
+				return ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID, locationMatrixById(matrices, vStopLocations.MatrixId))
+			}); nil != err {
+				continue
 			}
 		}
+	}
+}
 
-		// 获取最后一行数据
-		lastLocation, err = ruc.locationRepo.GetLocationLast(ctx)
-		if nil == lastLocation {
-			locationRow = 1
-			locationCol = 1
-			fmt.Println(25, locationRow, locationRow)
-		} else {
-			if 3 > lastLocation.Col {
-				locationCol = lastLocation.Col + 1
-				locationRow = lastLocation.Row
-				fmt.Println(33, locationCol, locationRow)
-			} else {
-				locationCol = 1
-				locationRow = lastLocation.Row + 1
-				fmt.Println(22, locationRow, locationRow)
-			}
-		}
-
-		// todo
-		if "100000000000000000000" == v.Amount {
-			locationCurrentLevel = 1
-			locationCurrentMax = 5000000000000
-			currentValue = 1000000000000
-		} else if "200000000000000000000" == v.Amount {
-			locationCurrentLevel = 2
-			locationCurrentMax = 10000000000000
-			currentValue = 2000000000000
-		} else if "500000000000000000000" == v.Amount {
-			locationCurrentLevel = 3
-			locationCurrentMax = 25000000000000
-			currentValue = 5000000000000
+// prepareLocation 计算新占位的位置和分红对象，事务中使用，锁住矩阵到事务结束
+func (ruc *RecordUseCase) prepareLocation(ctx context.Context, lc *locationConfig, userId int64, level int64, firstLocation bool) (*locationPlan, error) {
+	var err error
+
+	plan := &locationPlan{
+		userId:        userId,
+		firstLocation: firstLocation,
+		currentLevel:  level,
+	}
+	plan.currentValue, plan.currentMax = locationLevelValue(level)
+	if 0 >= plan.currentValue {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
+	}
+
+	// 按档位进入对应矩阵
+	plan.matrix = locationMatrixByLevel(lc.matrices, level)
+	if nil == plan.matrix {
+		return nil, errors.New(500, "MATRIX_ERROR", "档位没有对应的矩阵")
+	}
+
+	plan.row, plan.col, err = ruc.nextLocationPosition(ctx, plan.matrix)
+	if nil != err {
+		return nil, err
+	}
+
+	// 同一用户并发入单可能进不同矩阵，锁住余额后再确认没有运行中的占位
+	if err = ruc.userBalanceRepo.LockUserBalance(ctx, userId); nil != err {
+		return nil, err
+	}
+	if runningLocation, _ := ruc.locationRepo.LockMyLocationRunningLast(ctx, userId); nil != runningLocation {
+		return nil, errors.New(500, "LOCATION_RUNNING", "已有运行中的占位")
+	}
+
+	// 占位分红人
+	plan.rewardLocations, err = ruc.locationRepo.GetRewardLocationByRowOrCol(ctx, plan.matrix, plan.row, plan.col)
+
+	// 推荐人
+	if err = ruc.prepareRecommend(ctx, plan); nil != err {
+		return nil, err
+	}
+
+	plan.myLastStopLocation, err = ruc.locationRepo.GetMyStopLocationLast(ctx, userId)
+	now := time.Now().UTC().Add(8 * time.Hour)
+	if nil != plan.myLastStopLocation && now.Before(plan.myLastStopLocation.StopDate.Add(time.Duration(lc.timeAgain)*time.Minute)) &&
+		plan.myLastStopLocation.Current > plan.myLastStopLocation.CurrentMax { // 提前退出的没有超出部分
+		plan.current = plan.myLastStopLocation.Current - plan.myLastStopLocation.CurrentMax // 补上
+	}
+
+	return plan, nil
+}
+
+// nextLocationPosition 矩阵最后一个位置之后的位置，事务中使用，锁住矩阵到事务结束
+func (ruc *RecordUseCase) nextLocationPosition(ctx context.Context, matrix *LocationMatrix) (int64, int64, error) {
+	var (
+		lastLocation *Location
+		row          int64
+		col          int64
+		err          error
+	)
+
+	// 同一矩阵并发入单会拿到同一个位置，先锁矩阵
+	if err = ruc.locationRepo.LockLocationMatrix(ctx, matrix.ID); nil != err {
+		return 0, 0, err
+	}
+
+	// 获取矩阵最后一行数据
+	lastLocation, err = ruc.locationRepo.GetLocationLast(ctx, matrix.ID)
+	if nil == lastLocation {
+		row = 1
+		col = 1
+		fmt.Println(25, row, row)
+	} else {
+		if matrix.ColMax > lastLocation.Col {
+			col = lastLocation.Col + 1
+			row = lastLocation.Row
+			fmt.Println(33, col, row)
 		} else {
-			continue
+			col = 1
+			row = lastLocation.Row + 1
+			fmt.Println(22, row, row)
 		}
-		amount = currentValue
+	}
 
-		// 占位分红人
-		rewardLocations, err = ruc.locationRepo.GetRewardLocationByRowOrCol(ctx, locationRow, locationCol)
+	return row, col, nil
+}
 
-		// 推荐人
-		userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, v.UserId)
-		if nil != err {
-			continue
-		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
-			if 2 <= len(tmpRecommendUserIds) {
-				myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-			}
-		}
-		if 0 < myUserRecommendUserId {
-			myUserRecommendUserInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, myUserRecommendUserId)
-		}
+// createLocation 占位并分红，事务中使用
+func (ruc *RecordUseCase) createLocation(ctx context.Context, lc *locationConfig, plan *locationPlan) (*Location, error) {
+	var (
+		currentLocation *Location
+		amount          int64
+		err             error
+	)
 
-		myLastStopLocation, err = ruc.locationRepo.GetMyStopLocationLast(ctx, v.UserId)
-		now := time.Now().UTC().Add(8 * time.Hour)
-		if nil != myLastStopLocation && now.Before(myLastStopLocation.StopDate.Add(time.Duration(timeAgain)*time.Minute)) {
-			locationCurrent = myLastStopLocation.Current - myLastStopLocation.CurrentMax // 补上
-		}
+	currentLocation, err = ruc.locationRepo.CreateLocation(ctx, &Location{ // 占位
+		UserId:       plan.userId,
+		MatrixId:     plan.matrix.ID,
+		Status:       "running",
+		CurrentLevel: plan.currentLevel,
+		Current:      plan.current,
+		CurrentMax:   plan.currentMax,
+		Row:          plan.row,
+		Col:          plan.col,
+	})
+	if nil != err {
+		return nil, err
+	}
 
-		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			currentLocation, err = ruc.locationRepo.CreateLocation(ctx, &Location{ // 占位
-				UserId:       v.UserId,
-				Status:       "running",
-				CurrentLevel: locationCurrentLevel,
-				Current:      locationCurrent,
-				CurrentMax:   locationCurrentMax,
-				Row:          locationRow,
-				Col:          locationCol,
-			})
-			if nil != err {
-				return err
-			}
+	amount, err = ruc.rewardLocation(ctx, lc, plan, currentLocation)
+	if nil != err {
+		return nil, err
+	}
+	if 0 > amount { // 分红比例合计超过入单金额
+		return nil, errors.New(500, "REWARD_RATE_ERROR", "分红比例配置错误")
+	}
 
-			// 占位分红人分红
-			if nil != rewardLocations {
-				for _, vRewardLocations := range rewardLocations {
-					if "running" != vRewardLocations.Status {
-						continue
-					}
-					if locationRow == vRewardLocations.Row && locationCol == vRewardLocations.Col { // 跳过自己
-						continue
-					}
+	if 0 < plan.current && nil != plan.myLastStopLocation {
+		_, err = ruc.userBalanceRepo.DepositLast(ctx, plan.userId, plan.current, plan.myLastStopLocation.ID, currentLocation.ID) // 充值
+		if nil != err {
+			return nil, err
+		}
+	}
 
-					var locationType string
-					var tmpAmount int64
-					if locationRow == vRewardLocations.Row { // 同行的人
-						tmpAmount = currentValue / 100 * 5
-						locationType = "row"
-					} else if locationCol == vRewardLocations.Col { // 同列的人
-						tmpAmount = currentValue / 100
-						locationType = "col"
-					} else {
-						continue
-					}
+	err = ruc.userBalanceRepo.SystemReward(ctx, amount, currentLocation.ID)
+	if nil != err {
+		return nil, err
+	}
 
-					tmpCurrentStatus := vRewardLocations.Status // 现在还在运行中
-					tmpCurrent := vRewardLocations.Current
-
-					tmpBalanceAmount := tmpAmount
-					vRewardLocations.Status = "running"
-					vRewardLocations.Current += tmpAmount
-					if vRewardLocations.Current >= vRewardLocations.CurrentMax { // 占位分红人分满停止
-						if "running" == tmpCurrentStatus {
-							vRewardLocations.StopDate = time.Now().UTC().Add(8 * time.Hour)
-						}
-						vRewardLocations.Status = "stop"
-					}
+	return currentLocation, nil
+}
 
-					if 0 < tmpBalanceAmount {
-						err = ruc.locationRepo.UpdateLocation(ctx, vRewardLocations.ID, vRewardLocations.Status, tmpBalanceAmount, vRewardLocations.StopDate) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-						amount -= tmpBalanceAmount // 扣除
-
-						if 0 < tmpBalanceAmount && "running" == tmpCurrentStatus && tmpCurrent < vRewardLocations.CurrentMax { // 这次还能分红
-							tmpCurrentAmount := vRewardLocations.CurrentMax - tmpCurrent // 最大可分红额度
-							rewardAmount := tmpBalanceAmount
-							if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
-								rewardAmount = tmpCurrentAmount
-							}
-
-							_, err = ruc.userBalanceRepo.LocationReward(ctx, vRewardLocations.UserId, rewardAmount, currentLocation.ID, vRewardLocations.ID, locationType) // 分红信息修改
-							if nil != err {
-								return err
-							}
-						}
-					}
-				}
+// rewardLocation 入单金额给占位分红人和推荐人分红，返回剩下给系统的金额，事务中使用
+func (ruc *RecordUseCase) rewardLocation(ctx context.Context, lc *locationConfig, plan *locationPlan, currentLocation *Location) (int64, error) {
+	var (
+		myUserRecommendUserLocationLast *Location
+		currentValue                    = plan.currentValue
+		amount                          = plan.currentValue
+		myUserRecommendUserInfo         = plan.myUserRecommendUserInfo
+		myUserRecommendUserId           = plan.myUserRecommendUserId
+		err                             error
+	)
 
+	// 占位分红人分红
+	if nil != plan.rewardLocations {
+		for _, vRewardLocations := range plan.rewardLocations {
+			if "running" != vRewardLocations.Status {
+				continue
+			}
+			if plan.row == vRewardLocations.Row && plan.col == vRewardLocations.Col { // 跳过自己
+				continue
 			}
 
-			// 推荐人
-			if nil != myUserRecommendUserInfo {
-				if 0 == len(myLocations) { // vip 等级调整，被推荐人首次入单
-					myUserRecommendUserInfo.HistoryRecommend += 1
-					if myUserRecommendUserInfo.HistoryRecommend >= 10 {
-						myUserRecommendUserInfo.Vip = 5
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 8 {
-						myUserRecommendUserInfo.Vip = 4
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 6 {
-						myUserRecommendUserInfo.Vip = 3
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 4 {
-						myUserRecommendUserInfo.Vip = 2
-					} else if myUserRecommendUserInfo.HistoryRecommend >= 2 {
-						myUserRecommendUserInfo.Vip = 1
-					}
+			var locationType string
+			var tmpAmount int64
+			if plan.row == vRewardLocations.Row { // 同行的人
+				tmpAmount = currentValue / 100 * plan.matrix.RowRate
+				locationType = "row"
+			} else if plan.col == vRewardLocations.Col { // 同列的人
+				tmpAmount = currentValue / 100 * plan.matrix.ColRate
+				locationType = "col"
+			} else {
+				continue
+			}
 
-					_, err = ruc.userInfoRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
-					if nil != err {
-						return err
-					}
+			tmpCurrentStatus := vRewardLocations.Status // 现在还在运行中
+			tmpCurrent := vRewardLocations.Current
 
-					_, err = ruc.userCurrentMonthRecommendRepo.CreateUserCurrentMonthRecommend(ctx, &UserCurrentMonthRecommend{ // 直推人本月推荐人数
-						UserId:          myUserRecommendUserId,
-						RecommendUserId: v.UserId,
-						Date:            time.Now().UTC().Add(8 * time.Hour),
-					})
-					if nil != err {
-						return err
-					}
+			tmpBalanceAmount := tmpAmount
+			vRewardLocations.Status = "running"
+			vRewardLocations.Current += tmpAmount
+			if vRewardLocations.Current >= vRewardLocations.CurrentMax { // 占位分红人分满停止
+				if "running" == tmpCurrentStatus {
+					vRewardLocations.StopDate = time.Now().UTC().Add(8 * time.Hour)
 				}
+				vRewardLocations.Status = "stop"
+			}
 
-				// 有占位信息
-				myUserRecommendUserLocationLast, err = ruc.locationRepo.GetMyLocationLast(ctx, myUserRecommendUserInfo.UserId)
-				if nil != myUserRecommendUserLocationLast {
-					tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
-					tmpCurrent := myUserRecommendUserLocationLast.Current
-
-					tmpBalanceAmount := currentValue / 100 * recommendNeed // 记录下一次
-					myUserRecommendUserLocationLast.Status = "running"
-					myUserRecommendUserLocationLast.Current += tmpBalanceAmount
-					if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-						myUserRecommendUserLocationLast.Status = "stop"
-						if "running" == tmpStatus {
-							myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-						}
-					}
-					if 0 < tmpBalanceAmount {
-						err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-					}
-					amount -= tmpBalanceAmount // 扣除
-
-					if 0 < tmpBalanceAmount && "running" == tmpStatus && tmpCurrent < myUserRecommendUserLocationLast.CurrentMax { // 这次还能分红
-						tmpCurrentAmount := myUserRecommendUserLocationLast.CurrentMax - tmpCurrent // 最大可分红额度
-						rewardAmount := tmpBalanceAmount
-						if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
-							rewardAmount = tmpCurrentAmount
-						}
-						_, err = ruc.userBalanceRepo.NormalRecommendReward(ctx, myUserRecommendUserId, rewardAmount, currentLocation.ID) // 直推人奖励
-						if nil != err {
-							return err
-						}
-
-					}
+			if 0 < tmpBalanceAmount {
+				err = ruc.locationRepo.UpdateLocation(ctx, vRewardLocations.ID, vRewardLocations.Status, tmpBalanceAmount, vRewardLocations.StopDate) // 分红占位数据修改
+				if nil != err {
+					return 0, err
 				}
+				amount -= tmpBalanceAmount // 扣除
 
-				if nil != myUserRecommendUserLocationLast {
-					var tmpMyRecommendAmount int64
-					if 5 == myUserRecommendUserInfo.Vip { // 会员等级分红
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip5
-					} else if 4 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip4
-					} else if 3 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip3
-					} else if 2 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip2
-					} else if 1 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip1
+				if 0 < tmpBalanceAmount && "running" == tmpCurrentStatus && tmpCurrent < vRewardLocations.CurrentMax { // 这次还能分红
+					tmpCurrentAmount := vRewardLocations.CurrentMax - tmpCurrent // 最大可分红额度
+					rewardAmount := tmpBalanceAmount
+					if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
+						rewardAmount = tmpCurrentAmount
 					}
-					if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
-						tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
-						tmpCurrent := myUserRecommendUserLocationLast.Current
-
-						tmpBalanceAmount := tmpMyRecommendAmount // 记录下一次
-						myUserRecommendUserLocationLast.Status = "running"
-						myUserRecommendUserLocationLast.Current += tmpBalanceAmount
-						if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-							myUserRecommendUserLocationLast.Status = "stop"
-							if "running" == tmpStatus {
-								myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-							}
-						}
-						if 0 < tmpBalanceAmount {
-							err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-						}
-						amount -= tmpBalanceAmount                                                                                     // 扣除
-						if 0 < tmpBalanceAmount && "running" == tmpStatus && tmpCurrent < myUserRecommendUserLocationLast.CurrentMax { // 这次还能分红
-							tmpCurrentAmount := myUserRecommendUserLocationLast.CurrentMax - tmpCurrent // 最大可分红额度
-							rewardAmount := tmpBalanceAmount
-							if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
-								rewardAmount = tmpCurrentAmount
-							}
-							_, err = ruc.userBalanceRepo.RecommendReward(ctx, myUserRecommendUserId, rewardAmount, currentLocation.ID) // 推荐人奖励
-							if nil != err {
-								return err
-							}
-
-						}
+
+					_, err = ruc.userBalanceRepo.LocationReward(ctx, vRewardLocations.UserId, rewardAmount, currentLocation.ID, vRewardLocations.ID, locationType) // 分红信息修改
+					if nil != err {
+						return 0, err
 					}
 				}
 			}
+		}
+
+	}
 
-			_, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, currentValue) // 充值
+	// 推荐人
+	if nil != myUserRecommendUserInfo {
+		if plan.firstLocation { // vip 等级调整，被推荐人首次入单
+			myUserRecommendUserInfo.HistoryRecommend += 1
+			_, err = ruc.userInfoRepo.UpdateUserInfo(ctx, myUserRecommendUserInfo) // 推荐人信息修改
 			if nil != err {
-				return err
+				return 0, err
 			}
 
-			if 0 < locationCurrent && nil != myLastStopLocation {
-				_, err = ruc.userBalanceRepo.DepositLast(ctx, v.UserId, locationCurrent, myLastStopLocation.ID) // 充值
-				if nil != err {
-					return err
+			// 只看直推门槛的等级当场升级，团队和小区业绩门槛由定时重新计算
+			var tmpVipLevel *VipLevel
+			for _, vVipLevel := range lc.vipLevels {
+				if 0 < vVipLevel.NeedTeam || 0 < vVipLevel.NeedArea || myUserRecommendUserInfo.HistoryRecommend < vVipLevel.NeedRecommend {
+					continue
+				}
+				if myUserRecommendUserInfo.Vip < vVipLevel.Level && (nil == tmpVipLevel || tmpVipLevel.Level < vVipLevel.Level) {
+					tmpVipLevel = vVipLevel
 				}
 			}
 
-			err = ruc.userBalanceRepo.SystemReward(ctx, amount, currentLocation.ID)
-			if nil != err {
-				return err
+			if nil != tmpVipLevel {
+				var tmpVipExpire time.Time
+				if 0 < tmpVipLevel.ExpireDays {
+					tmpVipExpire = time.Now().UTC().Add(8*time.Hour).AddDate(0, 0, int(tmpVipLevel.ExpireDays))
+				}
+				err = ruc.userInfoRepo.UpdateUserInfoVip(ctx, myUserRecommendUserInfo.UserId, tmpVipLevel.Level, tmpVipExpire)
+				if nil != err {
+					return 0, err
+				}
+				err = ruc.userInfoRepo.CreateUserVipLog(ctx, &UserVipLog{
+					UserId:    myUserRecommendUserInfo.UserId,
+					BeforeVip: myUserRecommendUserInfo.Vip,
+					AfterVip:  tmpVipLevel.Level,
+					Reason:    "recommend",
+				})
+				if nil != err {
+					return 0, err
+				}
+				myUserRecommendUserInfo.Vip = tmpVipLevel.Level
+				myUserRecommendUserInfo.VipExpire = tmpVipExpire
 			}
 
-			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:     v.Hash,
-				UserId:   v.UserId,
-				Status:   v.Status,
-				Type:     v.Type,
-				Amount:   v.Amount,
-				CoinType: v.CoinType,
+			_, err = ruc.userCurrentMonthRecommendRepo.CreateUserCurrentMonthRecommend(ctx, &UserCurrentMonthRecommend{ // 直推人本月推荐人数
+				UserId:          myUserRecommendUserId,
+				RecommendUserId: plan.userId,
+				Date:            time.Now().UTC().Add(8 * time.Hour),
 			})
 			if nil != err {
-				return err
+				return 0, err
 			}
+		}
 
-			//dhbAmount, _ := strconv.ParseInt(ethUserRecord[k+1].Amount, 10, 64)
-			//dhbAmount /= 100000000                                                             // 转换为系统精度
-			//_, err = ruc.userBalanceRepo.DepositDhb(ctx, ethUserRecord[k+1].UserId, dhbAmount) // 充值
-			//if nil != err {
-			//	return err
-			//}
-			//
-			//_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-			//	Hash:     ethUserRecord[k+1].Hash,
-			//	UserId:   ethUserRecord[k+1].UserId,
-			//	Status:   ethUserRecord[k+1].Status,
-			//	Type:     ethUserRecord[k+1].Type,
-			//	Amount:   ethUserRecord[k+1].Amount,
-			//	CoinType: ethUserRecord[k+1].CoinType,
-			//})
-			//if nil != err {
-			//	return err
-			//}
+		// 有占位信息
+		myUserRecommendUserLocationLast, err = ruc.locationRepo.GetMyLocationLast(ctx, myUserRecommendUserInfo.UserId)
+		if nil != myUserRecommendUserLocationLast {
+			tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
+			tmpCurrent := myUserRecommendUserLocationLast.Current
 
-			return nil
-		}); nil != err {
-			continue
+			tmpBalanceAmount := currentValue / 100 * lc.recommendNeed // 记录下一次
+			myUserRecommendUserLocationLast.Status = "running"
+			myUserRecommendUserLocationLast.Current += tmpBalanceAmount
+			if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
+				myUserRecommendUserLocationLast.Status = "stop"
+				if "running" == tmpStatus {
+					myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+				}
+			}
+			if 0 < tmpBalanceAmount {
+				err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
+				if nil != err {
+					return 0, err
+				}
+			}
+			amount -= tmpBalanceAmount // 扣除
+
+			if 0 < tmpBalanceAmount && "running" == tmpStatus && tmpCurrent < myUserRecommendUserLocationLast.CurrentMax { // 这次还能分红
+				tmpCurrentAmount := myUserRecommendUserLocationLast.CurrentMax - tmpCurrent // 最大可分红额度
+				rewardAmount := tmpBalanceAmount
+				if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
+					rewardAmount = tmpCurrentAmount
+				}
+				_, err = ruc.userBalanceRepo.NormalRecommendReward(ctx, myUserRecommendUserId, rewardAmount, currentLocation.ID, myUserRecommendUserLocationLast.ID) // 直推人奖励
+				if nil != err {
+					return 0, err
+				}
+
+			}
 		}
 
-		// 调整位置紧缩
-		stopLocations, err = ruc.locationRepo.GetLocationsStopNotUpdate(ctx)
-		if nil != stopLocations {
-			// 调整位置紧缩
-			for _, vStopLocations := range stopLocations {
+		if nil != myUserRecommendUserLocationLast {
+			// 会员等级分红
+			tmpMyRecommendAmount := currentValue / 100 * vipLevelRate(lc.vipLevels, myUserRecommendUserInfo.Vip)
+			if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
+				tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
+				tmpCurrent := myUserRecommendUserLocationLast.Current
 
-				if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
+				tmpBalanceAmount := tmpMyRecommendAmount // 记录下一次
+				myUserRecommendUserLocationLast.Status = "running"
+				myUserRecommendUserLocationLast.Current += tmpBalanceAmount
+				if myUserRecommendUserLocationLast.Current >= myUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
+					myUserRecommendUserLocationLast.Status = "stop"
+					if "running" == tmpStatus {
+						myUserRecommendUserLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+					}
+				}
+				if 0 < tmpBalanceAmount {
+					err = ruc.locationRepo.UpdateLocation(ctx, myUserRecommendUserLocationLast.ID, myUserRecommendUserLocationLast.Status, tmpBalanceAmount, myUserRecommendUserLocationLast.StopDate) // 分红占位数据修改
 					if nil != err {
-						return err
+						return 0, err
 					}
-					return nil
-				}); nil != err {
-					continue
+				}
+				amount -= tmpBalanceAmount                                                                                     // 扣除
+				if 0 < tmpBalanceAmount && "running" == tmpStatus && tmpCurrent < myUserRecommendUserLocationLast.CurrentMax { // 这次还能分红
+					tmpCurrentAmount := myUserRecommendUserLocationLast.CurrentMax - tmpCurrent // 最大可分红额度
+					rewardAmount := tmpBalanceAmount
+					if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
+						rewardAmount = tmpCurrentAmount
+					}
+					_, err = ruc.userBalanceRepo.RecommendReward(ctx, myUserRecommendUserId, rewardAmount, currentLocation.ID, myUserRecommendUserLocationLast.ID) // 推荐人奖励
+					if nil != err {
+						return 0, err
+					}
+
 				}
 			}
 		}
 	}
 
-	return true, nil
+	// 直推人以上的多层级推荐分红
+	var tmpLevelAmount int64
+	tmpLevelAmount, err = rewardRecommendLevels(ctx, ruc.locationRepo, ruc.userBalanceRepo, ruc.userInfoRepo, lc.recommendLevels, plan.recommendUpUserIds, currentValue, currentLocation.ID, "location")
+	if nil != err {
+		return 0, err
+	}
+	amount -= tmpLevelAmount
+
+	return amount, nil
+}
+
+// prepareRecommend 直推人信息
+func (ruc *RecordUseCase) prepareRecommend(ctx context.Context, plan *locationPlan) error {
+	userRecommend, err := ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, plan.userId)
+	if nil != err {
+		return err
+	}
+	if "" != userRecommend.RecommendCode {
+		tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
+		if 2 <= len(tmpRecommendUserIds) {
+			plan.myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
+		}
+	}
+	plan.recommendUpUserIds = recommendUpUserIds(userRecommend.RecommendCode)
+	if 0 < plan.myUserRecommendUserId {
+		plan.myUserRecommendUserInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, plan.myUserRecommendUserId)
+	}
+
+	return nil
+}
+
+// locationUpgradeLevelByAmount 运行中的占位补差价升级，链上充值金额对应升到的档位
+func locationUpgradeLevelByAmount(currentLevel int64, amount string) int64 {
+	currentValue, _ := locationLevelValue(currentLevel)
+	for level := currentLevel + 1; level <= 3; level++ {
+		tmpValue, _ := locationLevelValue(level)
+		if strconv.FormatInt(tmpValue-currentValue, 10)+"00000000" == amount { // 系统精度转换为链上精度
+			return level
+		}
+	}
+
+	return 0
+}
+
+// prepareUpgrade 升级按补的差价分红，所在矩阵支持目标档位时保持原来的位置，不支持时移到目标档位矩阵的最后，事务中使用，锁住矩阵到事务结束
+func (ruc *RecordUseCase) prepareUpgrade(ctx context.Context, lc *locationConfig, location *Location, level int64) (*locationPlan, error) {
+	var (
+		currentValue int64
+		err          error
+	)
+
+	// 锁住矩阵后重新读，紧缩可能已经移动了位置
+	if err = ruc.locationRepo.LockLocationMatrix(ctx, location.MatrixId); nil != err {
+		return nil, err
+	}
+	var lockedLocation *Location
+	lockedLocation, err = ruc.locationRepo.GetLocationById(ctx, location.ID)
+	if nil == lockedLocation {
+		return nil, errors.New(500, "LOCATION_NOT_RUNNING", "没有运行中的占位")
+	}
+	*location = *lockedLocation
+
+	if "running" != location.Status {
+		return nil, errors.New(500, "LOCATION_NOT_RUNNING", "没有运行中的占位")
+	}
+	if level <= location.CurrentLevel {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
+	}
+
+	plan := &locationPlan{
+		userId:       location.UserId,
+		currentLevel: level,
+		row:          location.Row,
+		col:          location.Col,
+	}
+	currentValue, _ = locationLevelValue(location.CurrentLevel)
+	plan.currentValue, plan.currentMax = locationLevelValue(level)
+	if 0 >= plan.currentValue {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
+	}
+	plan.currentValue -= currentValue
+
+	plan.matrix = locationMatrixById(lc.matrices, location.MatrixId)
+	if level < plan.matrix.LevelMin || level > plan.matrix.LevelMax { // 跨档位升级
+		plan.matrix = locationMatrixByLevel(lc.matrices, level)
+		if nil == plan.matrix {
+			return nil, errors.New(500, "MATRIX_ERROR", "档位没有对应的矩阵")
+		}
+
+		// 档位只升不降，先锁原矩阵再锁目标矩阵，顺序一致
+		plan.row, plan.col, err = ruc.nextLocationPosition(ctx, plan.matrix)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	// 占位分红人
+	plan.rewardLocations, err = ruc.locationRepo.GetRewardLocationByRowOrCol(ctx, plan.matrix, plan.row, plan.col)
+
+	// 推荐人
+	if err = ruc.prepareRecommend(ctx, plan); nil != err {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// upgradeLocation 提升档位和最大分红额度并分红，事务中使用
+func (ruc *RecordUseCase) upgradeLocation(ctx context.Context, lc *locationConfig, location *Location, plan *locationPlan) error {
+	var (
+		amount int64
+		err    error
+	)
+
+	if location.MatrixId != plan.matrix.ID { // 跨档位，原矩阵的占位移出并马上紧缩，在目标矩阵占新位置，已分红额度带过去
+		err = ruc.locationRepo.MoveLocation(ctx, location.ID, time.Now().UTC().Add(8*time.Hour))
+		if nil != err {
+			return err
+		}
+		err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, location.ID, locationMatrixById(lc.matrices, location.MatrixId))
+		if nil != err {
+			return err
+		}
+
+		location, err = ruc.locationRepo.CreateLocation(ctx, &Location{
+			UserId:       location.UserId,
+			MatrixId:     plan.matrix.ID,
+			Status:       "running",
+			CurrentLevel: plan.currentLevel,
+			Current:      location.Current,
+			CurrentMax:   plan.currentMax,
+			Row:          plan.row,
+			Col:          plan.col,
+		})
+		if nil != err {
+			return err
+		}
+	} else {
+		err = ruc.locationRepo.UpgradeLocation(ctx, location.ID, plan.currentLevel, plan.currentMax)
+		if nil != err {
+			return err
+		}
+		location.CurrentLevel = plan.currentLevel
+		location.CurrentMax = plan.currentMax
+	}
+
+	amount, err = ruc.rewardLocation(ctx, lc, plan, location)
+	if nil != err {
+		return err
+	}
+	if 0 > amount { // 分红比例合计超过补的差价
+		return errors.New(500, "REWARD_RATE_ERROR", "分红比例配置错误")
+	}
+
+	return ruc.userBalanceRepo.SystemReward(ctx, amount, location.ID)
+}
+
+func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+
+	ctx = withDhbReward(ctx, ruc.configRepo) // 分红折dhb
+	lc := ruc.getLocationConfig(ctx)
+	lastEventId, _ := ruc.locationRepo.GetLocationEventLastId(ctx)
+
+	for _, v := range ethUserRecord {
+		var (
+			myLocations []*Location
+			plan        *locationPlan
+			err         error
+		)
+
+		ctx := NewLocationTriggerContext(ctx, "deposit", v.Hash) // 本次充值引起的占位变动
+
+		//if "DHB" == v.CoinType {
+		//	continue
+		//}
+
+		// 获取当前用户的占位信息，已经有运行中的跳过
+		myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, v.UserId)
+		if nil == myLocations { // 查询异常跳过本次循环
+			continue
+		}
+		if 0 < len(myLocations) { // 也代表复投
+			var tmpRunningLocation *Location
+			for _, vMyLocations := range myLocations {
+				if "running" == vMyLocations.Status {
+					tmpRunningLocation = vMyLocations
+					break
+				}
+			}
+
+			if nil != tmpRunningLocation { // 有运行中的，补差价的升级，其他直接跳过本次循环
+				ruc.upgradeByDeposit(ctx, lc, tmpRunningLocation, v)
+				continue
+			}
+		}
+
+		// 先紧缩一次位置
+		ruc.compactLocations(ctx, lc.matrices)
+
+		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			plan, err = ruc.prepareLocation(ctx, lc, v.UserId, locationLevelByAmount(v.Amount), 0 == len(myLocations))
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.createLocation(ctx, lc, plan)
+			if nil != err {
+				return err
+			}
+
+			var balanceRecordId int64
+			balanceRecordId, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, plan.currentValue) // 充值
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+				Hash:            v.Hash,
+				UserId:          v.UserId,
+				Status:          v.Status,
+				Type:            v.Type,
+				Amount:          v.Amount,
+				CoinType:        v.CoinType,
+				BalanceRecordId: balanceRecordId,
+			})
+			if nil != err {
+				return err
+			}
+
+			//dhbAmount, _ := strconv.ParseInt(ethUserRecord[k+1].Amount, 10, 64)
+			//dhbAmount /= 100000000                                                             // 转换为系统精度
+			//_, err = ruc.userBalanceRepo.DepositDhb(ctx, ethUserRecord[k+1].UserId, dhbAmount) // 充值
+			//if nil != err {
+			//	return err
+			//}
+			//
+			//_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+			//	Hash:     ethUserRecord[k+1].Hash,
+			//	UserId:   ethUserRecord[k+1].UserId,
+			//	Status:   ethUserRecord[k+1].Status,
+			//	Type:     ethUserRecord[k+1].Type,
+			//	Amount:   ethUserRecord[k+1].Amount,
+			//	CoinType: ethUserRecord[k+1].CoinType,
+			//})
+			//if nil != err {
+			//	return err
+			//}
+
+			return nil
+		}); nil != err {
+			ruc.recordFailedDeposit(ctx, v, err.Error()) // 档位没有开放的矩阵等，不能丢掉这笔充值
+			continue
+		}
+
+		// 调整位置紧缩
+		ruc.compactLocations(ctx, lc.matrices)
+	}
+
+	// 本轮出局的自动复投
+	ruc.AutoReinvest(ctx, lastEventId)
+
+	return true, nil
+}
+
+// EthUserRecordDhbHandle 链上充值dhb，只入余额不入单
+func (ruc *RecordUseCase) EthUserRecordDhbHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+	var err error
+	for _, v := range ethUserRecord {
+		if "DHB" != v.CoinType {
+			continue
+		}
+
+		chainAmount, ok := new(big.Int).SetString(v.Amount, 10)
+		if !ok {
+			continue
+		}
+		dhbAmount := new(big.Int).Div(chainAmount, big.NewInt(100000000)).Int64() // 转换为系统精度
+		if 0 >= dhbAmount {
+			continue
+		}
+
+		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			var balanceRecordId int64
+			balanceRecordId, err = ruc.userBalanceRepo.DepositDhb(ctx, v.UserId, dhbAmount) // 充值
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+				Hash:            v.Hash,
+				UserId:          v.UserId,
+				Status:          v.Status,
+				Type:            v.Type,
+				Amount:          v.Amount,
+				CoinType:        v.CoinType,
+				BalanceRecordId: balanceRecordId,
+			})
+			if nil != err {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			continue
+		}
+	}
+
+	return true, nil
+}
+
+// Reinvest 用余额复投，和链上充值入单的占位和分红一致
+func (ruc *RecordUseCase) Reinvest(ctx context.Context, req *v1.ReinvestRequest, user *User) (*v1.ReinvestReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
+	}
+
+	ctx = NewLocationTriggerContext(ctx, "reinvest", strconv.FormatInt(user.ID, 10)) // 本次复投引起的占位变动
+	ctx = withDhbReward(ctx, ruc.configRepo)                                         // 分红折dhb
+	if err := ruc.reinvestLocation(ctx, ruc.getLocationConfig(ctx), user.ID, req.SendBody.Level); nil != err {
+		return nil, err
+	}
+
+	return &v1.ReinvestReply{
+		Status: "ok",
+	}, nil
+}
+
+// upgradeByDeposit 链上补差价升级
+func (ruc *RecordUseCase) upgradeByDeposit(ctx context.Context, lc *locationConfig, location *Location, v *EthUserRecord) {
+	var (
+		level int64
+		plan  *locationPlan
+		err   error
+	)
+
+	level = locationUpgradeLevelByAmount(location.CurrentLevel, v.Amount)
+	if 0 >= level {
+		ruc.recordFailedDeposit(ctx, v, "upgrade amount not match")
+		return
+	}
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		plan, err = ruc.prepareUpgrade(ctx, lc, location, level)
+		if nil != err {
+			return err
+		}
+
+		err = ruc.upgradeLocation(ctx, lc, location, plan)
+		if nil != err {
+			return err
+		}
+
+		var balanceRecordId int64
+		balanceRecordId, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, plan.currentValue) // 充值
+		if nil != err {
+			return err
+		}
+
+		_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+			Hash:            v.Hash,
+			UserId:          v.UserId,
+			Status:          v.Status,
+			Type:            "upgrade",
+			Amount:          v.Amount,
+			CoinType:        v.CoinType,
+			BalanceRecordId: balanceRecordId,
+		})
+		if nil != err {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		ruc.recordFailedDeposit(ctx, v, err.Error())
+		return
+	}
+
+	// 调整位置紧缩
+	ruc.compactLocations(ctx, lc.matrices)
+}
+
+// recordFailedDeposit 充值没能入单或升级，记为失败不再重复处理，资金留给后台人工处理
+func (ruc *RecordUseCase) recordFailedDeposit(ctx context.Context, v *EthUserRecord, reason string) {
+	ruc.log.Errorf("deposit not handled: hash %s user %d amount %s coin %s reason %s", v.Hash, v.UserId, v.Amount, v.CoinType, reason)
+
+	_, err := ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+		Hash:     v.Hash,
+		UserId:   v.UserId,
+		Status:   "fail",
+		Type:     v.Type,
+		Amount:   v.Amount,
+		CoinType: v.CoinType,
+	})
+	if nil != err {
+		ruc.log.Errorf("deposit fail record: hash %s %v", v.Hash, err)
+	}
+}
+
+// UpgradeLocation 用余额补差价升级运行中的占位
+func (ruc *RecordUseCase) UpgradeLocation(ctx context.Context, req *v1.UpgradeLocationRequest, user *User) (*v1.UpgradeLocationReply, error) {
+	var (
+		location *Location
+		plan     *locationPlan
+		err      error
+	)
+
+	if nil == req.SendBody {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
+	}
+
+	ctx = NewLocationTriggerContext(ctx, "upgrade", strconv.FormatInt(user.ID, 10)) // 本次升级引起的占位变动
+	ctx = withDhbReward(ctx, ruc.configRepo)                                        // 分红折dhb
+	lc := ruc.getLocationConfig(ctx)
+	lastEventId, _ := ruc.locationRepo.GetLocationEventLastId(ctx)
+
+	location, err = ruc.locationRepo.GetMyLocationRunningLast(ctx, user.ID)
+	if nil == location {
+		return nil, errors.New(500, "LOCATION_NOT_RUNNING", "没有运行中的占位")
+	}
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		plan, err = ruc.prepareUpgrade(ctx, lc, location, req.SendBody.Level)
+		if nil != err {
+			return err
+		}
+
+		_, err = ruc.userBalanceRepo.DepositInternal(ctx, user.ID, plan.currentValue) // 余额扣款补差价
+		if nil != err {
+			return err
+		}
+
+		return ruc.upgradeLocation(ctx, lc, location, plan)
+	}); nil != err {
+		return nil, err
+	}
+
+	// 调整位置紧缩
+	ruc.compactLocations(ctx, lc.matrices)
+
+	// 本次出局的自动复投
+	ruc.AutoReinvest(ctx, lastEventId)
+
+	return &v1.UpgradeLocationReply{
+		Status: "ok",
+	}, nil
+}
+
+// ExitLocation 提前退出运行中的占位，按配置返还未回本部分并扣除违约金
+func (ruc *RecordUseCase) ExitLocation(ctx context.Context, req *v1.ExitLocationRequest, user *User) (*v1.ExitLocationReply, error) {
+	var (
+		location        *Location
+		matrix          *LocationMatrix
+		rewardLocations []*Location
+		principal       int64
+		refund          int64
+		penalty         int64
+		err             error
+	)
+
+	ctx = NewLocationTriggerContext(ctx, "exit", strconv.FormatInt(user.ID, 10)) // 本次退出引起的占位变动
+	ctx = withDhbReward(ctx, ruc.configRepo)                                     // 分红折dhb
+	lc := ruc.getLocationConfig(ctx)
+	if 0 >= lc.exitRefundRate {
+		return nil, errors.New(500, "EXIT_CLOSED", "未开放提前退出")
+	}
+
+	location, err = ruc.locationRepo.GetMyLocationRunningLast(ctx, user.ID)
+	if nil == location {
+		return nil, errors.New(500, "LOCATION_NOT_RUNNING", "没有运行中的占位")
+	}
+	matrix = locationMatrixById(lc.matrices, location.MatrixId)
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 和入单排队，锁住后重新读占位，按最新的额度算退款和违约金
+		err = ruc.locationRepo.LockLocationMatrix(ctx, location.MatrixId)
+		if nil != err {
+			return err
+		}
+		location, err = ruc.locationRepo.LockLocationById(ctx, location.ID)
+		if nil != err {
+			return err
+		}
+		if "running" != location.Status {
+			return errors.New(500, "LOCATION_NOT_RUNNING", "没有运行中的占位")
+		}
+
+		// 未回本部分
+		principal, _ = locationLevelValue(location.CurrentLevel)
+		refund = 0
+		if principal > location.Current {
+			refund = (principal - location.Current) / 100 * lc.exitRefundRate
+		}
+		penalty = refund / 100 * lc.exitPenaltyRate
+		refund -= penalty
+
+		rewardLocations = make([]*Location, 0)
+		if 0 < penalty && "location" == lc.exitPenaltyTo {
+			rewardLocations, err = ruc.locationRepo.GetRewardLocationByRowOrCol(ctx, matrix, location.Row, location.Col)
+			if nil != err {
+				return err
+			}
+		}
+
+		err = ruc.locationRepo.ExitLocation(ctx, location.ID, time.Now().UTC().Add(8*time.Hour))
+		if nil != err {
+			return err
+		}
+
+		if 0 < refund {
+			_, err = ruc.userBalanceRepo.ExitRefund(ctx, user.ID, refund, location.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		// 违约金平分给同行同列运行中的占位，分不完的归系统，占位按id顺序锁住后再判断是否运行中
+		systemAmount := penalty
+		sort.Slice(rewardLocations, func(i, j int) bool { return rewardLocations[i].ID < rewardLocations[j].ID })
+		tmpRewardLocations := make([]*Location, 0)
+		for _, vRewardLocations := range rewardLocations {
+			if location.ID == vRewardLocations.ID {
+				continue
+			}
+			var tmpLocation *Location
+			tmpLocation, err = ruc.locationRepo.LockLocationById(ctx, vRewardLocations.ID)
+			if nil != err {
+				return err
+			}
+			if "running" != tmpLocation.Status {
+				continue
+			}
+			tmpRewardLocations = append(tmpRewardLocations, tmpLocation)
+		}
+		if 0 < len(tmpRewardLocations) {
+			tmpAmount := penalty / int64(len(tmpRewardLocations))
+			for _, vRewardLocations := range tmpRewardLocations {
+				locationType := "col"
+				if location.Row == vRewardLocations.Row {
+					locationType = "row"
+				}
+
+				tmpCurrent := vRewardLocations.Current
+				vRewardLocations.Current += tmpAmount
+				if vRewardLocations.Current >= vRewardLocations.CurrentMax { // 占位分红人分满停止
+					vRewardLocations.StopDate = time.Now().UTC().Add(8 * time.Hour)
+					vRewardLocations.Status = "stop"
+				}
+				if 0 >= tmpAmount {
+					continue
+				}
+
+				err = ruc.locationRepo.UpdateLocation(ctx, vRewardLocations.ID, vRewardLocations.Status, tmpAmount, vRewardLocations.StopDate) // 分红占位数据修改
+				if nil != err {
+					return err
+				}
+
+				rewardAmount := tmpAmount
+				if vRewardLocations.CurrentMax-tmpCurrent < tmpAmount { // 大于最大可分红额度
+					rewardAmount = vRewardLocations.CurrentMax - tmpCurrent
+				}
+				_, err = ruc.userBalanceRepo.LocationReward(ctx, vRewardLocations.UserId, rewardAmount, location.ID, vRewardLocations.ID, locationType) // 分红信息修改
+				if nil != err {
+					return err
+				}
+				systemAmount -= rewardAmount
+			}
+		}
+
+		if 0 < systemAmount {
+			err = ruc.userBalanceRepo.SystemExitPenalty(ctx, systemAmount, location.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	// 调整位置紧缩
+	ruc.compactLocations(ctx, lc.matrices)
+
+	return &v1.ExitLocationReply{
+		Status:  "ok",
+		Refund:  fmt.Sprintf("%.2f", float64(refund)/float64(10000000000)),
+		Penalty: fmt.Sprintf("%.2f", float64(penalty)/float64(10000000000)),
+	}, nil
+}
+
+// statementCoinType 老的余额记录没有币种，都是usdt
+func statementCoinType(coinType string) string {
+	if "dhb" == strings.ToLower(coinType) {
+		return "dhb"
+	}
+
+	return "usdt"
+}
+
+// Statement 账单，按余额记录的时间顺序列出，充值带上交易hash，分红带上原因，提现带上状态，余额直接取记录里的balance
+func (ruc *RecordUseCase) Statement(ctx context.Context, req *v1.StatementRequest, user *User) (*v1.StatementReply, error) {
+	var (
+		start          time.Time
+		end            time.Time
+		records        []*UserBalanceRecord
+		ethUserRecords map[int64]*EthUserRecord
+		withdraws      map[int64]*Withdraw
+		rewards        map[int64]*Reward
+		recordIds      []int64
+		depositIds     []int64
+		withdrawIds    []int64
+		err            error
+	)
+
+	// 日期按天，包含结束当天，默认最近30天
+	if "" == req.End {
+		now := time.Now().UTC()
+		end = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	} else {
+		end, err = time.Parse("2006-01-02", req.End)
+		if nil != err {
+			return nil, errors.New(500, "STATEMENT_ERROR", "日期格式错误")
+		}
+	}
+	if "" == req.Start {
+		start = end.AddDate(0, 0, -29)
+	} else {
+		start, err = time.Parse("2006-01-02", req.Start)
+		if nil != err {
+			return nil, errors.New(500, "STATEMENT_ERROR", "日期格式错误")
+		}
+	}
+	if start.After(end) || start.AddDate(1, 0, 0).Before(end) {
+		return nil, errors.New(500, "STATEMENT_ERROR", "日期范围错误，最多一年")
+	}
+	endTime := end.AddDate(0, 0, 1)
+
+	records, err = ruc.userBalanceRepo.GetUserBalanceRecordsByTime(ctx, user.ID, start, endTime)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range records {
+		if "reward" == v.Type {
+			recordIds = append(recordIds, v.ID)
+		} else if "withdraw" == v.Type {
+			withdrawIds = append(withdrawIds, v.ID)
+		} else if "deposit" == v.Type && "internal" != v.Source {
+			depositIds = append(depositIds, v.ID)
+		}
+	}
+	rewards, err = ruc.userBalanceRepo.GetRewardsByBalanceRecordIds(ctx, recordIds...)
+	if nil != err {
+		return nil, err
+	}
+	withdraws, err = ruc.userBalanceRepo.GetWithdrawsByBalanceRecordIds(ctx, withdrawIds...)
+	if nil != err {
+		return nil, err
+	}
+	ethUserRecords, err = ruc.ethUserRecordRepo.GetEthUserRecordsByBalanceRecordIds(ctx, depositIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	// 期初余额
+	var openingUsdt, openingDhb int64
+	if lastRecord, _ := ruc.userBalanceRepo.GetUserBalanceRecordLast(ctx, user.ID, "usdt", start); nil != lastRecord {
+		openingUsdt = lastRecord.Balance
+	}
+	if lastRecord, _ := ruc.userBalanceRepo.GetUserBalanceRecordLast(ctx, user.ID, "dhb", start); nil != lastRecord {
+		openingDhb = lastRecord.Balance
+	}
+	closingUsdt, closingDhb := openingUsdt, openingDhb
+
+	res := &v1.StatementReply{
+		List:  make([]*v1.StatementReply_List, 0),
+		Start: start.Format("2006-01-02"),
+		End:   end.Format("2006-01-02"),
+	}
+	for _, v := range records {
+		coinType := statementCoinType(v.CoinType)
+		amount := v.Amount
+		item := &v1.StatementReply_List{
+			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Type:      v.Type,
+			Reason:    v.Source,
+			CoinType:  coinType,
+		}
+
+		if "reward" == v.Type {
+			if tmpReward, ok := rewards[v.ID]; ok {
+				item.Reason = tmpReward.Reason
+				item.Ref = strconv.FormatInt(tmpReward.TypeRecordId, 10)
+			}
+		} else if "withdraw" == v.Type {
+			amount = -v.Amount
+			if tmpWithdraw, ok := withdraws[v.ID]; ok { // 之前没存余额记录id的提现没有对应
+				item.Status = tmpWithdraw.Status
+				item.Ref = strconv.FormatInt(tmpWithdraw.ID, 10)
+			}
+		} else if "deposit" == v.Type && "internal" == v.Source {
+			amount = -v.Amount
+		} else if "deposit_reverse" == v.Type {
+			amount = -v.Amount
+		} else if "deposit" == v.Type { // 链上充值按交易记录里存的余额记录id对应
+			if tmpEthUserRecord, ok := ethUserRecords[v.ID]; ok {
+				item.Status = tmpEthUserRecord.Status
+				item.Ref = tmpEthUserRecord.Hash
+			}
+		}
+
+		item.Amount = fmt.Sprintf("%.2f", float64(amount)/float64(10000000000))
+		item.Balance = fmt.Sprintf("%.2f", float64(v.Balance)/float64(10000000000))
+		if "dhb" == coinType {
+			closingDhb = v.Balance
+		} else {
+			closingUsdt = v.Balance
+		}
+		res.List = append(res.List, item)
+	}
+
+	res.OpeningUsdt = fmt.Sprintf("%.2f", float64(openingUsdt)/float64(10000000000))
+	res.OpeningDhb = fmt.Sprintf("%.2f", float64(openingDhb)/float64(10000000000))
+	res.ClosingUsdt = fmt.Sprintf("%.2f", float64(closingUsdt)/float64(10000000000))
+	res.ClosingDhb = fmt.Sprintf("%.2f", float64(closingDhb)/float64(10000000000))
+
+	return res, nil
+}
+
+// AdminDepositReverse 充值冲正，扣回这笔入单产生的分红和给其他占位加的额度，余额不够扣成负数作为应收，占位停止后紧缩移出矩阵
+// 只处理新建占位的充值，补差价升级和已升级退出的占位分红混在一起分不开，不能冲正
+func (ruc *RecordUseCase) AdminDepositReverse(ctx context.Context, req *v1.AdminDepositReverseRequest, admin *User) (*v1.AdminDepositReverseReply, error) {
+	var (
+		ethUserRecords map[string]*EthUserRecord
+		events         []*LocationEvent
+		createEvent    *LocationEvent
+		location       *Location
+		rewards        []*Reward
+		lastRewards    []*Reward
+		reversal       *DepositReversal
+		rewardAmount   int64
+		err            error
+	)
+
+	if !isAdminUser(ctx, ruc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+	if nil == req.SendBody || "" == strings.TrimSpace(req.SendBody.Hash) {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "参数错误")
+	}
+	if "" == strings.TrimSpace(req.SendBody.Reason) {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "必须填写原因")
+	}
+	hash := strings.TrimSpace(req.SendBody.Hash)
+
+	ethUserRecords, err = ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, hash)
+	if nil != err {
+		return nil, err
+	}
+	ethUserRecord, ok := ethUserRecords[hash]
+	if !ok {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "充值记录不存在")
+	}
+	if "success" != ethUserRecord.Status {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "充值已冲正")
+	}
+	if "upgrade" == ethUserRecord.Type {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "补差价升级的充值不能冲正")
+	}
+
+	// 这笔充值引起的占位变动
+	events, err = ruc.locationRepo.GetLocationEventsByTrigger(ctx, "deposit", hash)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range events {
+		if "upgrade" == v.Type || "move" == v.Type { // 跨档位升级是移走旧占位再新建，不是新入单
+			return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "补差价升级的充值不能冲正")
+		}
+		if "create" == v.Type {
+			createEvent = v
+		}
+	}
+	if nil == createEvent {
+		return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "没有找到充值创建的占位")
+	}
+
+	// 这笔充值给其他占位加的分红额度，包括被分满出局的
+	rollbackCurrent := make(map[int64]int64, 0)
+	rollbackStop := make(map[int64]*LocationEvent, 0)
+	rollbackIds := make([]int64, 0)
+	rollbackUserIds := make(map[int64]int64, 0)
+	matrixIds := []int64{createEvent.MatrixId}
+	for _, v := range events {
+		if createEvent.LocationId == v.LocationId || ("credit" != v.Type && "stop" != v.Type) {
+			continue
+		}
+		if _, ok := rollbackCurrent[v.LocationId]; !ok {
+			rollbackIds = append(rollbackIds, v.LocationId)
+			rollbackUserIds[v.LocationId] = v.UserId
+			matrixIds = append(matrixIds, v.MatrixId)
+		}
+		rollbackCurrent[v.LocationId] += v.AfterCurrent - v.BeforeCurrent
+		if "running" == v.BeforeStatus && "stop" == v.AfterStatus {
+			rollbackStop[v.LocationId] = v
+		}
+	}
+	sort.Slice(rollbackIds, func(i, j int) bool { return rollbackIds[i] < rollbackIds[j] })
+	sort.Slice(matrixIds, func(i, j int) bool { return matrixIds[i] < matrixIds[j] })
+
+	location, err = ruc.locationRepo.GetLocationById(ctx, createEvent.LocationId)
+	if nil != err {
+		return nil, err
+	}
+	events, err = ruc.locationRepo.GetLocationEventsByLocationId(ctx, location.ID)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range events {
+		if "upgrade" == v.Type || "exit" == v.Type || "reverse" == v.Type {
+			return nil, errors.New(500, "DEPOSIT_REVERSE_ERROR", "占位已升级或退出，不能冲正")
+		}
+	}
+
+	// 入单产生的邻居，推荐人和系统分红，以及补给这一单的上一单超出部分
+	rewards, err = ruc.userBalanceRepo.GetRewardsByTypeRecordId(ctx, "location", location.ID)
+	if nil != err {
+		return nil, err
+	}
+	lastRewards, err = ruc.userBalanceRepo.GetRewardsByTypeRecordId(ctx, "last", location.ID)
+	if nil != err {
+		return nil, err
+	}
+	rewards = append(rewards, lastRewards...)
+	for _, v := range rewards {
+		rewardAmount += v.Amount
+	}
+
+	ctx = NewLocationTriggerContext(ctx, "deposit_reverse", hash) // 本次冲正引起的占位变动
+	lc := ruc.getLocationConfig(ctx)
+	amount := createEvent.AfterMax / 5 // 入单金额
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 涉及的矩阵按id顺序锁住，和入单升级的加锁顺序一致
+		var lastMatrixId int64
+		for _, matrixId := range matrixIds {
+			if lastMatrixId == matrixId {
+				continue
+			}
+			lastMatrixId = matrixId
+			err = ruc.locationRepo.LockLocationMatrix(ctx, matrixId)
+			if nil != err {
+				return err
+			}
+		}
+
+		err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, ethUserRecord.ID, "success", "reversed")
+		if nil != err {
+			return err
+		}
+
+		reversal, err = ruc.userBalanceRepo.CreateDepositReversal(ctx, &DepositReversal{
+			Hash:            hash,
+			EthUserRecordId: ethUserRecord.ID,
+			UserId:          location.UserId,
+			LocationId:      location.ID,
+			Amount:          amount,
+			RewardNum:       int64(len(rewards)),
+			RewardAmount:    rewardAmount,
+			Reason:          req.SendBody.Reason,
+			CreatedBy:       admin.ID,
+		})
+		if nil != err {
+			return err
+		}
+
+		_, err = ruc.userBalanceRepo.ReverseDeposit(ctx, location.UserId, amount, reversal.ID)
+		if nil != err {
+			return err
+		}
+
+		for _, v := range rewards {
+			_, err = ruc.userBalanceRepo.ReverseReward(ctx, v, reversal.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		err = ruc.locationRepo.ReverseLocation(ctx, location.ID, time.Now().UTC().Add(8*time.Hour))
+		if nil != err {
+			return err
+		}
+
+		// 调整位置紧缩
+		err = ruc.locationRepo.UpdateLocationRowAndCol(ctx, location.ID, locationMatrixById(lc.matrices, location.MatrixId))
+		if nil != err {
+			return err
+		}
+
+		for _, id := range rollbackIds {
+			var (
+				restore          bool
+				stopDate         time.Time
+				rollbackLocation *Location
+				runningLocation  *Location
+			)
+			if stopEvent, ok := rollbackStop[id]; ok { // 被这笔充值分满出局，没有再入单的恢复运行
+				runningLocation, _ = ruc.locationRepo.LockMyLocationRunningLast(ctx, rollbackUserIds[id])
+				restore = nil == runningLocation
+				stopDate = stopEvent.BeforeStopDate
+			}
+
+			rollbackLocation, err = ruc.locationRepo.RollbackLocation(ctx, id, rollbackCurrent[id], restore, stopDate)
+			if nil != err {
+				return err
+			}
+			if !restore || "stop" != rollbackLocation.Status || rollbackLocation.Current >= rollbackLocation.CurrentMax {
+				continue
+			}
+
+			// 已经紧缩移出矩阵，在原矩阵的最后重新占位
+			matrix := locationMatrixById(lc.matrices, rollbackLocation.MatrixId)
+			err = ruc.locationRepo.MoveLocation(ctx, id, time.Now().UTC().Add(8*time.Hour))
+			if nil != err {
+				return err
+			}
+			var row, col int64
+			row, col, err = ruc.nextLocationPosition(ctx, matrix)
+			if nil != err {
+				return err
+			}
+			_, err = ruc.locationRepo.CreateLocation(ctx, &Location{
+				UserId:       rollbackLocation.UserId,
+				MatrixId:     matrix.ID,
+				Status:       "running",
+				CurrentLevel: rollbackLocation.CurrentLevel,
+				Current:      rollbackLocation.Current,
+				CurrentMax:   rollbackLocation.CurrentMax,
+				Row:          row,
+				Col:          col,
+			})
+			if nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminDepositReverseReply{
+		Id:           reversal.ID,
+		LocationId:   location.ID,
+		Amount:       fmt.Sprintf("%.2f", float64(amount)/float64(10000000000)),
+		RewardNum:    reversal.RewardNum,
+		RewardAmount: fmt.Sprintf("%.2f", float64(rewardAmount)/float64(10000000000)),
+		Status:       "ok",
+	}, nil
+}
+
+// LocationEventLastId 处理开始前的流水位置，处理后据此找出本轮出局的占位
+func (ruc *RecordUseCase) LocationEventLastId(ctx context.Context) int64 {
+	lastEventId, _ := ruc.locationRepo.GetLocationEventLastId(ctx)
+	return lastEventId
+}
+
+// AutoReinvest 本轮出局的占位，用户开启了自动复投且余额足够的用余额入单
+func (ruc *RecordUseCase) AutoReinvest(ctx context.Context, lastEventId int64) {
+	var (
+		lc        *locationConfig
+		events    []*LocationEvent
+		userInfo  *UserInfo
+		reinvests map[int64]bool
+		err       error
+	)
+
+	ctx = withDhbReward(ctx, ruc.configRepo) // 分红折dhb
+
+	reinvests = make(map[int64]bool, 0)
+	for i := 0; i < 10; i++ { // 复投的分红可能再让别人出局，最多连续处理10次
+		events, err = ruc.locationRepo.GetLocationStopEventsAfterId(ctx, lastEventId)
+		if nil != err || 0 >= len(events) {
+			return
+		}
+		if nil == lc {
+			lc = ruc.getLocationConfig(ctx)
+		}
+
+		for _, vEvents := range events {
+			if vEvents.ID > lastEventId {
+				lastEventId = vEvents.ID
+			}
+			if _, ok := reinvests[vEvents.UserId]; ok { // 本轮已处理过
+				continue
+			}
+			reinvests[vEvents.UserId] = true
+
+			userInfo, err = ruc.userInfoRepo.GetUserInfoByUserId(ctx, vEvents.UserId)
+			if nil == userInfo || 0 >= userInfo.AutoReinvest {
+				continue
+			}
+
+			tmpCtx := NewLocationTriggerContext(ctx, "auto_reinvest", strconv.FormatInt(vEvents.LocationId, 10))
+			if err = ruc.reinvestLocation(tmpCtx, lc, vEvents.UserId, userInfo.AutoReinvest); nil != err {
+				ruc.log.Errorf("auto reinvest: user %d %v", vEvents.UserId, err)
+			}
+		}
+	}
+}
+
+// reinvestLocation 余额扣款入单，一个事务
+func (ruc *RecordUseCase) reinvestLocation(ctx context.Context, lc *locationConfig, userId int64, level int64) error {
+	var (
+		myLocations []*Location
+		plan        *locationPlan
+		err         error
+	)
+
+	myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, userId)
+	if nil == myLocations {
+		return err
+	}
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		plan, err = ruc.prepareLocation(ctx, lc, userId, level, 0 == len(myLocations))
+		if nil != err {
+			return err
+		}
+
+		_, err = ruc.userBalanceRepo.DepositInternal(ctx, userId, plan.currentValue) // 余额扣款入单
+		if nil != err {
+			return err
+		}
+
+		_, err = ruc.createLocation(ctx, lc, plan)
+		if nil != err {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		return err
+	}
+
+	// 调整位置紧缩
+	ruc.compactLocations(ctx, lc.matrices)
+
+	return nil
 }
 
 func (ruc *RecordUseCase) LockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
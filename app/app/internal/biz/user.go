@@ -2,11 +2,16 @@ package biz
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	v1 "dhb/app/app/api"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,11 +31,120 @@ type UserRecommendArea struct {
 	CreatedAt     time.Time
 }
 
+type BalanceAdjustment struct {
+	ID              int64
+	UserId          int64
+	Direction       string // credit 加 debit 减
+	CoinType        string
+	Amount          int64
+	Reason          string
+	Ticket          string
+	Status          string // pending 待审核 approved 已通过 rejected 已拒绝
+	CreatedBy       int64
+	ApprovedBy      int64
+	BalanceRecordId int64
+	CreatedAt       time.Time
+}
+
+type ReserveSnapshot struct {
+	ID              int64
+	Onchain         int64
+	UserBalance     int64
+	PendingWithdraw int64
+	Wallets         string // json，钱包地址对应链上余额
+	MerkleRoot      string
+	CreatedAt       time.Time
+}
+
+type ReserveLeaf struct {
+	SnapshotId int64
+	UserId     int64
+	Address    string
+	Balance    int64
+	Nonce      string // 每个叶子的随机盐，防止用兄弟节点穷举余额
+	Leaf       string
+}
+
+type ReconcileResult struct {
+	ID        int64
+	Day       string
+	Name      string // deposit 入单分红 balance 余额 withdraw 提现打款
+	Expected  int64
+	Actual    int64
+	Num       int64
+	Alert     int64
+	CreatedAt time.Time
+	Details   []*ReconcileDetail
+}
+
+type DailyMetrics struct {
+	ID        int64
+	Day       string
+	Name      string // new_user 新用户 deposit_档位 链上充值 deposit_internal 复投 withdraw_apply_币种 withdraw_paid_币种 reward_原因 system_revenue fee_pool location_running location_stop
+	Num       int64
+	Amount    int64
+	CreatedAt time.Time
+}
+
+type ReconcileDetail struct {
+	ID       int64
+	ResultId int64
+	UserId   int64
+	RecordId int64
+	Expected int64
+	Actual   int64
+	Reason   string
+}
+
+type LedgerBalance struct {
+	Account  string
+	UserId   int64
+	CoinType string
+	Balance  int64
+}
+
+type UserRecommendAreaDiff struct {
+	ID            int64
+	RecommendCode string
+	Num           int64
+	ExpectNum     int64
+	Type          string // missing 缺少 extra 多余 num 人数不对
+}
+
 type UserInfo struct {
 	ID               int64
 	UserId           int64
 	Vip              int64
 	HistoryRecommend int64
+	AutoReinvest     int64     // 自动复投档位，0不开启
+	VipExpire        time.Time // 会员等级到期时间，零值不过期
+}
+
+type VipLevel struct {
+	ID            int64
+	Level         int64
+	NeedRecommend int64 // 直推入单人数
+	NeedTeam      int64 // 团队业绩
+	NeedArea      int64 // 小区业绩
+	Rate          int64 // 推荐分红百分比
+	ExpireDays    int64 // 达标后有效天数，0不过期
+}
+
+type RecommendLevel struct {
+	ID                int64
+	Level             int64 // 推荐层级，1是直推人，沿用直推和会员等级分红，这里从2开始
+	Rate              int64 // 分红百分比
+	NeedRecommend     int64 // 直推入单人数门槛
+	NeedLocationLevel int64 // 运行中占位档位门槛
+}
+
+type UserVipLog struct {
+	ID        int64
+	UserId    int64
+	BeforeVip int64
+	AfterVip  int64
+	Reason    string // recommend 直推入单 recalc 重新计算 expire 到期降级 move 调整推荐人
+	CreatedAt time.Time
 }
 
 type UserRecommend struct {
@@ -40,6 +154,43 @@ type UserRecommend struct {
 	CreatedAt     time.Time
 }
 
+type UserRecommendTree struct {
+	ID           int64
+	AncestorId   int64
+	DescendantId int64
+	Depth        int64 // 1是直推
+	CreatedAt    time.Time
+}
+
+type UserRecommendDepth struct {
+	Depth     int64
+	Num       int64
+	ActiveNum int64 // 有运行中占位的人数
+}
+
+type UserTeamStats struct {
+	ID            int64
+	UserId        int64
+	TeamNum       int64 // 团队人数
+	ActiveNum     int64 // 团队有运行中占位的人数
+	OwnAmount     int64 // 个人业绩
+	TeamAmount    int64 // 团队业绩
+	MaxAreaAmount int64 // 最大一条线的业绩
+	AreaAmount    int64 // 小区业绩，团队业绩去掉最大一条线
+}
+
+type UserMoveLog struct {
+	ID                 int64
+	UserId             int64
+	OldRecommendUserId int64
+	NewRecommendUserId int64
+	OldRecommendCode   string
+	NewRecommendCode   string
+	Num                int64 // 一起移动的人数，含自己
+	Reason             string
+	CreatedAt          time.Time
+}
+
 type UserCurrentMonthRecommend struct {
 	ID              int64
 	UserId          int64
@@ -61,6 +212,40 @@ type UserBalance struct {
 	BalanceDhb  int64
 }
 
+type UserBalanceRecord struct {
+	ID        int64
+	UserId    int64
+	Balance   int64
+	Amount    int64
+	Type      string
+	CoinType  string
+	Source    string
+	CreatedAt time.Time
+}
+
+type Transfer struct {
+	ID           int64
+	FromUserId   int64
+	ToUserId     int64
+	Amount       int64
+	CoinType     string
+	FromRecordId int64
+	ToRecordId   int64
+	CreatedAt    time.Time
+}
+
+type DhbConvert struct {
+	ID         int64
+	UserId     int64
+	FromCoin   string
+	FromAmount int64
+	ToCoin     string
+	ToAmount   int64
+	Price      int64
+	Spread     int64
+	CreatedAt  time.Time
+}
+
 type Withdraw struct {
 	ID              int64
 	UserId          int64
@@ -69,6 +254,7 @@ type Withdraw struct {
 	BalanceRecordId int64
 	Status          string
 	Type            string
+	TxHash          string
 	CreatedAt       time.Time
 }
 
@@ -88,6 +274,7 @@ type Reward struct {
 	ID               int64
 	UserId           int64
 	Amount           int64
+	AmountDhb        int64 // 按比例折成dhb发的数量，Amount仍是分红的usdt总额
 	BalanceRecordId  int64
 	Type             string
 	TypeRecordId     int64
@@ -112,16 +299,21 @@ type UserBalanceRepo interface {
 	CreateUserBalance(ctx context.Context, u *User) (*UserBalance, error)
 	LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error)
 	WithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error)
-	RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
+	RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error)
 	SystemWithdrawReward(ctx context.Context, amount int64, locationId int64) error
 	SystemReward(ctx context.Context, amount int64, locationId int64) error
+	SystemExitPenalty(ctx context.Context, amount int64, locationId int64) error
+	ExitRefund(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	SystemFee(ctx context.Context, amount int64, locationId int64) error
-	UserFee(ctx context.Context, userId int64, amount int64) (int64, error)
-	RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
-	NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
-	NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
+	UserFee(ctx context.Context, userId int64, amount int64, myLocationId int64) (int64, error)
+	RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error)
+	NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error)
+	NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error)
+	LevelRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, rewardType string, level int64) (int64, error)
 	Deposit(ctx context.Context, userId int64, amount int64) (int64, error)
-	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
+	DepositInternal(ctx context.Context, userId int64, amount int64) (int64, error)
+	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64, newLocationId int64) (int64, error)
+	LockUserBalance(ctx context.Context, userId int64) error
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
@@ -129,9 +321,9 @@ type UserBalanceRepo interface {
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalance, error)
 	GetUserBalanceUsdtTotal(ctx context.Context) (int64, error)
-	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*Withdraw, error)
-	WithdrawUsdt(ctx context.Context, userId int64, amount int64) error
-	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
+	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, balanceRecordId int64) (*Withdraw, error)
+	WithdrawUsdt(ctx context.Context, userId int64, amount int64) (int64, error)
+	WithdrawDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetWithdrawByUserId(ctx context.Context, userId int64) ([]*Withdraw, error)
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64) ([]*Withdraw, error, int64)
 	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
@@ -145,15 +337,65 @@ type UserBalanceRepo interface {
 	GetUserRewardUsdtTotal(ctx context.Context) (int64, error)
 	GetSystemRewardUsdtTotal(ctx context.Context) (int64, error)
 	UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*Withdraw, error)
+	GetLedgerBalances(ctx context.Context) ([]*LedgerBalance, error)
+	GetUserBalances(ctx context.Context) ([]*UserBalance, error)
+	LedgerOpening(ctx context.Context, userId int64, coinType string, amount int64) error
+	ResetUserBalance(ctx context.Context, userId int64) error
+	WithdrawPayout(ctx context.Context, id int64, txHash string) error
+	GetReconcileDeposit(ctx context.Context, start time.Time, end time.Time) ([]*ReconcileDetail, error)
+	GetReconcileBalance(ctx context.Context) ([]*ReconcileDetail, error)
+	GetReconcileWithdrawSuccess(ctx context.Context, start time.Time, end time.Time) ([]*Withdraw, error)
+	GetReconcileWithdraw(ctx context.Context, start time.Time, end time.Time, onchain map[int64]int64) ([]*ReconcileDetail, error)
+	SaveReconcileResults(ctx context.Context, day string, results []*ReconcileResult) error
+	GetReconcileResults(ctx context.Context, b *Pagination, day string) ([]*ReconcileResult, error, int64)
+	GetReconcileDetails(ctx context.Context, b *Pagination, resultId int64) ([]*ReconcileDetail, error, int64)
+	GetDailyMetricsStat(ctx context.Context, start time.Time, end time.Time) ([]*DailyMetrics, error)
+	SaveDailyMetrics(ctx context.Context, day string, metrics []*DailyMetrics) error
+	GetDailyMetrics(ctx context.Context, startDay string, endDay string, name string) ([]*DailyMetrics, error)
+	GetWithdrawUsdtNotPaidTotal(ctx context.Context) (int64, error)
+	CreateReserveSnapshot(ctx context.Context, s *ReserveSnapshot, leaves []*ReserveLeaf) (*ReserveSnapshot, error)
+	GetReserveSnapshots(ctx context.Context, b *Pagination) ([]*ReserveSnapshot, error, int64)
+	GetReserveSnapshot(ctx context.Context, id int64) (*ReserveSnapshot, error)
+	GetReserveLeaves(ctx context.Context, snapshotId int64) ([]*ReserveLeaf, error)
+	CreateBalanceAdjustment(ctx context.Context, a *BalanceAdjustment) (*BalanceAdjustment, error)
+	GetBalanceAdjustmentById(ctx context.Context, id int64) (*BalanceAdjustment, error)
+	GetBalanceAdjustments(ctx context.Context, b *Pagination, status string) ([]*BalanceAdjustment, error, int64)
+	UpdateBalanceAdjustmentStatus(ctx context.Context, id int64, status string, approvedBy int64, balanceRecordId int64) error
+	AdjustBalance(ctx context.Context, userId int64, coinType string, amount int64, adjustmentId int64) (int64, error)
+	GetRewardsByTypeRecordId(ctx context.Context, rewardType string, typeRecordId int64) ([]*Reward, error)
+	CreateDepositReversal(ctx context.Context, r *DepositReversal) (*DepositReversal, error)
+	ReverseReward(ctx context.Context, reward *Reward, reversalId int64) (int64, error)
+	ReverseDeposit(ctx context.Context, userId int64, amount int64, reversalId int64) (int64, error)
+	GetUserBalanceRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*UserBalanceRecord, error)
+	GetUserBalanceRecordLast(ctx context.Context, userId int64, coinType string, before time.Time) (*UserBalanceRecord, error)
+	GetRewardsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*Reward, error)
+	GetWithdrawsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*Withdraw, error)
+	Transfer(ctx context.Context, fromUserId int64, toUserId int64, amount int64) (*Transfer, error)
+	GetTransferTotalToday(ctx context.Context, userId int64) (int64, int64, error)
+	Convert(ctx context.Context, c *DhbConvert) (*DhbConvert, error)
 }
 
 type UserRecommendRepo interface {
 	GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error)
+	GetUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserRecommend, error)
 	CreateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (*UserRecommend, error)
-	GetUserRecommendByCode(ctx context.Context, code string) ([]*UserRecommend, error)
-	GetUserRecommendLikeCode(ctx context.Context, code string) ([]*UserRecommend, error)
+	GetUserRecommends(ctx context.Context) ([]*UserRecommend, error)
 	CreateUserRecommendArea(ctx context.Context, u *User, recommendUser *UserRecommend) (bool, error)
-	GetUserRecommendLowArea(ctx context.Context, code string) ([]*UserRecommendArea, error)
+	CreateUserRecommendTree(ctx context.Context, u *User, recommendUser *UserRecommend) error
+	ResetUserRecommendTree(ctx context.Context, userId int64, upUserIds []int64) error
+	GetUserRecommendDescendants(ctx context.Context, userId int64, depth int64) ([]*UserRecommendTree, error)
+	GetUserRecommendDepthStats(ctx context.Context, userId int64) ([]*UserRecommendDepth, error)
+	GetUserRecommendChildren(ctx context.Context, b *Pagination, userId int64, sort string) ([]*UserRecommendTree, error, int64)
+	GetUserRecommendAncestors(ctx context.Context, userId int64) ([]*UserRecommendTree, error)
+	GetUserTeamStatsByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserTeamStats, error)
+	SaveUserTeamStats(ctx context.Context, stats []*UserTeamStats) error
+	LockUserTeamStats(ctx context.Context) error
+	UpdateUserRecommendCode(ctx context.Context, userId int64, code string) error
+	MoveUserRecommendArea(ctx context.Context, oldPrefix string, newPrefix string, oldRecommendCode string) error
+	CreateUserMoveLog(ctx context.Context, l *UserMoveLog) error
+	GetUserRecommendAreas(ctx context.Context) ([]*UserRecommendArea, error)
+	FixUserRecommendArea(ctx context.Context, deleteIds []int64, areas []*UserRecommendArea) error
+	GetRecommendLevels(ctx context.Context) ([]*RecommendLevel, error)
 }
 
 type UserCurrentMonthRecommendRepo interface {
@@ -169,6 +411,13 @@ type UserInfoRepo interface {
 	GetUserInfoByUserId(ctx context.Context, userId int64) (*UserInfo, error)
 	UpdateUserInfo(ctx context.Context, u *UserInfo) (*UserInfo, error)
 	GetUserInfoByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserInfo, error)
+	UpdateUserInfoAutoReinvest(ctx context.Context, userId int64, level int64) error
+	UpdateUserInfoVip(ctx context.Context, userId int64, vip int64, vipExpire time.Time) error
+	UpdateUserInfoHistoryRecommend(ctx context.Context, userId int64, historyRecommend int64) error
+	GetVipLevels(ctx context.Context) ([]*VipLevel, error)
+	SaveVipLevel(ctx context.Context, vl *VipLevel) error
+	CreateUserVipLog(ctx context.Context, l *UserVipLog) error
+	GetUserVipLogs(ctx context.Context, b *Pagination, userId int64) ([]*UserVipLog, error, int64)
 }
 
 type UserRepo interface {
@@ -257,6 +506,11 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 				return err
 			}
 
+			err = uuc.urRepo.CreateUserRecommendTree(ctx, user, recommendUser) // 创建用户推荐上下级关系
+			if err != nil {
+				return err
+			}
+
 			userBalance, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
 			if err != nil {
 				return err
@@ -278,9 +532,8 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		locations                  []*Location
 		userBalance                *UserBalance
 		userRecommend              *UserRecommend
-		userRecommends             []*UserRecommend
+		userTeamStats              map[int64]*UserTeamStats
 		userRewards                []*Reward
-		rewardLocations            []*Location
 		userCurrentMonthRecommends []*UserCurrentMonthRecommend
 		userRewardTotal            int64
 		encodeString               string
@@ -307,9 +560,11 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		level1Dhb                  string
 		level2Dhb                  string
 		level3Dhb                  string
-		userRecommendArea          []*UserRecommendArea
-		userAreas                  map[int64]int64
 		areaAmount                 int64
+		matrices                   []*LocationMatrix
+		myMatrix                   *LocationMatrix
+		matrixLocations            map[int64]*Location
+		matrixList                 []*v1.UserInfoReply_Matrix
 		err                        error
 	)
 
@@ -323,6 +578,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		return nil, err
 	}
 
+	matrices = getLocationMatrices(ctx, uuc.locationRepo)
 	locations, err = uuc.locationRepo.GetLocationsByUserId(ctx, myUser.ID)
 	if nil != locations && 0 < len(locations) {
 		status = "stop"
@@ -336,6 +592,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				amount = fmt.Sprintf("%.2f", float64(v.CurrentMax-v.Current)/float64(10000000000))
 				myCol = v.Col
 				myRow = v.Row
+				myMatrix = locationMatrixById(matrices, v.MatrixId)
 				break
 			}
 		}
@@ -373,13 +630,13 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 			return nil, err
 		}
 		inviteUserAddress = myRecommendUser.Address
-		myCode = userRecommend.RecommendCode + myCode
 	}
 
 	// 团队
-	userRecommends, err = uuc.urRepo.GetUserRecommendLikeCode(ctx, myCode)
-	if nil != userRecommends {
-		recommendTeamNum = int64(len(userRecommends))
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, myUser.ID)
+	if _, ok := userTeamStats[myUser.ID]; ok {
+		recommendTeamNum = userTeamStats[myUser.ID].TeamNum
+		areaAmount = userTeamStats[myUser.ID].AreaAmount
 	}
 
 	// 累计奖励
@@ -399,20 +656,37 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 
 	// 位置
 	if 0 < myRow && 0 < myCol {
-		rewardLocations, err = uuc.locationRepo.GetRewardLocationByRowOrCol(ctx, myRow, myCol)
-		if nil != rewardLocations {
-			for _, vRewardLocation := range rewardLocations {
-				if myRow == vRewardLocation.Row && myCol == vRewardLocation.Col { // 跳过自己
-					continue
-				}
-				if myRow == vRewardLocation.Row {
-					colNum++
-				}
-				if myCol == vRewardLocation.Col {
-					rowNum++
-				}
-			}
+		rowNum, colNum = uuc.locationNeighbourNum(ctx, myMatrix, myRow, myCol)
+	}
+
+	// 各矩阵中的位置，取每个矩阵最新的一单
+	matrixLocations = make(map[int64]*Location, 0)
+	for _, v := range locations {
+		if _, ok := matrixLocations[v.MatrixId]; !ok {
+			matrixLocations[v.MatrixId] = v
+		}
+	}
+	matrixList = make([]*v1.UserInfoReply_Matrix, 0)
+	for _, vMatrix := range matrices {
+		tmpLocation, ok := matrixLocations[vMatrix.ID]
+		if !ok {
+			continue
 		}
+
+		var tmpRowNum, tmpColNum int64
+		if "running" == tmpLocation.Status {
+			tmpRowNum, tmpColNum = uuc.locationNeighbourNum(ctx, vMatrix, tmpLocation.Row, tmpLocation.Col)
+		}
+		matrixList = append(matrixList, &v1.UserInfoReply_Matrix{
+			MatrixId: vMatrix.ID,
+			Name:     vMatrix.Name,
+			Level:    tmpLocation.CurrentLevel,
+			Status:   tmpLocation.Status,
+			Row:      tmpLocation.Row,
+			Col:      tmpLocation.Col,
+			RowNum:   tmpRowNum,
+			ColNum:   tmpColNum,
+		})
 	}
 
 	// 当月推荐人数
@@ -439,33 +713,6 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		}
 	}
 
-	// 区信息
-	userAreas = make(map[int64]int64, 0)
-	userRecommendArea, err = uuc.urRepo.GetUserRecommendLowArea(ctx, myCode)
-	if nil != userRecommendArea {
-		for _, vUserRecommendArea := range userRecommendArea {
-			tmpCodes := strings.Split(vUserRecommendArea.RecommendCode, "D")
-			for _, vTmpCode := range tmpCodes {
-				tmpUserId, _ := strconv.ParseInt(vTmpCode, 10, 64)
-				if tmpUserId > 0 {
-					userAreas[tmpUserId] = tmpUserId
-				}
-			}
-		}
-	}
-	// 获取小区内入单信息
-	if 0 < len(userAreas) {
-		tmpUserAreasSlice := make([]int64, 0)
-		for _, vUserAreas := range userAreas {
-			tmpUserAreasSlice = append(tmpUserAreasSlice, vUserAreas)
-		}
-		var tmpAreaLocations []*Location
-		tmpAreaLocations, err = uuc.locationRepo.GetLocationByIds(ctx, tmpUserAreasSlice...)
-		for _, vTmpAreaLocations := range tmpAreaLocations {
-			areaAmount += vTmpAreaLocations.CurrentMax / 5
-		}
-	}
-
 	return &v1.UserInfoReply{
 		Address:                  myUser.Address,
 		Level:                    userInfo.Vip,
@@ -489,18 +736,194 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		Level3Dhb:                level3Dhb,
 		LocationCount:            locationCount,
 		Usdt:                     "0x55d398326f99059fF775485246999027B3197955",
-		Dhb:                      "0xb7864be857e00796e6f79e057b3ef1032cbe4a06",
+		Dhb:                      getDhbTokenAddress(ctx, uuc.configRepo),
 		Account:                  "0x636F2deAAb4C9A8F3c808D23F16f456009C4e9Fd",
 		Contract:                 "0xA497605d07da3B94fFAA6667aF702adacd02B843",
 		//Usdt:                     "0x337610d27c682E347C9cD60BD4b3b107C9d34dDd",
 		//Dhb:                      "0x96BD81715c69eE013405B4005Ba97eA1f420fd87",
 		//Account:                  "0xe865f2e5ff04b8b7952d1c0d9163a91f313b158f",
-		AmountB:    fmt.Sprintf("%.2f", float64(myLastLocationCurrent)/float64(10000000000)),
-		Undo:       myUser.Undo,
-		AreaAmount: fmt.Sprintf("%.2f", float64(areaAmount)/float64(10000000000)),
+		AmountB:      fmt.Sprintf("%.2f", float64(myLastLocationCurrent)/float64(10000000000)),
+		Undo:         myUser.Undo,
+		AreaAmount:   fmt.Sprintf("%.2f", float64(areaAmount)/float64(10000000000)),
+		Matrix:       matrixList,
+		AutoReinvest: userInfo.AutoReinvest,
 	}, nil
 }
 
+// locationNeighbourNum 矩阵内同列和同行的分红人数，不含自己
+func (uuc *UserUseCase) locationNeighbourNum(ctx context.Context, matrix *LocationMatrix, row int64, col int64) (int64, int64) {
+	var (
+		rowNum          int64
+		colNum          int64
+		rewardLocations []*Location
+	)
+
+	rewardLocations, _ = uuc.locationRepo.GetRewardLocationByRowOrCol(ctx, matrix, row, col)
+	for _, vRewardLocation := range rewardLocations {
+		if row == vRewardLocation.Row && col == vRewardLocation.Col { // 跳过自己
+			continue
+		}
+		if row == vRewardLocation.Row {
+			colNum++
+		}
+		if col == vRewardLocation.Col {
+			rowNum++
+		}
+	}
+
+	return rowNum, colNum
+}
+
+// RecommendTree 团队树，分页查看某个节点的直推和每条线的业绩，节点只能是自己或自己的下级
+func (uuc *UserUseCase) RecommendTree(ctx context.Context, req *v1.RecommendTreeRequest, user *User) (*v1.RecommendTreeReply, error) {
+	var (
+		nodeUserId    = user.ID
+		nodeUser      *User
+		ancestors     []*UserRecommendTree
+		depths        []*UserRecommendDepth
+		children      []*UserRecommendTree
+		locations     []*Location
+		users         map[int64]*User
+		userTeamStats map[int64]*UserTeamStats
+		childIds      []int64
+		userRunning   map[int64]bool
+		count         int64
+		err           error
+	)
+
+	if 0 < req.UserId && user.ID != req.UserId {
+		ancestors, err = uuc.urRepo.GetUserRecommendAncestors(ctx, req.UserId)
+		if nil != err {
+			return nil, err
+		}
+
+		var isMyTeam bool
+		for _, v := range ancestors {
+			if user.ID == v.AncestorId {
+				isMyTeam = true
+				break
+			}
+		}
+		if !isMyTeam {
+			return nil, errors.New(500, "NOT_MY_TEAM", "不是我的团队成员")
+		}
+		nodeUserId = req.UserId
+	}
+
+	nodeUser, err = uuc.repo.GetUserById(ctx, nodeUserId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RecommendTreeReply{
+		UserId:     nodeUser.ID,
+		Address:    nodeUser.Address,
+		TeamAmount: "0.00",
+		Depths:     make([]*v1.RecommendTreeReply_Depth, 0),
+		Children:   make([]*v1.RecommendTreeReply_List, 0),
+	}
+
+	// 团队人数和业绩取团队统计，每层人数按层汇总
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, nodeUserId)
+	if nil != err {
+		return nil, err
+	}
+	if _, ok := userTeamStats[nodeUserId]; ok {
+		res.TeamNum = userTeamStats[nodeUserId].TeamNum
+		res.ActiveNum = userTeamStats[nodeUserId].ActiveNum
+		res.TeamAmount = fmt.Sprintf("%.2f", float64(userTeamStats[nodeUserId].TeamAmount)/float64(10000000000))
+	}
+
+	depths, err = uuc.urRepo.GetUserRecommendDepthStats(ctx, nodeUserId)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range depths {
+		res.Depths = append(res.Depths, &v1.RecommendTreeReply_Depth{
+			Depth:     v.Depth,
+			Num:       v.Num,
+			ActiveNum: v.ActiveNum,
+		})
+	}
+
+	// 直推分页
+	pageNum := int(req.Page)
+	if 0 >= pageNum {
+		pageNum = 1
+	}
+	children, err, count = uuc.urRepo.GetUserRecommendChildren(ctx, &Pagination{
+		PageNum:  pageNum,
+		PageSize: 10,
+	}, nodeUserId, req.Sort)
+	if nil != err {
+		return nil, err
+	}
+	res.Count = count
+	if 0 == len(children) {
+		return res, nil
+	}
+
+	for _, v := range children {
+		childIds = append(childIds, v.DescendantId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, childIds...)
+	if nil != err {
+		return nil, err
+	}
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, childIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	userRunning = make(map[int64]bool, 0)
+	locations, err = uuc.locationRepo.GetLocationByIds(ctx, childIds...)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range locations {
+		if "running" == v.Status {
+			userRunning[v.UserId] = true
+		}
+	}
+
+	for _, v := range children {
+		var (
+			tmpAddress    string
+			tmpOwnAmount  int64
+			tmpTeamNum    int64
+			tmpActiveNum  int64
+			tmpTeamAmount int64
+		)
+		if _, ok := users[v.DescendantId]; ok {
+			tmpAddress = users[v.DescendantId].Address
+		}
+		if _, ok := userTeamStats[v.DescendantId]; ok {
+			tmpOwnAmount = userTeamStats[v.DescendantId].OwnAmount
+			tmpTeamNum = userTeamStats[v.DescendantId].TeamNum
+			tmpActiveNum = userTeamStats[v.DescendantId].ActiveNum
+			tmpTeamAmount = userTeamStats[v.DescendantId].TeamAmount
+		}
+		tmpStatus := "stop"
+		if userRunning[v.DescendantId] {
+			tmpStatus = "running"
+			tmpActiveNum++ // 一条线的运行人数含直推自己
+		}
+
+		res.Children = append(res.Children, &v1.RecommendTreeReply_List{
+			UserId:     v.DescendantId,
+			Address:    tmpAddress,
+			Status:     tmpStatus,
+			Amount:     fmt.Sprintf("%.2f", float64(tmpOwnAmount)/float64(10000000000)),
+			TeamNum:    tmpTeamNum,
+			ActiveNum:  tmpActiveNum,
+			TeamAmount: fmt.Sprintf("%.2f", float64(tmpOwnAmount+tmpTeamAmount)/float64(10000000000)),
+			CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
 func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
 	var (
 		userRewards    []*Reward
@@ -554,180 +977,637 @@ func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListReques
 	return res, nil
 }
 
-func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
+// MyLocationList 我的全部占位及每一单的收益明细
+func (uuc *UserUseCase) MyLocationList(ctx context.Context, user *User) (*v1.MyLocationListReply, error) {
 	var (
+		locations   []*Location
 		userRewards []*Reward
+		myLocations map[int64]*Location
+		rowReward   map[int64]int64
+		colReward   map[int64]int64
+		recommend   map[int64]int64
+		recommendV  map[int64]int64
+		feeReward   map[int64]int64
 		err         error
 	)
-	res := &v1.RecommendRewardListReply{
-		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
+	res := &v1.MyLocationListReply{
+		Locations: make([]*v1.MyLocationListReply_List, 0),
+	}
+
+	locations, err = uuc.locationRepo.GetLocationsByUserId(ctx, user.ID)
+	if nil == locations || 0 >= len(locations) {
+		return res, nil
+	}
+
+	myLocations = make(map[int64]*Location, 0)
+	for _, v := range locations {
+		myLocations[v.ID] = v
 	}
 
+	rowReward = make(map[int64]int64, 0)
+	colReward = make(map[int64]int64, 0)
+	recommend = make(map[int64]int64, 0)
+	recommendV = make(map[int64]int64, 0)
+	feeReward = make(map[int64]int64, 0)
 	userRewards, err = uuc.ubRepo.GetUserRewardByUserId(ctx, user.ID)
 	if nil != err {
 		return res, nil
 	}
-
 	for _, vUserReward := range userRewards {
-		if "recommend" == vUserReward.Reason || "recommend_vip" == vUserReward.Reason {
-			res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
-				CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
-				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
-				Type:      vUserReward.Type,
-				Reason:    vUserReward.Reason,
-			})
+		// 收益归属的占位，发放时记在reason_location_id，没有记录的不归到任何占位
+		var tmpLocationId int64
+		if _, ok := myLocations[vUserReward.ReasonLocationId]; ok {
+			tmpLocationId = vUserReward.ReasonLocationId
+		} else if _, ok = myLocations[vUserReward.TypeRecordId]; ok && "location" != vUserReward.Reason {
+			tmpLocationId = vUserReward.TypeRecordId
+		} else {
+			continue
+		}
+
+		if "location" == vUserReward.Reason && "row" == vUserReward.LocationType {
+			rowReward[tmpLocationId] += vUserReward.Amount
+		} else if "location" == vUserReward.Reason && "col" == vUserReward.LocationType {
+			colReward[tmpLocationId] += vUserReward.Amount
+		} else if "recommend" == vUserReward.Reason {
+			recommend[tmpLocationId] += vUserReward.Amount
+		} else if "recommend_vip" == vUserReward.Reason {
+			recommendV[tmpLocationId] += vUserReward.Amount
+		} else if "fee" == vUserReward.Reason {
+			feeReward[tmpLocationId] += vUserReward.Amount
+		}
+	}
+
+	for _, v := range locations {
+		var (
+			stopDate string
+			progress string
+		)
+		if "stop" == v.Status {
+			stopDate = v.StopDate.Format("2006-01-02 15:04:05")
+		}
+		if 0 < v.CurrentMax {
+			progress = fmt.Sprintf("%.2f", float64(v.Current)*100/float64(v.CurrentMax))
 		}
+
+		res.Locations = append(res.Locations, &v1.MyLocationListReply_List{
+			Id:                 v.ID,
+			MatrixId:           v.MatrixId,
+			Level:              v.CurrentLevel,
+			Status:             v.Status,
+			CreatedAt:          v.CreatedAt.Format("2006-01-02 15:04:05"),
+			StopDate:           stopDate,
+			Current:            fmt.Sprintf("%.2f", float64(v.Current)/float64(10000000000)),
+			CurrentMax:         fmt.Sprintf("%.2f", float64(v.CurrentMax)/float64(10000000000)),
+			Progress:           progress,
+			Row:                v.Row,
+			Col:                v.Col,
+			RowReward:          fmt.Sprintf("%.2f", float64(rowReward[v.ID])/float64(10000000000)),
+			ColReward:          fmt.Sprintf("%.2f", float64(colReward[v.ID])/float64(10000000000)),
+			RecommendReward:    fmt.Sprintf("%.2f", float64(recommend[v.ID])/float64(10000000000)),
+			RecommendVipReward: fmt.Sprintf("%.2f", float64(recommendV[v.ID])/float64(10000000000)),
+			FeeReward:          fmt.Sprintf("%.2f", float64(feeReward[v.ID])/float64(10000000000)),
+			Total:              fmt.Sprintf("%.2f", float64(rowReward[v.ID]+colReward[v.ID]+recommend[v.ID]+recommendV[v.ID]+feeReward[v.ID])/float64(10000000000)),
+			Source:             v.Source,
+		})
 	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
+// LocationNeighbour 我所在行的人和同列上下范围内的人，短时缓存供轮询
+func (uuc *UserUseCase) LocationNeighbour(ctx context.Context, user *User) (*v1.LocationNeighbourReply, error) {
 	var (
-		userRewards []*Reward
-		err         error
+		myLocation      *Location
+		matrix          *LocationMatrix
+		rewardLocations []*Location
+		userIds         []int64
+		users           map[int64]*User
+		aheadCount      int64
+		cache           string
+		err             error
 	)
-	res := &v1.FeeRewardListReply{
-		Rewards: make([]*v1.FeeRewardListReply_List, 0),
+	res := &v1.LocationNeighbourReply{
+		RowPeers: make([]*v1.LocationNeighbourReply_List, 0),
+		ColPeers: make([]*v1.LocationNeighbourReply_List, 0),
 	}
 
-	userRewards, err = uuc.ubRepo.GetUserRewardByUserId(ctx, user.ID)
-	if nil != err {
+	cache, _ = uuc.locationRepo.GetLocationNeighbourCache(ctx, user.ID)
+	if "" != cache {
+		if err = json.Unmarshal([]byte(cache), res); nil == err {
+			return res, nil
+		}
+	}
+
+	myLocation, err = uuc.locationRepo.GetMyLocationRunningLast(ctx, user.ID)
+	if nil == myLocation { // 没有运行中的占位
 		return res, nil
 	}
 
-	for _, vUserReward := range userRewards {
-		if "fee" == vUserReward.Reason {
-			res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
-				CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
-				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
-			})
+	matrix = locationMatrixById(getLocationMatrices(ctx, uuc.locationRepo), myLocation.MatrixId)
+	res.MatrixId = matrix.ID
+	res.Row = myLocation.Row
+	res.Col = myLocation.Col
+
+	aheadCount, err = uuc.locationRepo.GetLocationRunningAheadCount(ctx, matrix.ID, myLocation.Row, myLocation.Col)
+	if nil != err {
+		return nil, err
+	}
+	res.Position = aheadCount + 1
+
+	rewardLocations, err = uuc.locationRepo.GetRewardLocationByRowOrCol(ctx, matrix, myLocation.Row, myLocation.Col)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range rewardLocations {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	sort.Slice(rewardLocations, func(i, j int) bool {
+		if rewardLocations[i].Row == rewardLocations[j].Row {
+			return rewardLocations[i].Col < rewardLocations[j].Col
+		}
+		return rewardLocations[i].Row < rewardLocations[j].Row
+	})
+	for _, v := range rewardLocations {
+		if myLocation.ID == v.ID { // 跳过自己
+			continue
+		}
+
+		var (
+			address  string
+			progress string
+		)
+		if _, ok := users[v.UserId]; ok {
+			address = maskAddress(users[v.UserId].Address)
+		}
+		if 0 < v.CurrentMax {
+			progress = fmt.Sprintf("%.2f", float64(v.Current)*100/float64(v.CurrentMax))
+		}
+		tmpPeer := &v1.LocationNeighbourReply_List{
+			Address:  address,
+			Level:    v.CurrentLevel,
+			Progress: progress,
+			Status:   v.Status,
+			Row:      v.Row,
+			Col:      v.Col,
+		}
+
+		if myLocation.Row == v.Row {
+			res.RowPeers = append(res.RowPeers, tmpPeer)
+		} else if myLocation.Col == v.Col {
+			res.ColPeers = append(res.ColPeers, tmpPeer)
 		}
 	}
 
+	if cacheByte, err := json.Marshal(res); nil == err {
+		_ = uuc.locationRepo.SetLocationNeighbourCache(ctx, user.ID, string(cacheByte), 10*time.Second)
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.WithdrawListReply, error) {
+// maskAddress 地址只显示前后几位
+func maskAddress(address string) string {
+	if 10 >= len(address) {
+		return address
+	}
 
-	var (
-		withdraws []*Withdraw
-		err       error
-	)
+	return address[:6] + "****" + address[len(address)-4:]
+}
 
-	res := &v1.WithdrawListReply{
-		Withdraw: make([]*v1.WithdrawListReply_List, 0),
+// SetAutoReinvest 设置自动复投档位，0关闭
+func (uuc *UserUseCase) SetAutoReinvest(ctx context.Context, req *v1.SetAutoReinvestRequest, user *User) (*v1.SetAutoReinvestReply, error) {
+	if nil == req.SendBody || 0 > req.SendBody.Level || 3 < req.SendBody.Level {
+		return nil, errors.New(500, "LEVEL_ERROR", "档位错误")
 	}
 
-	withdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, user.ID)
-	if nil != err {
-		return res, err
+	if err := uuc.uiRepo.UpdateUserInfoAutoReinvest(ctx, user.ID, req.SendBody.Level); nil != err {
+		return nil, err
 	}
 
-	for _, v := range withdraws {
-		res.Withdraw = append(res.Withdraw, &v1.WithdrawListReply_List{
-			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
-			Status:    v.Status,
-			Type:      v.Type,
-		})
+	return &v1.SetAutoReinvestReply{
+		Status: "ok",
+	}, nil
+}
+
+// recommendUpUserIds 推荐链路上的上级，由近到远，第一个是直推人
+func recommendUpUserIds(recommendCode string) []int64 {
+	res := make([]int64, 0)
+	tmpCodes := strings.Split(recommendCode, "D")
+	for i := len(tmpCodes) - 1; i >= 0; i-- {
+		tmpUserId, _ := strconv.ParseInt(tmpCodes[i], 10, 64)
+		if tmpUserId > 0 {
+			res = append(res, tmpUserId)
+		}
 	}
 
-	return res, nil
+	return res
 }
 
-func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, user *User) (*v1.WithdrawReply, error) {
+// rewardRecommendLevels 直推人以上的多层级推荐分红，按每个上级运行中占位的最大额度封顶，返回实际分出的金额
+func rewardRecommendLevels(ctx context.Context, locationRepo LocationRepo, ubRepo UserBalanceRepo, uiRepo UserInfoRepo,
+	recommendLevels []*RecommendLevel, upUserIds []int64, currentValue int64, locationId int64, rewardType string) (int64, error) {
 	var (
+		rewardTotal int64
+		userInfos   map[int64]*UserInfo
 		err         error
-		userUndo    *User
-		userBalance *UserBalance
 	)
 
-	if "dhb" != req.SendBody.Type && "usdt" != req.SendBody.Type {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	if 0 == len(recommendLevels) || 2 > len(upUserIds) {
+		return 0, nil
 	}
 
-	userUndo, err = uuc.repo.GetUserById(ctx, user.ID)
-	if nil == userUndo {
-		return nil, err
+	userInfos, err = uiRepo.GetUserInfoByUserIds(ctx, upUserIds...)
+	if nil != err {
+		return 0, err
 	}
-	if 0 < userUndo.Undo {
-		return nil, errors.New(500, "USER_WITHDRAW_ERROR", "账户锁定")
+
+	for _, vRecommendLevel := range recommendLevels {
+		if 2 > vRecommendLevel.Level || int64(len(upUserIds)) < vRecommendLevel.Level || 0 >= vRecommendLevel.Rate {
+			continue
+		}
+
+		tmpUserId := upUserIds[vRecommendLevel.Level-1]
+		if _, ok := userInfos[tmpUserId]; !ok || userInfos[tmpUserId].HistoryRecommend < vRecommendLevel.NeedRecommend {
+			continue
+		}
+
+		// 只给运行中的占位分红，锁住读，同一事务前面的分红已经算在里面
+		tmpLocation, _ := locationRepo.LockMyLocationRunningLast(ctx, tmpUserId)
+		if nil == tmpLocation || tmpLocation.CurrentLevel < vRecommendLevel.NeedLocationLevel || tmpLocation.Current >= tmpLocation.CurrentMax {
+			continue
+		}
+
+		rewardAmount := currentValue / 100 * vRecommendLevel.Rate
+		if tmpLocation.CurrentMax-tmpLocation.Current < rewardAmount { // 大于最大可分红额度
+			rewardAmount = tmpLocation.CurrentMax - tmpLocation.Current
+		}
+		if 0 >= rewardAmount {
+			continue
+		}
+
+		tmpStatus := "running"
+		var tmpStopDate time.Time
+		if tmpLocation.Current+rewardAmount >= tmpLocation.CurrentMax { // 占位分红人分满停止
+			tmpStatus = "stop"
+			tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
+		}
+		err = locationRepo.UpdateLocation(ctx, tmpLocation.ID, tmpStatus, rewardAmount, tmpStopDate) // 分红占位数据修改
+		if nil != err {
+			return 0, err
+		}
+
+		_, err = ubRepo.LevelRecommendReward(ctx, tmpUserId, rewardAmount, locationId, tmpLocation.ID, rewardType, vRecommendLevel.Level)
+		if nil != err {
+			return 0, err
+		}
+		rewardTotal += rewardAmount
 	}
 
-	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
-	if nil != err {
+	return rewardTotal, nil
+}
+
+// defaultVipLevels 未配置等级表时沿用直推人数 2/4/6/8/10 的规则
+func defaultVipLevels(rates map[int64]int64) []*VipLevel {
+	res := make([]*VipLevel, 0)
+	for i := int64(1); i <= 5; i++ {
+		res = append(res, &VipLevel{
+			Level:         i,
+			NeedRecommend: i * 2,
+			Rate:          rates[i],
+		})
+	}
+
+	return res
+}
+
+// getVipLevels 会员等级表，等级升序
+func getVipLevels(ctx context.Context, uiRepo UserInfoRepo, configRepo ConfigRepo) []*VipLevel {
+	vipLevels, _ := uiRepo.GetVipLevels(ctx)
+	if nil != vipLevels && 0 < len(vipLevels) {
+		sort.Slice(vipLevels, func(i, j int) bool {
+			return vipLevels[i].Level < vipLevels[j].Level
+		})
+		return vipLevels
+	}
+
+	rates := make(map[int64]int64, 0)
+	configs, _ := configRepo.GetConfigByKeys(ctx, "recommend_need_vip1", "recommend_need_vip2",
+		"recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmpLevel, _ := strconv.ParseInt(strings.TrimPrefix(vConfig.KeyName, "recommend_need_vip"), 10, 64)
+			rates[tmpLevel], _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+
+	return defaultVipLevels(rates)
+}
+
+// vipLevelRate 会员等级推荐分红百分比
+func vipLevelRate(vipLevels []*VipLevel, vip int64) int64 {
+	for _, v := range vipLevels {
+		if vip == v.Level {
+			return v.Rate
+		}
+	}
+
+	return 0
+}
+
+// vipLevelQualified 满足全部门槛的最高等级，没有返回nil
+func vipLevelQualified(vipLevels []*VipLevel, recommendNum int64, teamAmount int64, areaAmount int64) *VipLevel {
+	var res *VipLevel
+	for _, v := range vipLevels {
+		if recommendNum >= v.NeedRecommend && teamAmount >= v.NeedTeam && areaAmount >= v.NeedArea {
+			if nil == res || v.Level > res.Level {
+				res = v
+			}
+		}
+	}
+
+	return res
+}
+
+// nextVip 根据达标等级计算新的等级和到期时间，未过期的等级不降
+func nextVip(userInfo *UserInfo, qualified *VipLevel, now time.Time) (int64, time.Time, string) {
+	var (
+		vip       int64
+		vipExpire time.Time
+	)
+	if nil != qualified {
+		vip = qualified.Level
+		if 0 < qualified.ExpireDays {
+			vipExpire = now.AddDate(0, 0, int(qualified.ExpireDays))
+		}
+	}
+
+	if vip >= userInfo.Vip {
+		return vip, vipExpire, "recalc"
+	}
+
+	if !userInfo.VipExpire.IsZero() && now.After(userInfo.VipExpire) {
+		return vip, vipExpire, "expire"
+	}
+
+	return userInfo.Vip, userInfo.VipExpire, ""
+}
+
+// AdminVipRecalc 按等级表重新计算全部用户会员等级，定时任务调用
+func (uuc *UserUseCase) AdminVipRecalc(ctx context.Context, req *v1.AdminVipRecalcRequest) (*v1.AdminVipRecalcReply, error) {
+	var (
+		vipLevels      []*VipLevel
+		userRecommends []*UserRecommend
+		userInfos      map[int64]*UserInfo
+		userTeamStats  map[int64]*UserTeamStats
+		userIds        []int64
+		count          int64
+		err            error
+	)
+
+	vipLevels = getVipLevels(ctx, uuc.uiRepo, uuc.configRepo)
+
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+	if nil == userRecommends {
 		return nil, err
 	}
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-	if 0 >= amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	for _, v := range userRecommends {
+		userIds = append(userIds, v.UserId)
 	}
 
-	if "dhb" == req.SendBody.Type && userBalance.BalanceDhb < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	userInfos, err = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
 	}
 
-	if "usdt" == req.SendBody.Type && userBalance.BalanceUsdt < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	// 团队和小区业绩
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
 	}
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 
-		if "usdt" == req.SendBody.Type {
-			err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount) // 提现
+	now := time.Now().UTC().Add(8 * time.Hour)
+	for _, userId := range userIds {
+		if _, ok := userInfos[userId]; !ok {
+			continue
+		}
+		userInfo := userInfos[userId]
+
+		var tmpTeamAmount, tmpAreaAmount int64
+		if _, ok := userTeamStats[userId]; ok {
+			tmpTeamAmount = userTeamStats[userId].TeamAmount
+			tmpAreaAmount = userTeamStats[userId].AreaAmount
+		}
+
+		qualified := vipLevelQualified(vipLevels, userInfo.HistoryRecommend, tmpTeamAmount, tmpAreaAmount)
+		vip, vipExpire, reason := nextVip(userInfo, qualified, now)
+		if vip == userInfo.Vip && vipExpire.Equal(userInfo.VipExpire) {
+			continue
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.uiRepo.UpdateUserInfoVip(ctx, userId, vip, vipExpire)
 			if nil != err {
 				return err
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
-			if nil != err {
-				return err
+
+			if vip != userInfo.Vip {
+				err = uuc.uiRepo.CreateUserVipLog(ctx, &UserVipLog{
+					UserId:    userId,
+					BeforeVip: userInfo.Vip,
+					AfterVip:  vip,
+					Reason:    reason,
+				})
+				if nil != err {
+					return err
+				}
+				count++
 			}
 
-		} else if "dhb" == req.SendBody.Type {
-			err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
-			if nil != err {
-				return err
+			return nil
+		}); nil != err {
+			continue
+		}
+	}
+
+	return &v1.AdminVipRecalcReply{
+		Count: count,
+	}, nil
+}
+
+// buildUserTeamStats 按推荐码和占位全量计算团队统计
+func buildUserTeamStats(userRecommends []*UserRecommend, locations []*Location) []*UserTeamStats {
+	userAmount := make(map[int64]int64, 0)
+	userRunning := make(map[int64]bool, 0)
+	for _, v := range locations {
+		if "moved" == v.Status { // 跨档位移走的业绩算在新占位上
+			continue
+		}
+		userAmount[v.UserId] += v.CurrentMax / 5
+		if "running" == v.Status {
+			userRunning[v.UserId] = true
+		}
+	}
+
+	stats := make(map[int64]*UserTeamStats, 0)
+	legAmount := make(map[int64]map[int64]int64, 0)
+	for _, v := range userRecommends {
+		stats[v.UserId] = &UserTeamStats{UserId: v.UserId, OwnAmount: userAmount[v.UserId]}
+	}
+	for _, v := range userRecommends {
+		upUserIds := recommendUpUserIds(v.RecommendCode)
+		tmpLeg := v.UserId
+		for _, vUp := range upUserIds {
+			if _, ok := stats[vUp]; !ok {
+				stats[vUp] = &UserTeamStats{UserId: vUp, OwnAmount: userAmount[vUp]}
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
-			if nil != err {
-				return err
+			stats[vUp].TeamNum++
+			stats[vUp].TeamAmount += userAmount[v.UserId]
+			if userRunning[v.UserId] {
+				stats[vUp].ActiveNum++
 			}
+			if _, ok := legAmount[vUp]; !ok {
+				legAmount[vUp] = make(map[int64]int64, 0)
+			}
+			legAmount[vUp][tmpLeg] += userAmount[v.UserId]
+			tmpLeg = vUp
 		}
+	}
 
-		return nil
-	}); nil != err {
-		return nil, errors.New(500, "USER_WITHDRAW_ERROR", "提现失败，余额不足")
+	res := make([]*UserTeamStats, 0)
+	for _, v := range stats {
+		for _, vLeg := range legAmount[v.UserId] {
+			if vLeg > v.MaxAreaAmount {
+				v.MaxAreaAmount = vLeg
+			}
+		}
+		v.AreaAmount = v.TeamAmount - v.MaxAreaAmount
+		res = append(res, v)
 	}
 
-	return &v1.WithdrawReply{
-		Status: "ok",
+	return res
+}
+
+// AdminTeamStatsRebuild 全量重建团队统计，增量维护出错时使用
+func (uuc *UserUseCase) AdminTeamStatsRebuild(ctx context.Context, req *v1.AdminTeamStatsRebuildRequest, admin *User) (*v1.AdminTeamStatsRebuildReply, error) {
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	count, err := uuc.rebuildUserTeamStats(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminTeamStatsRebuildReply{
+		Count: count,
 	}, nil
 }
 
-func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
+// rebuildUserTeamStats .
+func (uuc *UserUseCase) rebuildUserTeamStats(ctx context.Context) (int64, error) {
+	var (
+		userRecommends []*UserRecommend
+		locations      []*Location
+		userIds        []int64
+		err            error
+	)
+
+	// 先锁住统计再读，读到的都是已提交的数据，没提交的增量等重建完再叠加
+	var stats []*UserTeamStats
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.urRepo.LockUserTeamStats(ctx)
+		if nil != err {
+			return err
+		}
+
+		userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+		if nil == userRecommends {
+			return err
+		}
+		for _, v := range userRecommends {
+			userIds = append(userIds, v.UserId)
+		}
+
+		locations, err = uuc.locationRepo.GetLocationByIds(ctx, userIds...)
+		if nil != err {
+			return err
+		}
+
+		stats = buildUserTeamStats(userRecommends, locations)
+		return uuc.urRepo.SaveUserTeamStats(ctx, stats)
+	}); nil != err {
+		return 0, err
+	}
+
+	return int64(len(stats)), nil
+}
+
+// AdminVipLevelList .
+func (uuc *UserUseCase) AdminVipLevelList(ctx context.Context, req *v1.AdminVipLevelListRequest) (*v1.AdminVipLevelListReply, error) {
+	res := &v1.AdminVipLevelListReply{
+		Levels: make([]*v1.AdminVipLevelListReply_List, 0),
+	}
+
+	for _, v := range getVipLevels(ctx, uuc.uiRepo, uuc.configRepo) {
+		res.Levels = append(res.Levels, &v1.AdminVipLevelListReply_List{
+			Id:            v.ID,
+			Level:         v.Level,
+			NeedRecommend: v.NeedRecommend,
+			NeedTeam:      fmt.Sprintf("%.2f", float64(v.NeedTeam)/float64(10000000000)),
+			NeedArea:      fmt.Sprintf("%.2f", float64(v.NeedArea)/float64(10000000000)),
+			Rate:          v.Rate,
+			ExpireDays:    v.ExpireDays,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminVipLevelUpdate 按等级新增或修改
+func (uuc *UserUseCase) AdminVipLevelUpdate(ctx context.Context, req *v1.AdminVipLevelUpdateRequest, admin *User) (*v1.AdminVipLevelUpdateReply, error) {
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	if nil == req.SendBody || 0 >= req.SendBody.Level || 0 > req.SendBody.Rate || 0 > req.SendBody.ExpireDays {
+		return nil, errors.New(500, "VIP_LEVEL_ERROR", "等级参数错误")
+	}
+
+	needTeam, _ := strconv.ParseFloat(req.SendBody.NeedTeam, 64)
+	needArea, _ := strconv.ParseFloat(req.SendBody.NeedArea, 64)
+	if err := uuc.uiRepo.SaveVipLevel(ctx, &VipLevel{
+		Level:         req.SendBody.Level,
+		NeedRecommend: req.SendBody.NeedRecommend,
+		NeedTeam:      int64(needTeam * 10000000000),
+		NeedArea:      int64(needArea * 10000000000),
+		Rate:          req.SendBody.Rate,
+		ExpireDays:    req.SendBody.ExpireDays,
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminVipLevelUpdateReply{}, nil
+}
+
+// AdminVipLogList .
+func (uuc *UserUseCase) AdminVipLogList(ctx context.Context, req *v1.AdminVipLogListRequest) (*v1.AdminVipLogListReply, error) {
 	var (
 		userSearch  *User
 		userId      int64 = 0
-		userRewards []*Reward
+		userVipLogs []*UserVipLog
 		users       map[int64]*User
-		userIdsMap  map[int64]int64
 		userIds     []int64
 		err         error
 		count       int64
 	)
-	res := &v1.AdminRewardListReply{
-		Rewards: make([]*v1.AdminRewardListReply_List, 0),
+	res := &v1.AdminVipLogListReply{
+		Logs: make([]*v1.AdminVipLogListReply_List, 0),
 	}
 
 	// 地址查询
@@ -739,7 +1619,7 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminReward
 		userId = userSearch.ID
 	}
 
-	userRewards, err, count = uuc.ubRepo.GetUserRewards(ctx, &Pagination{
+	userVipLogs, err, count = uuc.uiRepo.GetUserVipLogs(ctx, &Pagination{
 		PageNum:  int(req.Page),
 		PageSize: 10,
 	}, userId)
@@ -748,231 +1628,1993 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminReward
 	}
 	res.Count = count
 
-	userIdsMap = make(map[int64]int64, 0)
-	for _, vUserReward := range userRewards {
-		userIdsMap[vUserReward.UserId] = vUserReward.UserId
-	}
-	for _, v := range userIdsMap {
-		userIds = append(userIds, v)
+	for _, v := range userVipLogs {
+		userIds = append(userIds, v.UserId)
 	}
-
 	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
-	for _, vUserReward := range userRewards {
+	for _, v := range userVipLogs {
 		tmpUser := ""
 		if nil != users {
-			if _, ok := users[vUserReward.UserId]; ok {
-				tmpUser = users[vUserReward.UserId].Address
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
 			}
 		}
 
-		res.Rewards = append(res.Rewards, &v1.AdminRewardListReply_List{
-			CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
-			Type:      vUserReward.Type,
+		res.Logs = append(res.Logs, &v1.AdminVipLogListReply_List{
+			Id:        v.ID,
 			Address:   tmpUser,
-			Reason:    vUserReward.Reason,
+			BeforeVip: v.BeforeVip,
+			AfterVip:  v.AfterVip,
+			Reason:    v.Reason,
+			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
 		})
 	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *v1.AdminUserListRequest) (*v1.AdminUserListReply, error) {
+func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
 	var (
-		users                          []*User
-		userIds                        []int64
-		userBalances                   map[int64]*UserBalance
-		userInfos                      map[int64]*UserInfo
-		userCurrentMonthRecommendCount map[int64]int64
-		count                          int64
-		err                            error
+		userRewards []*Reward
+		err         error
 	)
-
-	res := &v1.AdminUserListReply{
-		Users: make([]*v1.AdminUserListReply_UserList, 0),
+	res := &v1.RecommendRewardListReply{
+		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
 	}
 
-	users, err, count = uuc.repo.GetUsers(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, req.Address)
+	userRewards, err = uuc.ubRepo.GetUserRewardByUserId(ctx, user.ID)
 	if nil != err {
 		return res, nil
 	}
-	res.Count = count
 
-	for _, vUsers := range users {
-		userIds = append(userIds, vUsers.ID)
+	for _, vUserReward := range userRewards {
+		if "recommend" == vUserReward.Reason || "recommend_vip" == vUserReward.Reason {
+			res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+				CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
+				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+				Type:      vUserReward.Type,
+				Reason:    vUserReward.Reason,
+			})
+		}
 	}
 
-	userBalances, err = uuc.ubRepo.GetUserBalanceByUserIds(ctx, userIds...)
-	if nil != err {
-		return res, nil
-	}
+	return res, nil
+}
+
+func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
+	var (
+		userRewards []*Reward
+		err         error
+	)
+	res := &v1.FeeRewardListReply{
+		Rewards: make([]*v1.FeeRewardListReply_List, 0),
+	}
+
+	userRewards, err = uuc.ubRepo.GetUserRewardByUserId(ctx, user.ID)
+	if nil != err {
+		return res, nil
+	}
+
+	for _, vUserReward := range userRewards {
+		if "fee" == vUserReward.Reason {
+			res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+				CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
+				Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.WithdrawListReply, error) {
+
+	var (
+		withdraws []*Withdraw
+		err       error
+	)
+
+	res := &v1.WithdrawListReply{
+		Withdraw: make([]*v1.WithdrawListReply_List, 0),
+	}
+
+	withdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, user.ID)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range withdraws {
+		res.Withdraw = append(res.Withdraw, &v1.WithdrawListReply_List{
+			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			Status:    v.Status,
+			Type:      v.Type,
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, user *User) (*v1.WithdrawReply, error) {
+	var (
+		err             error
+		userUndo        *User
+		userBalance     *UserBalance
+		balanceRecordId int64
+	)
+
+	if "dhb" != req.SendBody.Type && "usdt" != req.SendBody.Type {
+		return &v1.WithdrawReply{
+			Status: "fail",
+		}, nil
+	}
+
+	userUndo, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil == userUndo {
+		return nil, err
+	}
+	if 0 < userUndo.Undo {
+		return nil, errors.New(500, "USER_WITHDRAW_ERROR", "账户锁定")
+	}
+
+	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
+	amountFloat *= 10000000000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount {
+		return &v1.WithdrawReply{
+			Status: "fail",
+		}, nil
+	}
+
+	// 这里只是提前拦截，实际扣款在事务中锁行后按余额条件扣
+	if "dhb" == req.SendBody.Type && userBalance.BalanceDhb < amount {
+		return &v1.WithdrawReply{
+			Status: "fail",
+		}, nil
+	}
+
+	if "usdt" == req.SendBody.Type && userBalance.BalanceUsdt < amount {
+		return &v1.WithdrawReply{
+			Status: "fail",
+		}, nil
+	}
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+
+		if "usdt" == req.SendBody.Type {
+			balanceRecordId, err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount) // 提现
+			if nil != err {
+				return err
+			}
+			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type, balanceRecordId)
+			if nil != err {
+				return err
+			}
+
+		} else if "dhb" == req.SendBody.Type {
+			balanceRecordId, err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
+			if nil != err {
+				return err
+			}
+			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type, balanceRecordId)
+			if nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return nil, errors.New(500, "USER_WITHDRAW_ERROR", "提现失败，余额不足")
+	}
+
+	return &v1.WithdrawReply{
+		Status: "ok",
+	}, nil
+}
+
+// Transfer 用户之间转usdt余额，配置开关，可限制只能转给上下级，每天按转出人限额限次
+func (uuc *UserUseCase) Transfer(ctx context.Context, req *v1.TransferRequest, user *User) (*v1.TransferReply, error) {
+	var (
+		err              error
+		userUndo         *User
+		toUser           *User
+		userRecommend    *UserRecommend
+		toRecommend      *UserRecommend
+		configs          []*Config
+		transfer         *Transfer
+		transferEnable   string
+		transferOnlyTeam string
+		dayAmount        int64
+		dayNum           int64
+	)
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "transfer_enable", "transfer_only_team", "transfer_day_amount", "transfer_day_num")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "transfer_enable" == vConfig.KeyName {
+				transferEnable = vConfig.Value
+			} else if "transfer_only_team" == vConfig.KeyName {
+				transferOnlyTeam = vConfig.Value
+			} else if "transfer_day_amount" == vConfig.KeyName {
+				dayAmount, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				dayAmount *= 10000000000
+			} else if "transfer_day_num" == vConfig.KeyName {
+				dayNum, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if "1" != transferEnable {
+		return nil, errors.New(500, "TRANSFER_CLOSED", "未开放转账")
+	}
+	if nil == req.SendBody {
+		return nil, errors.New(500, "TRANSFER_ERROR", "参数错误")
+	}
+
+	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
+	amountFloat *= 10000000000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount {
+		return nil, errors.New(500, "TRANSFER_ERROR", "金额错误")
+	}
+
+	userUndo, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil == userUndo {
+		return nil, err
+	}
+	if 0 < userUndo.Undo {
+		return nil, errors.New(500, "TRANSFER_ERROR", "账户锁定")
+	}
+
+	toUser, err = uuc.repo.GetUserByAddress(ctx, req.SendBody.Address)
+	if nil == toUser {
+		return nil, errors.New(500, "TRANSFER_ERROR", "收款地址未注册")
+	}
+	if toUser.ID == user.ID {
+		return nil, errors.New(500, "TRANSFER_ERROR", "不能转给自己")
+	}
+
+	// 只能转给自己的上级或者下级
+	if "1" == transferOnlyTeam {
+		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
+		if nil == userRecommend {
+			return nil, errors.New(500, "TRANSFER_ERROR", "推荐关系不存在")
+		}
+		toRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, toUser.ID)
+		if nil == toRecommend {
+			return nil, errors.New(500, "TRANSFER_ERROR", "推荐关系不存在")
+		}
+
+		isTeam := false
+		for _, v := range recommendUpUserIds(userRecommend.RecommendCode) {
+			if toUser.ID == v {
+				isTeam = true
+				break
+			}
+		}
+		for _, v := range recommendUpUserIds(toRecommend.RecommendCode) {
+			if user.ID == v {
+				isTeam = true
+				break
+			}
+		}
+		if !isTeam {
+			return nil, errors.New(500, "TRANSFER_ERROR", "只能转给上级或下级")
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		transfer, err = uuc.ubRepo.Transfer(ctx, user.ID, toUser.ID, amount)
+		if nil != err {
+			return err
+		}
+
+		// 转出时已锁住转出人的余额，这里的当日累计包含本次
+		var todayAmount, todayNum int64
+		todayAmount, todayNum, err = uuc.ubRepo.GetTransferTotalToday(ctx, user.ID)
+		if nil != err {
+			return err
+		}
+		if 0 < dayAmount && todayAmount > dayAmount {
+			return errors.New(500, "TRANSFER_LIMIT", "超过今日转账额度")
+		}
+		if 0 < dayNum && todayNum > dayNum {
+			return errors.New(500, "TRANSFER_LIMIT", "超过今日转账次数")
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.TransferReply{
+		Id:     transfer.ID,
+		Status: "ok",
+	}, nil
+}
+
+// Convert usdt和dhb按后台价格互换，扣除点差 .
+func (uuc *UserUseCase) Convert(ctx context.Context, req *v1.ConvertRequest, user *User) (*v1.ConvertReply, error) {
+	var (
+		err           error
+		userUndo      *User
+		configs       []*Config
+		dhbConvert    *DhbConvert
+		convertEnable string
+		spread        int64
+		price         int64
+	)
+
+	// 配置
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "convert_enable", "convert_spread")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "convert_enable" == vConfig.KeyName {
+				convertEnable = vConfig.Value
+			} else if "convert_spread" == vConfig.KeyName {
+				spread, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if "1" != convertEnable {
+		return nil, errors.New(500, "CONVERT_CLOSED", "未开放兑换")
+	}
+	if 0 > spread || 100 <= spread {
+		return nil, errors.New(500, "CONVERT_ERROR", "点差配置错误")
+	}
+	if nil == req.SendBody {
+		return nil, errors.New(500, "CONVERT_ERROR", "参数错误")
+	}
+
+	price = getDhbPrice(ctx, uuc.configRepo)
+	if 0 >= price {
+		return nil, errors.New(500, "CONVERT_ERROR", "dhb价格未设置")
+	}
+
+	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
+	amountFloat *= 10000000000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount {
+		return nil, errors.New(500, "CONVERT_ERROR", "金额错误")
+	}
+
+	userUndo, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil == userUndo {
+		return nil, err
+	}
+	if 0 < userUndo.Undo {
+		return nil, errors.New(500, "CONVERT_ERROR", "账户锁定")
+	}
+
+	dhbConvert = &DhbConvert{
+		UserId:     user.ID,
+		FromAmount: amount,
+		Price:      price,
+		Spread:     spread,
+	}
+	if "usdt" == req.SendBody.From {
+		dhbConvert.FromCoin = "usdt"
+		dhbConvert.ToCoin = "dhb"
+		dhbConvert.ToAmount = DhbAmountByUsdt(amount/100*(100-spread), price)
+	} else if "dhb" == req.SendBody.From {
+		dhbConvert.FromCoin = "dhb"
+		dhbConvert.ToCoin = "usdt"
+		dhbConvert.ToAmount = UsdtAmountByDhb(amount, price) / 100 * (100 - spread)
+	} else {
+		return nil, errors.New(500, "CONVERT_ERROR", "币种错误")
+	}
+	if 0 >= dhbConvert.ToAmount {
+		return nil, errors.New(500, "CONVERT_ERROR", "金额太小")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		dhbConvert, err = uuc.ubRepo.Convert(ctx, dhbConvert)
+		if nil != err {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.ConvertReply{
+		Id:         dhbConvert.ID,
+		FromAmount: fmt.Sprintf("%.2f", float64(dhbConvert.FromAmount)/float64(10000000000)),
+		ToAmount:   fmt.Sprintf("%.2f", float64(dhbConvert.ToAmount)/float64(10000000000)),
+		Price:      fmt.Sprintf("%.4f", float64(dhbConvert.Price)/float64(10000000000)),
+		Status:     "ok",
+	}, nil
+}
+
+// AdminDhbPrice 配置为预言机取价时用链上价格更新dhb价格，否则保持后台设置的价格 .
+func (uuc *UserUseCase) AdminDhbPrice(ctx context.Context, oraclePrice int64) (*v1.AdminDhbPriceReply, error) {
+	var (
+		configs     []*Config
+		priceConfig *Config
+		source      string
+		maxChange   int64
+		err         error
+	)
+
+	configs, err = uuc.configRepo.GetConfigByKeys(ctx, "dhb_price", "dhb_price_source", "dhb_price_max_change")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "dhb_price" == vConfig.KeyName {
+				priceConfig = vConfig
+			} else if "dhb_price_source" == vConfig.KeyName {
+				source = vConfig.Value
+			} else if "dhb_price_max_change" == vConfig.KeyName {
+				maxChange, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if nil == priceConfig {
+		return nil, errors.New(500, "CONFIG_ERROR", "dhb价格配置不存在")
+	}
+	if 0 >= maxChange {
+		maxChange = 10 // 默认单次最多变动百分之10
+	}
+
+	if "oracle" == source && 0 < oraclePrice {
+		// 池子现价一笔大单就能拉动，和当前价格相差超过限制的不同步，等后台确认
+		currentPrice := getDhbPrice(ctx, uuc.configRepo)
+		if 0 < currentPrice {
+			tmpChange := oraclePrice - currentPrice
+			if 0 > tmpChange {
+				tmpChange = -tmpChange
+			}
+			if tmpChange*100 > currentPrice*maxChange {
+				uuc.log.Errorf("dhb price rejected: current %d oracle %d max change %d%%", currentPrice, oraclePrice, maxChange)
+				return nil, errors.New(500, "DHB_PRICE_ERROR", "价格变动超过限制")
+			}
+		}
+
+		_, err = uuc.configRepo.UpdateConfig(ctx, priceConfig.ID, strconv.FormatFloat(float64(oraclePrice)/float64(10000000000), 'f', 10, 64))
+		if nil != err {
+			return nil, err
+		}
+	} else {
+		source = "admin"
+	}
+
+	return &v1.AdminDhbPriceReply{
+		Price:  fmt.Sprintf("%.4f", float64(getDhbPrice(ctx, uuc.configRepo))/float64(10000000000)),
+		Source: source,
+	}, nil
+}
+
+func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
+	var (
+		userSearch  *User
+		userId      int64 = 0
+		userRewards []*Reward
+		users       map[int64]*User
+		userIdsMap  map[int64]int64
+		userIds     []int64
+		err         error
+		count       int64
+	)
+	res := &v1.AdminRewardListReply{
+		Rewards: make([]*v1.AdminRewardListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(ctx, req.Address)
+		if nil != err {
+			return res, nil
+		}
+		userId = userSearch.ID
+	}
+
+	userRewards, err, count = uuc.ubRepo.GetUserRewards(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	userIdsMap = make(map[int64]int64, 0)
+	for _, vUserReward := range userRewards {
+		userIdsMap[vUserReward.UserId] = vUserReward.UserId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	for _, vUserReward := range userRewards {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[vUserReward.UserId]; ok {
+				tmpUser = users[vUserReward.UserId].Address
+			}
+		}
+
+		res.Rewards = append(res.Rewards, &v1.AdminRewardListReply_List{
+			CreatedAt: vUserReward.CreatedAt.Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+			Type:      vUserReward.Type,
+			Address:   tmpUser,
+			Reason:    vUserReward.Reason,
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *v1.AdminUserListRequest) (*v1.AdminUserListReply, error) {
+	var (
+		users                          []*User
+		userIds                        []int64
+		userBalances                   map[int64]*UserBalance
+		userInfos                      map[int64]*UserInfo
+		userCurrentMonthRecommendCount map[int64]int64
+		userTeamStats                  map[int64]*UserTeamStats
+		count                          int64
+		err                            error
+	)
+
+	res := &v1.AdminUserListReply{
+		Users: make([]*v1.AdminUserListReply_UserList, 0),
+	}
+
+	users, err, count = uuc.repo.GetUsers(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Address)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, vUsers := range users {
+		userIds = append(userIds, vUsers.ID)
+	}
+
+	userBalances, err = uuc.ubRepo.GetUserBalanceByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	userInfos, err = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	userCurrentMonthRecommendCount, err = uuc.userCurrentMonthRecommendRepo.GetUserCurrentMonthRecommendCountByUserIds(ctx, userIds...)
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, userIds...)
+
+	for _, v := range users {
+		if _, ok := userBalances[v.ID]; !ok {
+			continue
+		}
+		if _, ok := userInfos[v.ID]; !ok {
+			continue
+		}
+
+		tmpTeamStats := &UserTeamStats{}
+		if _, ok := userTeamStats[v.ID]; ok {
+			tmpTeamStats = userTeamStats[v.ID]
+		}
+
+		var tmpCount int64
+		if nil != userCurrentMonthRecommendCount {
+			if _, ok := userCurrentMonthRecommendCount[v.ID]; ok {
+				tmpCount = userCurrentMonthRecommendCount[v.ID]
+			}
+		}
+
+		res.Users = append(res.Users, &v1.AdminUserListReply_UserList{
+			UserId:           v.ID,
+			CreatedAt:        v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Address:          v.Address,
+			BalanceUsdt:      fmt.Sprintf("%.2f", float64(userBalances[v.ID].BalanceUsdt)/float64(10000000000)),
+			BalanceDhb:       fmt.Sprintf("%.2f", float64(userBalances[v.ID].BalanceDhb)/float64(10000000000)),
+			Vip:              userInfos[v.ID].Vip,
+			MonthRecommend:   tmpCount,
+			HistoryRecommend: userInfos[v.ID].HistoryRecommend,
+			TeamNum:          tmpTeamStats.TeamNum,
+			TeamActiveNum:    tmpTeamStats.ActiveNum,
+			TeamAmount:       fmt.Sprintf("%.2f", float64(tmpTeamStats.TeamAmount)/float64(10000000000)),
+			AreaAmount:       fmt.Sprintf("%.2f", float64(tmpTeamStats.AreaAmount)/float64(10000000000)),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) GetUserByUserIds(ctx context.Context, userIds ...int64) (map[int64]*User, error) {
+	return uuc.repo.GetUserByUserIds(ctx, userIds...)
+}
+
+func (uuc *UserUseCase) AdminLocationList(ctx context.Context, req *v1.AdminLocationListRequest) (*v1.AdminLocationListReply, error) {
+	var (
+		locations  []*Location
+		userSearch *User
+		userId     int64
+		userIds    []int64
+		userIdsMap map[int64]int64
+		users      map[int64]*User
+		count      int64
+		err        error
+	)
+
+	res := &v1.AdminLocationListReply{
+		Locations: make([]*v1.AdminLocationListReply_LocationList, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(ctx, req.Address)
+		if nil != err {
+			return res, nil
+		}
+		userId = userSearch.ID
+	}
+
+	locations, err, count = uuc.locationRepo.GetLocations(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	userIdsMap = make(map[int64]int64, 0)
+	for _, vLocations := range locations {
+		userIdsMap[vLocations.UserId] = vLocations.UserId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	for _, v := range locations {
+		if _, ok := users[v.UserId]; !ok {
+			continue
+		}
+
+		res.Locations = append(res.Locations, &v1.AdminLocationListReply_LocationList{
+			CreatedAt:    v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Address:      users[v.UserId].Address,
+			Row:          v.Row,
+			Col:          v.Col,
+			Status:       v.Status,
+			CurrentLevel: v.CurrentLevel,
+			Current:      fmt.Sprintf("%.2f", float64(v.Current)/float64(10000000000)),
+			CurrentMax:   fmt.Sprintf("%.2f", float64(v.CurrentMax)/float64(10000000000)),
+			MatrixId:     v.MatrixId,
+			Id:           v.ID,
+		})
+	}
+
+	return res, nil
+
+}
+
+// AdminLocationEventList 按流水重放占位的全部变动，并和当前占位核对
+func (uuc *UserUseCase) AdminLocationEventList(ctx context.Context, req *v1.AdminLocationEventListRequest) (*v1.AdminLocationEventListReply, error) {
+	var (
+		location *Location
+		user     *User
+		events   []*LocationEvent
+		replay   *Location
+		err      error
+	)
+
+	res := &v1.AdminLocationEventListReply{
+		Events: make([]*v1.AdminLocationEventListReply_List, 0),
+	}
+
+	location, err = uuc.locationRepo.GetLocationById(ctx, req.LocationId)
+	if nil != err {
+		return res, err
+	}
+
+	user, err = uuc.repo.GetUserById(ctx, location.UserId)
+	if nil == err {
+		res.Address = user.Address
+	}
+	res.MatrixId = location.MatrixId
+	res.Status = location.Status
+	res.Current = fmt.Sprintf("%.2f", float64(location.Current)/float64(10000000000))
+	res.Row = location.Row
+	res.Col = location.Col
+
+	events, err = uuc.locationRepo.GetLocationEventsByLocationId(ctx, location.ID)
+	if nil != err {
+		return res, err
+	}
+
+	res.Consistent = true
+	for _, v := range events {
+		// 每条流水的变动前要接上一条的变动后
+		if nil != replay && (replay.Status != v.BeforeStatus || replay.Current != v.BeforeCurrent ||
+			replay.Row != v.BeforeRow || replay.Col != v.BeforeCol) {
+			res.Consistent = false
+		}
+		replay = &Location{
+			Status:  v.AfterStatus,
+			Current: v.AfterCurrent,
+			Row:     v.AfterRow,
+			Col:     v.AfterCol,
+		}
+
+		res.Events = append(res.Events, &v1.AdminLocationEventListReply_List{
+			Id:            v.ID,
+			CreatedAt:     v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Type:          v.Type,
+			BeforeStatus:  v.BeforeStatus,
+			AfterStatus:   v.AfterStatus,
+			BeforeCurrent: fmt.Sprintf("%.2f", float64(v.BeforeCurrent)/float64(10000000000)),
+			AfterCurrent:  fmt.Sprintf("%.2f", float64(v.AfterCurrent)/float64(10000000000)),
+			BeforeRow:     v.BeforeRow,
+			AfterRow:      v.AfterRow,
+			BeforeCol:     v.BeforeCol,
+			AfterCol:      v.AfterCol,
+			StopDate:      v.AfterStopDate.Format("2006-01-02 15:04:05"),
+			TriggerType:   v.TriggerType,
+			TriggerRef:    v.TriggerRef,
+			CorrelationId: v.CorrelationId,
+			BeforeLevel:   v.BeforeLevel,
+			AfterLevel:    v.AfterLevel,
+			BeforeMax:     fmt.Sprintf("%.2f", float64(v.BeforeMax)/float64(10000000000)),
+			AfterMax:      fmt.Sprintf("%.2f", float64(v.AfterMax)/float64(10000000000)),
+		})
+	}
+
+	// 重放结果和当前占位不一致，说明有未记流水的改动
+	if nil == replay || replay.Status != location.Status || replay.Current != location.Current ||
+		replay.Row != location.Row || replay.Col != location.Col {
+		res.Consistent = false
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminRecommendList(ctx context.Context, req *v1.AdminUserRecommendRequest) (*v1.AdminUserRecommendReply, error) {
+	var (
+		userRecommendTrees []*UserRecommendTree
+		userRecommend      *UserRecommend
+		userRecommends     map[int64]*UserRecommend
+		userIdsMap         map[int64]int64
+		userIds            []int64
+		users              map[int64]*User
+		err                error
+	)
+
+	res := &v1.AdminUserRecommendReply{
+		Users: make([]*v1.AdminUserRecommendReply_List, 0),
+	}
+
+	// 地址查询
+	if 0 < req.UserId {
+		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, req.UserId)
+		if nil == userRecommend {
+			return res, nil
+		}
+
+		userRecommendTrees, err = uuc.urRepo.GetUserRecommendDescendants(ctx, userRecommend.UserId, 1)
+		if nil != err {
+			return res, nil
+		}
+	}
+
+	userIdsMap = make(map[int64]int64, 0)
+	for _, vUserRecommendTrees := range userRecommendTrees {
+		userIdsMap[vUserRecommendTrees.DescendantId] = vUserRecommendTrees.DescendantId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	// 返回推荐记录的id和注册时间，关系表只用来查直推
+	userRecommends, err = uuc.urRepo.GetUserRecommendByUserIds(ctx, userIds...)
+	if nil != err {
+		return res, nil
+	}
+
+	for _, v := range userRecommendTrees {
+		if _, ok := users[v.DescendantId]; !ok {
+			continue
+		}
+		if _, ok := userRecommends[v.DescendantId]; !ok {
+			continue
+		}
+
+		res.Users = append(res.Users, &v1.AdminUserRecommendReply_List{
+			Address:   users[v.DescendantId].Address,
+			Id:        userRecommends[v.DescendantId].ID,
+			UserId:    v.DescendantId,
+			CreatedAt: userRecommends[v.DescendantId].CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminRecommendTreeBackfill 按推荐码重建推荐上下级关系表
+func (uuc *UserUseCase) AdminRecommendTreeBackfill(ctx context.Context, req *v1.AdminRecommendTreeBackfillRequest, admin *User) (*v1.AdminRecommendTreeBackfillReply, error) {
+	var (
+		userRecommends []*UserRecommend
+		count          int64
+		err            error
+	)
+
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+	if nil == userRecommends {
+		return nil, err
+	}
+
+	for _, vUserRecommends := range userRecommends {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return uuc.urRepo.ResetUserRecommendTree(ctx, vUserRecommends.UserId, recommendUpUserIds(vUserRecommends.RecommendCode))
+		}); nil != err {
+			return nil, err
+		}
+		count++
+	}
+
+	return &v1.AdminRecommendTreeBackfillReply{
+		Count: count,
+	}, nil
+}
+
+// isAdminUser 后台管理员，配置admin_user_ids，逗号分隔
+func isAdminUser(ctx context.Context, configRepo ConfigRepo, userId int64) bool {
+	configs, _ := configRepo.GetConfigByKeys(ctx, "admin_user_ids")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "admin_user_ids" != vConfig.KeyName {
+				continue
+			}
+			for _, v := range strings.Split(vConfig.Value, ",") {
+				tmpUserId, _ := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+				if 0 < userId && tmpUserId == userId {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// AdminBalanceAdjust 人工调整余额，先创建待审核，另一个管理员通过后才入账
+func (uuc *UserUseCase) AdminBalanceAdjust(ctx context.Context, req *v1.AdminBalanceAdjustRequest, admin *User) (*v1.AdminBalanceAdjustReply, error) {
+	var (
+		user       *User
+		adjustment *BalanceAdjustment
+		err        error
+	)
+
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+	if nil == req.SendBody || ("credit" != req.SendBody.Direction && "debit" != req.SendBody.Direction) ||
+		("usdt" != req.SendBody.CoinType && "dhb" != req.SendBody.CoinType) {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "参数错误")
+	}
+	if "" == strings.TrimSpace(req.SendBody.Reason) || "" == strings.TrimSpace(req.SendBody.Ticket) {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "必须填写原因和工单号")
+	}
+
+	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
+	amountFloat *= 10000000000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "金额错误")
+	}
+
+	user, err = uuc.repo.GetUserByAddress(ctx, req.SendBody.Address)
+	if nil != err {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "用户不存在")
+	}
+
+	adjustment, err = uuc.ubRepo.CreateBalanceAdjustment(ctx, &BalanceAdjustment{
+		UserId:    user.ID,
+		Direction: req.SendBody.Direction,
+		CoinType:  req.SendBody.CoinType,
+		Amount:    amount,
+		Reason:    req.SendBody.Reason,
+		Ticket:    req.SendBody.Ticket,
+		CreatedBy: admin.ID,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminBalanceAdjustReply{
+		Id:     adjustment.ID,
+		Status: adjustment.Status,
+	}, nil
+}
+
+// AdminBalanceAdjustApprove 审核人工调整，审核人不能是创建人，通过后走正常余额和记录
+func (uuc *UserUseCase) AdminBalanceAdjustApprove(ctx context.Context, req *v1.AdminBalanceAdjustApproveRequest, admin *User) (*v1.AdminBalanceAdjustApproveReply, error) {
+	var (
+		adjustment *BalanceAdjustment
+		err        error
+	)
+
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+	if nil == req.SendBody {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "参数错误")
+	}
+
+	adjustment, err = uuc.ubRepo.GetBalanceAdjustmentById(ctx, req.SendBody.Id)
+	if nil != err {
+		return nil, err
+	}
+	if "pending" != adjustment.Status {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "余额调整已处理")
+	}
+	if admin.ID == adjustment.CreatedBy {
+		return nil, errors.New(500, "BALANCE_ADJUST_ERROR", "需要另一个管理员审核")
+	}
+
+	status := "rejected"
+	if req.SendBody.Approve {
+		status = "approved"
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var balanceRecordId int64
+		if req.SendBody.Approve {
+			tmpAmount := adjustment.Amount
+			if "debit" == adjustment.Direction {
+				tmpAmount = -tmpAmount
+			}
+
+			balanceRecordId, err = uuc.ubRepo.AdjustBalance(ctx, adjustment.UserId, adjustment.CoinType, tmpAmount, adjustment.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		return uuc.ubRepo.UpdateBalanceAdjustmentStatus(ctx, adjustment.ID, status, admin.ID, balanceRecordId)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminBalanceAdjustApproveReply{
+		Status: status,
+	}, nil
+}
+
+func (uuc *UserUseCase) AdminBalanceAdjustList(ctx context.Context, req *v1.AdminBalanceAdjustListRequest) (*v1.AdminBalanceAdjustListReply, error) {
+	var (
+		adjustments []*BalanceAdjustment
+		users       map[int64]*User
+		userIds     []int64
+		err         error
+		count       int64
+	)
+	res := &v1.AdminBalanceAdjustListReply{
+		List: make([]*v1.AdminBalanceAdjustListReply_List, 0),
+	}
+
+	adjustments, err, count = uuc.ubRepo.GetBalanceAdjustments(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range adjustments {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	for _, v := range adjustments {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.List = append(res.List, &v1.AdminBalanceAdjustListReply_List{
+			Id:         v.ID,
+			Address:    tmpUser,
+			Direction:  v.Direction,
+			CoinType:   v.CoinType,
+			Amount:     fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			Reason:     v.Reason,
+			Ticket:     v.Ticket,
+			Status:     v.Status,
+			CreatedBy:  v.CreatedBy,
+			ApprovedBy: v.ApprovedBy,
+			CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// GetReserveWallets 储备钱包，收款和打款钱包
+func (uuc *UserUseCase) GetReserveWallets(ctx context.Context) []string {
+	res := make([]string, 0)
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "reserve_wallets")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "reserve_wallets" == vConfig.KeyName {
+				for _, v := range strings.Split(vConfig.Value, ",") {
+					if "" != strings.TrimSpace(v) {
+						res = append(res, strings.TrimSpace(v))
+					}
+				}
+			}
+		}
+	}
+
+	if 0 == len(res) {
+		res = append(res, "0x636F2deAAb4C9A8F3c808D23F16f456009C4e9Fd", "0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f")
+	}
+
+	return res
+}
+
+// GetDhbTokenAddress dhb代币合约，充值、打款和价格查询共用，没配置时用主网合约，0x96BD...是测试网的
+func (uuc *UserUseCase) GetDhbTokenAddress(ctx context.Context) string {
+	return getDhbTokenAddress(ctx, uuc.configRepo)
+}
+
+// AdminReserveSnapshot 储备证明快照，wallets是各钱包链上usdt余额，系统精度
+func (uuc *UserUseCase) AdminReserveSnapshot(ctx context.Context, wallets map[string]int64) (*v1.AdminReserveSnapshotReply, error) {
+	var (
+		userBalances    []*UserBalance
+		users           map[int64]*User
+		userIds         []int64
+		leaves          []*ReserveLeaf
+		snapshot        *ReserveSnapshot
+		onchain         int64
+		userBalance     int64
+		pendingWithdraw int64
+		err             error
+	)
+
+	for _, v := range wallets {
+		onchain += v
+	}
+
+	pendingWithdraw, err = uuc.ubRepo.GetWithdrawUsdtNotPaidTotal(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	// 用户余额默克尔树，按user_id排序
+	userBalances, err = uuc.ubRepo.GetUserBalances(ctx)
+	if nil != err {
+		return nil, err
+	}
+	sort.Slice(userBalances, func(i, j int) bool {
+		return userBalances[i].UserId < userBalances[j].UserId
+	})
+	for _, v := range userBalances {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range userBalances {
+		// 冲正后余额可能为负，负债按0算，不能抵扣别人的余额
+		tmpBalance := v.BalanceUsdt
+		if 0 > tmpBalance {
+			tmpBalance = 0
+		}
+		userBalance += tmpBalance
+
+		if _, ok := users[v.UserId]; !ok {
+			continue
+		}
+
+		tmpNonce := make([]byte, 16)
+		if _, err = rand.Read(tmpNonce); nil != err {
+			return nil, err
+		}
+		leaves = append(leaves, &ReserveLeaf{
+			UserId:  v.UserId,
+			Address: users[v.UserId].Address,
+			Balance: tmpBalance,
+			Nonce:   hex.EncodeToString(tmpNonce),
+			Leaf:    reserveLeafHash(users[v.UserId].Address, tmpBalance, hex.EncodeToString(tmpNonce)),
+		})
+	}
+
+	tmpWallets, _ := json.Marshal(wallets)
+	snapshot = &ReserveSnapshot{
+		Onchain:         onchain,
+		UserBalance:     userBalance,
+		PendingWithdraw: pendingWithdraw,
+		Wallets:         string(tmpWallets),
+		MerkleRoot:      reserveMerkleRoot(leaves),
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		snapshot, err = uuc.ubRepo.CreateReserveSnapshot(ctx, snapshot, leaves)
+		return err
+	}); nil != err {
+		return nil, err
+	}
+
+	if snapshot.Onchain < snapshot.UserBalance+snapshot.PendingWithdraw {
+		uuc.log.Errorf("reserve not enough: onchain %d liabilities %d", snapshot.Onchain, snapshot.UserBalance+snapshot.PendingWithdraw)
+	}
+
+	return &v1.AdminReserveSnapshotReply{
+		Snapshot: reserveSnapshotReply(snapshot),
+	}, nil
+}
+
+func (uuc *UserUseCase) AdminReserveList(ctx context.Context, req *v1.AdminReserveListRequest) (*v1.AdminReserveListReply, error) {
+	var (
+		snapshots []*ReserveSnapshot
+		err       error
+		count     int64
+	)
+	res := &v1.AdminReserveListReply{
+		List: make([]*v1.ReserveSnapshot, 0),
+	}
+
+	snapshots, err, count = uuc.ubRepo.GetReserveSnapshots(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	})
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range snapshots {
+		res.List = append(res.List, reserveSnapshotReply(v))
+	}
+
+	return res, nil
+}
+
+// AdminReserveMerkle 导出快照的默克尔树叶子
+func (uuc *UserUseCase) AdminReserveMerkle(ctx context.Context, req *v1.AdminReserveMerkleRequest, admin *User) (*v1.AdminReserveMerkleReply, error) {
+	var (
+		snapshot *ReserveSnapshot
+		leaves   []*ReserveLeaf
+		err      error
+	)
+
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	snapshot, err = uuc.ubRepo.GetReserveSnapshot(ctx, req.SnapshotId)
+	if nil != err {
+		return nil, err
+	}
+	leaves, err = uuc.ubRepo.GetReserveLeaves(ctx, snapshot.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminReserveMerkleReply{
+		SnapshotId: snapshot.ID,
+		MerkleRoot: snapshot.MerkleRoot,
+		Leaves:     make([]*v1.AdminReserveMerkleReply_Leaves, 0),
+	}
+	for _, v := range leaves {
+		res.Leaves = append(res.Leaves, &v1.AdminReserveMerkleReply_Leaves{
+			UserId:  v.UserId,
+			Address: v.Address,
+			Balance: fmt.Sprintf("%.2f", float64(v.Balance)/float64(10000000000)),
+			Nonce:   v.Nonce,
+			Leaf:    v.Leaf,
+		})
+	}
+
+	return res, nil
+}
+
+// ReserveProof 用户在最新一次储备快照里的默克尔证明
+func (uuc *UserUseCase) ReserveProof(ctx context.Context, req *v1.ReserveProofRequest, user *User) (*v1.ReserveProofReply, error) {
+	var (
+		snapshot *ReserveSnapshot
+		leaves   []*ReserveLeaf
+		err      error
+	)
+
+	snapshot, err = uuc.ubRepo.GetReserveSnapshot(ctx, 0)
+	if nil != err {
+		return nil, err
+	}
+	leaves, err = uuc.ubRepo.GetReserveLeaves(ctx, snapshot.ID)
+	if nil != err {
+		return nil, err
+	}
 
-	userInfos, err = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+	index := -1
+	for k, v := range leaves {
+		if user.ID == v.UserId {
+			index = k
+			break
+		}
+	}
+	if 0 > index {
+		return nil, errors.New(500, "RESERVE_PROOF_ERROR", "快照中没有该用户")
+	}
+
+	res := &v1.ReserveProofReply{
+		SnapshotId: snapshot.ID,
+		MerkleRoot: snapshot.MerkleRoot,
+		Address:    leaves[index].Address,
+		Balance:    leaves[index].Balance,
+		Nonce:      leaves[index].Nonce,
+		Leaf:       leaves[index].Leaf,
+		Index:      int64(index),
+		Proof:      make([]*v1.ReserveProofReply_Proof, 0),
+		CreatedAt:  snapshot.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	levels := reserveMerkleLevels(leaves)
+	for i := 0; i < len(levels)-1; i++ {
+		tmpSibling := index ^ 1
+		if tmpSibling >= len(levels[i]) { // 单数时和自己配对
+			tmpSibling = index
+		}
+
+		tmpPosition := "right"
+		if tmpSibling < index {
+			tmpPosition = "left"
+		}
+		res.Proof = append(res.Proof, &v1.ReserveProofReply_Proof{
+			Hash:     hex.EncodeToString(levels[i][tmpSibling]),
+			Position: tmpPosition,
+		})
+		index /= 2
+	}
+
+	return res, nil
+}
+
+// 叶子和父节点的哈希前缀，区分两种节点防止把父节点伪造成叶子
+const (
+	reserveLeafPrefix = 0x00
+	reserveNodePrefix = 0x01
+)
+
+// reserveLeafHash 叶子，sha256(0x00+小写地址:余额:盐)，余额是系统精度
+func reserveLeafHash(address string, balance int64, nonce string) string {
+	tmpHash := sha256.Sum256(append([]byte{reserveLeafPrefix}, []byte(strings.ToLower(address)+":"+strconv.FormatInt(balance, 10)+":"+nonce)...))
+	return hex.EncodeToString(tmpHash[:])
+}
+
+// reserveMerkleLevels 默克尔树每一层，第一层是叶子，最后一层是根，父节点 sha256(0x01+左+右)
+func reserveMerkleLevels(leaves []*ReserveLeaf) [][][]byte {
+	levels := make([][][]byte, 0)
+	if 0 == len(leaves) {
+		return levels
+	}
+
+	tmpLevel := make([][]byte, 0)
+	for _, v := range leaves {
+		tmpLeaf, _ := hex.DecodeString(v.Leaf)
+		tmpLevel = append(tmpLevel, tmpLeaf)
+	}
+	levels = append(levels, tmpLevel)
+
+	for 1 < len(tmpLevel) {
+		tmpNext := make([][]byte, 0)
+		for i := 0; i < len(tmpLevel); i += 2 {
+			tmpRight := tmpLevel[i]
+			if i+1 < len(tmpLevel) {
+				tmpRight = tmpLevel[i+1]
+			}
+
+			tmpHash := sha256.Sum256(append(append([]byte{reserveNodePrefix}, tmpLevel[i]...), tmpRight...))
+			tmpNext = append(tmpNext, tmpHash[:])
+		}
+		levels = append(levels, tmpNext)
+		tmpLevel = tmpNext
+	}
+
+	return levels
+}
+
+func reserveMerkleRoot(leaves []*ReserveLeaf) string {
+	levels := reserveMerkleLevels(leaves)
+	if 0 == len(levels) {
+		return ""
+	}
+
+	return hex.EncodeToString(levels[len(levels)-1][0])
+}
+
+func reserveSnapshotReply(snapshot *ReserveSnapshot) *v1.ReserveSnapshot {
+	var (
+		wallets map[string]int64
+		ratio   = "0"
+	)
+
+	liabilities := snapshot.UserBalance + snapshot.PendingWithdraw
+	if 0 < liabilities {
+		ratio = fmt.Sprintf("%.4f", float64(snapshot.Onchain)/float64(liabilities)) // 覆盖率
+	}
+
+	res := &v1.ReserveSnapshot{
+		Id:              snapshot.ID,
+		Onchain:         fmt.Sprintf("%.2f", float64(snapshot.Onchain)/float64(10000000000)),
+		UserBalance:     fmt.Sprintf("%.2f", float64(snapshot.UserBalance)/float64(10000000000)),
+		PendingWithdraw: fmt.Sprintf("%.2f", float64(snapshot.PendingWithdraw)/float64(10000000000)),
+		Liabilities:     fmt.Sprintf("%.2f", float64(liabilities)/float64(10000000000)),
+		Ratio:           ratio,
+		MerkleRoot:      snapshot.MerkleRoot,
+		Wallets:         make([]*v1.ReserveSnapshot_Wallets, 0),
+		CreatedAt:       snapshot.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	_ = json.Unmarshal([]byte(snapshot.Wallets), &wallets)
+	for k, v := range wallets {
+		res.Wallets = append(res.Wallets, &v1.ReserveSnapshot_Wallets{
+			Address: k,
+			Balance: fmt.Sprintf("%.2f", float64(v)/float64(10000000000)),
+		})
+	}
+
+	return res
+}
+
+// reconcileDay 对账日期，默认昨天
+func reconcileDay(day string) (time.Time, time.Time, error) {
+	var (
+		start time.Time
+		err   error
+	)
+
+	if "" == day {
+		now := time.Now().UTC().AddDate(0, 0, -1)
+		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	} else {
+		start, err = time.Parse("2006-01-02", day)
+		if nil != err {
+			return start, start, errors.New(500, "RECONCILE_ERROR", "日期格式错误")
+		}
+	}
+
+	return start, start.AddDate(0, 0, 1), nil
+}
+
+// GetReconcileWithdrawList 对账日打款成功的提现，链上核对交易用
+func (uuc *UserUseCase) GetReconcileWithdrawList(ctx context.Context, day string) ([]*Withdraw, error) {
+	start, end, err := reconcileDay(day)
+	if nil != err {
+		return nil, err
+	}
+
+	return uuc.ubRepo.GetReconcileWithdrawSuccess(ctx, start, end)
+}
+
+// AdminReconcile 每日对账，默认对昨天，差额超过阈值告警，onchain 是链上核对过的提现转账金额
+func (uuc *UserUseCase) AdminReconcile(ctx context.Context, req *v1.AdminReconcileRequest, onchain map[int64]int64) (*v1.AdminReconcileReply, error) {
+	var (
+		start     time.Time
+		end       time.Time
+		configs   []*Config
+		threshold int64
+		deposits  []*ReconcileDetail
+		balances  []*ReconcileDetail
+		withdraws []*ReconcileDetail
+		err       error
+	)
+
+	start, end, err = reconcileDay(req.Day)
+	if nil != err {
+		return nil, err
+	}
+	day := start.Format("2006-01-02")
+
+	// 配置，告警阈值单位usdt
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "reconcile_alert_threshold")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "reconcile_alert_threshold" == vConfig.KeyName {
+				threshold, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				threshold *= 10000000000
+			}
+		}
+	}
+
+	deposits, err = uuc.ubRepo.GetReconcileDeposit(ctx, start, end)
+	if nil != err {
+		return nil, err
+	}
+	balances, err = uuc.ubRepo.GetReconcileBalance(ctx)
+	if nil != err {
+		return nil, err
+	}
+	withdraws, err = uuc.ubRepo.GetReconcileWithdraw(ctx, start, end, onchain)
+	if nil != err {
+		return nil, err
+	}
+
+	results := []*ReconcileResult{
+		reconcileResult("deposit", deposits, threshold),
+		reconcileResult("balance", balances, threshold),
+		reconcileResult("withdraw", withdraws, threshold),
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.ubRepo.SaveReconcileResults(ctx, day, results)
+	}); nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminReconcileReply{
+		List: make([]*v1.AdminReconcileReply_List, 0),
+	}
+	for _, v := range results {
+		if 1 == v.Alert {
+			uuc.log.Errorf("reconcile alert: %s %s expected %d actual %d num %d", day, v.Name, v.Expected, v.Actual, len(v.Details))
+		}
+
+		res.List = append(res.List, &v1.AdminReconcileReply_List{
+			Id:       v.ID,
+			Day:      day,
+			Name:     v.Name,
+			Expected: fmt.Sprintf("%.2f", float64(v.Expected)/float64(10000000000)),
+			Actual:   fmt.Sprintf("%.2f", float64(v.Actual)/float64(10000000000)),
+			Num:      int64(len(v.Details)),
+			Alert:    v.Alert,
+		})
+	}
+
+	return res, nil
+}
+
+// reconcileResult 汇总，只留有差额的明细，差额绝对值合计超过阈值告警
+func reconcileResult(name string, details []*ReconcileDetail, threshold int64) *ReconcileResult {
+	var (
+		diffTotal int64
+		noTxHash  bool
+	)
+	res := &ReconcileResult{
+		Name:    name,
+		Details: make([]*ReconcileDetail, 0),
+	}
+
+	for _, v := range details {
+		res.Expected += v.Expected
+		res.Actual += v.Actual
+
+		tmpDiff := v.Actual - v.Expected
+		if 0 > tmpDiff {
+			tmpDiff = -tmpDiff
+		}
+		if "no_tx_hash" == v.Reason || "tx_failed" == v.Reason {
+			noTxHash = true
+		} else if 0 == tmpDiff {
+			continue
+		}
+
+		diffTotal += tmpDiff
+		res.Details = append(res.Details, v)
+	}
+
+	if diffTotal > threshold || noTxHash { // 没有链上交易或链上交易失败的提现也要告警
+		res.Alert = 1
+	}
+
+	return res
+}
+
+func (uuc *UserUseCase) AdminReconcileList(ctx context.Context, req *v1.AdminReconcileListRequest) (*v1.AdminReconcileListReply, error) {
+	var (
+		reconcileResults []*ReconcileResult
+		err              error
+		count            int64
+	)
+	res := &v1.AdminReconcileListReply{
+		List: make([]*v1.AdminReconcileListReply_List, 0),
+	}
+
+	reconcileResults, err, count = uuc.ubRepo.GetReconcileResults(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Day)
 	if nil != err {
 		return res, nil
 	}
+	res.Count = count
 
-	userCurrentMonthRecommendCount, err = uuc.userCurrentMonthRecommendRepo.GetUserCurrentMonthRecommendCountByUserIds(ctx, userIds...)
+	for _, v := range reconcileResults {
+		res.List = append(res.List, &v1.AdminReconcileListReply_List{
+			Id:        v.ID,
+			Day:       v.Day,
+			Name:      v.Name,
+			Expected:  fmt.Sprintf("%.2f", float64(v.Expected)/float64(10000000000)),
+			Actual:    fmt.Sprintf("%.2f", float64(v.Actual)/float64(10000000000)),
+			Num:       v.Num,
+			Alert:     v.Alert,
+			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
 
-	for _, v := range users {
-		if _, ok := userBalances[v.ID]; !ok {
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminReconcileDetail(ctx context.Context, req *v1.AdminReconcileDetailRequest) (*v1.AdminReconcileDetailReply, error) {
+	var (
+		reconcileDetails []*ReconcileDetail
+		users            map[int64]*User
+		userIds          []int64
+		err              error
+		count            int64
+	)
+	res := &v1.AdminReconcileDetailReply{
+		List: make([]*v1.AdminReconcileDetailReply_List, 0),
+	}
+
+	reconcileDetails, err, count = uuc.ubRepo.GetReconcileDetails(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.ResultId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range reconcileDetails {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	for _, v := range reconcileDetails {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.List = append(res.List, &v1.AdminReconcileDetailReply_List{
+			UserId:   v.UserId,
+			Address:  tmpUser,
+			RecordId: v.RecordId,
+			Expected: fmt.Sprintf("%.2f", float64(v.Expected)/float64(10000000000)),
+			Actual:   fmt.Sprintf("%.2f", float64(v.Actual)/float64(10000000000)),
+			Reason:   v.Reason,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminLedgerVerify 用户余额和账本核对，opening补记账本上线前的期初余额，fix按账本重置余额
+func (uuc *UserUseCase) AdminLedgerVerify(ctx context.Context, req *v1.AdminLedgerVerifyRequest, admin *User) (*v1.AdminLedgerVerifyReply, error) {
+	var (
+		ledgerBalances []*LedgerBalance
+		userBalances   []*UserBalance
+		err            error
+	)
+
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	userBalances, err = uuc.ubRepo.GetUserBalances(ctx)
+	if nil != err {
+		return nil, err
+	}
+	ledgerBalances, err = uuc.ubRepo.GetLedgerBalances(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	ledgerUsdt := make(map[int64]int64, 0)
+	ledgerDhb := make(map[int64]int64, 0)
+	ledgerUser := make(map[int64]bool, 0)
+	res := &v1.AdminLedgerVerifyReply{
+		List:     make([]*v1.AdminLedgerVerifyReply_List, 0),
+		Accounts: make([]*v1.AdminLedgerVerifyReply_Accounts, 0),
+	}
+	for _, v := range ledgerBalances {
+		if "user" != v.Account {
+			res.Accounts = append(res.Accounts, &v1.AdminLedgerVerifyReply_Accounts{
+				Account:  v.Account,
+				CoinType: v.CoinType,
+				Balance:  fmt.Sprintf("%.2f", float64(v.Balance)/float64(10000000000)),
+			})
 			continue
 		}
-		if _, ok := userInfos[v.ID]; !ok {
+
+		ledgerUser[v.UserId] = true
+		if "dhb" == v.CoinType {
+			ledgerDhb[v.UserId] = v.Balance
+		} else {
+			ledgerUsdt[v.UserId] = v.Balance
+		}
+	}
+
+	for _, v := range userBalances {
+		// 账本上线前的余额，补记期初
+		if req.Opening && !ledgerUser[v.UserId] && (0 != v.BalanceUsdt || 0 != v.BalanceDhb) {
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				err = uuc.ubRepo.LedgerOpening(ctx, v.UserId, "usdt", v.BalanceUsdt)
+				if nil != err {
+					return err
+				}
+
+				return uuc.ubRepo.LedgerOpening(ctx, v.UserId, "dhb", v.BalanceDhb)
+			}); nil != err {
+				return nil, err
+			}
+
+			ledgerUsdt[v.UserId] = v.BalanceUsdt
+			ledgerDhb[v.UserId] = v.BalanceDhb
+			res.Opening++
+		}
+
+		if ledgerUsdt[v.UserId] == v.BalanceUsdt && ledgerDhb[v.UserId] == v.BalanceDhb {
 			continue
 		}
 
-		var tmpCount int64
-		if nil != userCurrentMonthRecommendCount {
-			if _, ok := userCurrentMonthRecommendCount[v.ID]; ok {
-				tmpCount = userCurrentMonthRecommendCount[v.ID]
+		res.List = append(res.List, &v1.AdminLedgerVerifyReply_List{
+			UserId:            v.UserId,
+			BalanceUsdt:       fmt.Sprintf("%.2f", float64(v.BalanceUsdt)/float64(10000000000)),
+			LedgerBalanceUsdt: fmt.Sprintf("%.2f", float64(ledgerUsdt[v.UserId])/float64(10000000000)),
+			BalanceDhb:        fmt.Sprintf("%.2f", float64(v.BalanceDhb)/float64(10000000000)),
+			LedgerBalanceDhb:  fmt.Sprintf("%.2f", float64(ledgerDhb[v.UserId])/float64(10000000000)),
+		})
+
+		if req.Fix {
+			tmpUserId := v.UserId
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				return uuc.ubRepo.ResetUserBalance(ctx, tmpUserId)
+			}); nil != err {
+				return nil, err
 			}
 		}
+	}
 
-		res.Users = append(res.Users, &v1.AdminUserListReply_UserList{
-			UserId:           v.ID,
-			CreatedAt:        v.CreatedAt.Format("2006-01-02 15:04:05"),
-			Address:          v.Address,
-			BalanceUsdt:      fmt.Sprintf("%.2f", float64(userBalances[v.ID].BalanceUsdt)/float64(10000000000)),
-			BalanceDhb:       fmt.Sprintf("%.2f", float64(userBalances[v.ID].BalanceDhb)/float64(10000000000)),
-			Vip:              userInfos[v.ID].Vip,
-			MonthRecommend:   tmpCount,
-			HistoryRecommend: userInfos[v.ID].HistoryRecommend,
+	res.Count = int64(len(res.List))
+	if 0 < res.Count {
+		uuc.log.Warnf("user_balance ledger diff: %d", res.Count)
+	}
+
+	return res, nil
+}
+
+// AdminRecommendAreaCheck 推荐链路检查，定时任务不修复只记录
+func (uuc *UserUseCase) AdminRecommendAreaCheck(ctx context.Context, req *v1.AdminRecommendAreaCheckRequest, admin *User) (*v1.AdminRecommendAreaCheckReply, error) {
+	if req.Fix && !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	diffs, err := uuc.CheckUserRecommendArea(ctx, req.Fix)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminRecommendAreaCheckReply{
+		List:  make([]*v1.AdminRecommendAreaCheckReply_List, 0),
+		Count: int64(len(diffs)),
+		Fixed: req.Fix && 0 < len(diffs),
+	}
+	for _, v := range diffs {
+		res.List = append(res.List, &v1.AdminRecommendAreaCheckReply_List{
+			RecommendCode: v.RecommendCode,
+			Num:           v.Num,
+			ExpectNum:     v.ExpectNum,
+			Type:          v.Type,
 		})
 	}
 
-	return res, nil
-}
-
-func (uuc *UserUseCase) GetUserByUserIds(ctx context.Context, userIds ...int64) (map[int64]*User, error) {
-	return uuc.repo.GetUserByUserIds(ctx, userIds...)
+	return res, nil
+}
+
+// CheckUserRecommendArea 按user_recommend推算每条到叶子节点的链路，和user_recommend_area比对，fix为true时修复
+func (uuc *UserUseCase) CheckUserRecommendArea(ctx context.Context, fix bool) ([]*UserRecommendAreaDiff, error) {
+	var (
+		userRecommends     []*UserRecommend
+		userRecommendAreas []*UserRecommendArea
+		diffs              []*UserRecommendAreaDiff
+		err                error
+	)
+
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+	if nil != err {
+		return nil, err
+	}
+	userRecommendAreas, err = uuc.urRepo.GetUserRecommendAreas(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	// 有下级的不是链路末尾
+	hasChild := make(map[int64]bool, 0)
+	for _, v := range userRecommends {
+		upUserIds := recommendUpUserIds(v.RecommendCode)
+		if 0 < len(upUserIds) {
+			hasChild[upUserIds[0]] = true
+		}
+	}
+	expect := make(map[string]int64, 0)
+	for _, v := range userRecommends {
+		if hasChild[v.UserId] {
+			continue
+		}
+		tmpCode := v.RecommendCode + "D" + strconv.FormatInt(v.UserId, 10)
+		expect[tmpCode] = int64(len(strings.Split(tmpCode, "D")) - 1)
+	}
+
+	var (
+		deleteIds []int64
+		areas     []*UserRecommendArea
+	)
+	exist := make(map[string]bool, 0)
+	for _, v := range userRecommendAreas {
+		expectNum, ok := expect[v.RecommendCode]
+		if !ok || exist[v.RecommendCode] { // 不该有的或者重复的
+			diffs = append(diffs, &UserRecommendAreaDiff{ID: v.ID, RecommendCode: v.RecommendCode, Num: v.Num, Type: "extra"})
+			deleteIds = append(deleteIds, v.ID)
+			continue
+		}
+
+		exist[v.RecommendCode] = true
+		if expectNum != v.Num {
+			diffs = append(diffs, &UserRecommendAreaDiff{ID: v.ID, RecommendCode: v.RecommendCode, Num: v.Num, ExpectNum: expectNum, Type: "num"})
+			areas = append(areas, &UserRecommendArea{ID: v.ID, RecommendCode: v.RecommendCode, Num: expectNum})
+		}
+	}
+	for code, expectNum := range expect {
+		if exist[code] {
+			continue
+		}
+		diffs = append(diffs, &UserRecommendAreaDiff{RecommendCode: code, ExpectNum: expectNum, Type: "missing"})
+		areas = append(areas, &UserRecommendArea{RecommendCode: code, Num: expectNum})
+	}
+
+	if 0 < len(diffs) {
+		uuc.log.Warnf("user_recommend_area diff: %d", len(diffs))
+	}
+
+	if fix && 0 < len(diffs) {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return uuc.urRepo.FixUserRecommendArea(ctx, deleteIds, areas)
+		}); nil != err {
+			return nil, err
+		}
+	}
+
+	return diffs, nil
 }
 
-func (uuc *UserUseCase) AdminLocationList(ctx context.Context, req *v1.AdminLocationListRequest) (*v1.AdminLocationListReply, error) {
+// AdminUserMove 把用户和整个下级团队移到新的推荐人下面
+func (uuc *UserUseCase) AdminUserMove(ctx context.Context, req *v1.AdminUserMoveRequest, admin *User) (*v1.AdminUserMoveReply, error) {
 	var (
-		locations  []*Location
-		userSearch *User
-		userId     int64
-		userIds    []int64
-		userIdsMap map[int64]int64
-		users      map[int64]*User
-		count      int64
-		err        error
+		userRecommend      *UserRecommend
+		newRecommend       *UserRecommend
+		newAncestors       []*UserRecommendTree
+		descendants        []*UserRecommendTree
+		descendantCodes    map[int64]string
+		userRecommends     []*UserRecommend
+		oldRecommendUserId int64
+		err                error
 	)
 
-	res := &v1.AdminLocationListReply{
-		Locations: make([]*v1.AdminLocationListReply_LocationList, 0),
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
 	}
 
-	// 地址查询
-	if "" != req.Address {
-		userSearch, err = uuc.repo.GetUserByAddress(ctx, req.Address)
-		if nil != err {
-			return res, nil
-		}
-		userId = userSearch.ID
+	if nil == req.SendBody || 0 >= req.SendBody.UserId || 0 >= req.SendBody.RecommendUserId || req.SendBody.UserId == req.SendBody.RecommendUserId {
+		return nil, errors.New(500, "USER_MOVE_ERROR", "参数错误")
 	}
 
-	locations, err, count = uuc.locationRepo.GetLocations(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, userId)
-	if nil != err {
-		return res, nil
+	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, req.SendBody.UserId)
+	if nil == userRecommend {
+		return nil, errors.New(500, "USER_MOVE_ERROR", "用户不存在")
+	}
+	newRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, req.SendBody.RecommendUserId)
+	if nil == newRecommend {
+		return nil, errors.New(500, "USER_MOVE_ERROR", "推荐人不存在")
 	}
-	res.Count = count
 
-	userIdsMap = make(map[int64]int64, 0)
-	for _, vLocations := range locations {
-		userIdsMap[vLocations.UserId] = vLocations.UserId
+	upUserIds := recommendUpUserIds(userRecommend.RecommendCode)
+	if 0 < len(upUserIds) {
+		oldRecommendUserId = upUserIds[0]
 	}
-	for _, v := range userIdsMap {
-		userIds = append(userIds, v)
+	if oldRecommendUserId == req.SendBody.RecommendUserId {
+		return nil, errors.New(500, "USER_MOVE_ERROR", "推荐人没有变化")
 	}
 
-	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	// 新推荐人不能是自己的下级
+	newAncestors, err = uuc.urRepo.GetUserRecommendAncestors(ctx, req.SendBody.RecommendUserId)
 	if nil != err {
-		return res, nil
+		return nil, err
+	}
+	for _, v := range newAncestors {
+		if req.SendBody.UserId == v.AncestorId {
+			return nil, errors.New(500, "USER_MOVE_ERROR", "新推荐人是该用户的下级")
+		}
 	}
 
-	for _, v := range locations {
-		if _, ok := users[v.UserId]; !ok {
-			continue
+	descendants, err = uuc.urRepo.GetUserRecommendDescendants(ctx, req.SendBody.UserId, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	// 推荐码前缀替换
+	oldCode := userRecommend.RecommendCode
+	newCode := newRecommend.RecommendCode + "D" + strconv.FormatInt(req.SendBody.RecommendUserId, 10)
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
+	if nil != err {
+		return nil, err
+	}
+	descendantCodes = make(map[int64]string, 0)
+	for _, v := range descendants {
+		descendantCodes[v.DescendantId] = ""
+	}
+	for _, v := range userRecommends {
+		if _, ok := descendantCodes[v.UserId]; ok {
+			descendantCodes[v.UserId] = v.RecommendCode
 		}
+	}
+	descendantCodes[req.SendBody.UserId] = oldCode
 
-		res.Locations = append(res.Locations, &v1.AdminLocationListReply_LocationList{
-			CreatedAt:    v.CreatedAt.Format("2006-01-02 15:04:05"),
-			Address:      users[v.UserId].Address,
-			Row:          v.Row,
-			Col:          v.Col,
-			Status:       v.Status,
-			CurrentLevel: v.CurrentLevel,
-			Current:      fmt.Sprintf("%.2f", float64(v.Current)/float64(10000000000)),
-			CurrentMax:   fmt.Sprintf("%.2f", float64(v.CurrentMax)/float64(10000000000)),
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		for userId, code := range descendantCodes {
+			if !strings.HasPrefix(code, oldCode) {
+				return errors.New(500, "USER_MOVE_ERROR", "下级推荐码错误")
+			}
+
+			tmpCode := newCode + code[len(oldCode):]
+			err = uuc.urRepo.UpdateUserRecommendCode(ctx, userId, tmpCode)
+			if nil != err {
+				return err
+			}
+
+			err = uuc.urRepo.ResetUserRecommendTree(ctx, userId, recommendUpUserIds(tmpCode))
+			if nil != err {
+				return err
+			}
+		}
+
+		err = uuc.urRepo.MoveUserRecommendArea(ctx, oldCode+"D"+strconv.FormatInt(req.SendBody.UserId, 10), newCode+"D"+strconv.FormatInt(req.SendBody.UserId, 10), oldCode)
+		if nil != err {
+			return err
+		}
+
+		return uuc.urRepo.CreateUserMoveLog(ctx, &UserMoveLog{
+			UserId:             req.SendBody.UserId,
+			OldRecommendUserId: oldRecommendUserId,
+			NewRecommendUserId: req.SendBody.RecommendUserId,
+			OldRecommendCode:   oldCode,
+			NewRecommendCode:   newCode,
+			Num:                int64(len(descendantCodes)),
+			Reason:             req.SendBody.Reason,
 		})
+	}); nil != err {
+		return nil, err
 	}
 
-	return res, nil
+	// 团队统计重建
+	if _, err = uuc.rebuildUserTeamStats(ctx); nil != err {
+		return nil, err
+	}
+
+	// 原推荐人和新推荐人的直推人数和会员等级
+	if req.SendBody.Recompute {
+		for _, vUserId := range []int64{oldRecommendUserId, req.SendBody.RecommendUserId} {
+			if 0 >= vUserId {
+				continue
+			}
+			if err = uuc.recomputeRecommendVip(ctx, vUserId); nil != err {
+				return nil, err
+			}
+		}
+	}
 
+	return &v1.AdminUserMoveReply{
+		Count: int64(len(descendantCodes)),
+	}, nil
 }
 
-func (uuc *UserUseCase) AdminRecommendList(ctx context.Context, req *v1.AdminUserRecommendRequest) (*v1.AdminUserRecommendReply, error) {
+// recomputeRecommendVip 直推入单人数和会员等级按当前推荐关系重新计算，等级可以降
+func (uuc *UserUseCase) recomputeRecommendVip(ctx context.Context, userId int64) error {
 	var (
-		userRecommends []*UserRecommend
-		userRecommend  *UserRecommend
-		userIdsMap     map[int64]int64
-		userIds        []int64
-		users          map[int64]*User
-		err            error
+		userInfo      *UserInfo
+		children      []*UserRecommendTree
+		locations     []*Location
+		userTeamStats map[int64]*UserTeamStats
+		childIds      []int64
+		historyNum    int64
+		teamAmount    int64
+		areaAmount    int64
+		err           error
 	)
 
-	res := &v1.AdminUserRecommendReply{
-		Users: make([]*v1.AdminUserRecommendReply_List, 0),
+	userInfo, err = uuc.uiRepo.GetUserInfoByUserId(ctx, userId)
+	if nil != err {
+		return err
 	}
 
-	// 地址查询
-	if 0 < req.UserId {
-		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, req.UserId)
-		if nil == userRecommend {
-			return res, nil
-		}
-
-		userRecommends, err = uuc.urRepo.GetUserRecommendByCode(ctx, userRecommend.RecommendCode+"D"+strconv.FormatInt(userRecommend.UserId, 10))
-		if nil != err {
-			return res, nil
+	children, err = uuc.urRepo.GetUserRecommendDescendants(ctx, userId, 1)
+	if nil != err {
+		return err
+	}
+	for _, v := range children {
+		childIds = append(childIds, v.DescendantId)
+	}
+	if 0 < len(childIds) {
+		childLocation := make(map[int64]bool, 0)
+		locations, err = uuc.locationRepo.GetLocationByIds(ctx, childIds...)
+		for _, v := range locations {
+			childLocation[v.UserId] = true
 		}
+		historyNum = int64(len(childLocation))
 	}
 
-	userIdsMap = make(map[int64]int64, 0)
-	for _, vLocations := range userRecommends {
-		userIdsMap[vLocations.UserId] = vLocations.UserId
-	}
-	for _, v := range userIdsMap {
-		userIds = append(userIds, v)
+	userTeamStats, err = uuc.urRepo.GetUserTeamStatsByUserIds(ctx, userId)
+	if _, ok := userTeamStats[userId]; ok {
+		teamAmount = userTeamStats[userId].TeamAmount
+		areaAmount = userTeamStats[userId].AreaAmount
 	}
 
-	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
-	if nil != err {
-		return res, nil
+	var (
+		vip       int64
+		vipExpire time.Time
+	)
+	qualified := vipLevelQualified(getVipLevels(ctx, uuc.uiRepo, uuc.configRepo), historyNum, teamAmount, areaAmount)
+	if nil != qualified {
+		vip = qualified.Level
+		if 0 < qualified.ExpireDays {
+			vipExpire = time.Now().UTC().Add(8*time.Hour).AddDate(0, 0, int(qualified.ExpireDays))
+		}
 	}
 
-	for _, v := range userRecommends {
-		if _, ok := users[v.UserId]; !ok {
-			continue
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.uiRepo.UpdateUserInfoHistoryRecommend(ctx, userId, historyNum)
+		if nil != err {
+			return err
 		}
 
-		res.Users = append(res.Users, &v1.AdminUserRecommendReply_List{
-			Address:   users[v.UserId].Address,
-			Id:        v.ID,
-			UserId:    v.UserId,
-			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
-		})
-	}
+		err = uuc.uiRepo.UpdateUserInfoVip(ctx, userId, vip, vipExpire)
+		if nil != err {
+			return err
+		}
 
-	return res, nil
+		if vip != userInfo.Vip {
+			return uuc.uiRepo.CreateUserVipLog(ctx, &UserVipLog{
+				UserId:    userId,
+				BeforeVip: userInfo.Vip,
+				AfterVip:  vip,
+				Reason:    "move",
+			})
+		}
+
+		return nil
+	})
 }
 
 func (uuc *UserUseCase) AdminMonthRecommend(ctx context.Context, req *v1.AdminMonthRecommendRequest) (*v1.AdminMonthRecommendReply, error) {
@@ -1039,11 +3681,15 @@ func (uuc *UserUseCase) AdminMonthRecommend(ctx context.Context, req *v1.AdminMo
 	return res, nil
 }
 
-func (uuc *UserUseCase) AdminConfig(ctx context.Context, req *v1.AdminConfigRequest) (*v1.AdminConfigReply, error) {
+func (uuc *UserUseCase) AdminConfig(ctx context.Context, req *v1.AdminConfigRequest, admin *User) (*v1.AdminConfigReply, error) {
 	var (
 		configs []*Config
 	)
 
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
 	res := &v1.AdminConfigReply{
 		Config: make([]*v1.AdminConfigReply_List, 0),
 	}
@@ -1064,13 +3710,29 @@ func (uuc *UserUseCase) AdminConfig(ctx context.Context, req *v1.AdminConfigRequ
 	return res, nil
 }
 
-func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigUpdateRequest) (*v1.AdminConfigUpdateReply, error) {
+func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigUpdateRequest, admin *User) (*v1.AdminConfigUpdateReply, error) {
 	var (
-		err error
+		configs []*Config
+		err     error
 	)
 
 	res := &v1.AdminConfigUpdateReply{}
 
+	if !isAdminUser(ctx, uuc.configRepo, admin.ID) {
+		return nil, errors.New(500, "NOT_ADMIN", "没有权限")
+	}
+
+	// 管理员名单只能直接改库，不能通过接口给自己加权限
+	configs, err = uuc.configRepo.GetConfigs(ctx)
+	if nil != err {
+		return res, err
+	}
+	for _, v := range configs {
+		if req.SendBody.Id == v.ID && "admin_user_ids" == v.KeyName {
+			return nil, errors.New(500, "CONFIG_ERROR", "该配置不能修改")
+		}
+	}
+
 	_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
 	if nil != err {
 		return res, err
@@ -1087,8 +3749,24 @@ func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Wit
 	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
 }
 
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
+func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64, txHash string) (*Withdraw, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		withdraw, err = uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
+		if nil != err {
+			return err
+		}
+
+		return uuc.ubRepo.WithdrawPayout(ctx, id, txHash) // 打款记账
+	}); nil != err {
+		return nil, err
+	}
+
+	return withdraw, nil
 }
 
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
@@ -1194,6 +3872,10 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 	userCount = int64(len(userIds))
 	fee = fee / 100 / userCount
 
+	// 本次手续费分红引起的占位变动，按上月记
+	now := time.Now().UTC().Add(8 * time.Hour)
+	ctx = NewLocationTriggerContext(ctx, "fee", time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC).Format("2006-01"))
+
 	for _, v := range userIds {
 		// 获取当前用户的占位信息，已经有运行中的跳过
 		myLocationLast, err = uuc.locationRepo.GetMyLocationRunningLast(ctx, v)
@@ -1227,7 +3909,7 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 						rewardAmount = tmpCurrentAmount
 					}
 
-					_, err = uuc.ubRepo.UserFee(ctx, v, rewardAmount)
+					_, err = uuc.ubRepo.UserFee(ctx, v, rewardAmount, myLocationLast.ID)
 					if nil != err {
 						return err
 					}
@@ -1279,6 +3961,167 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 	}, nil
 }
 
+// AdminDailyMetrics 每日统计，默认统计昨天，重跑覆盖
+func (uuc *UserUseCase) AdminDailyMetrics(ctx context.Context, req *v1.AdminDailyMetricsRequest) (*v1.AdminDailyMetricsReply, error) {
+	var (
+		start   time.Time
+		metrics []*DailyMetrics
+		err     error
+	)
+
+	if "" == req.Day {
+		now := time.Now().UTC().AddDate(0, 0, -1)
+		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	} else {
+		start, err = time.Parse("2006-01-02", req.Day)
+		if nil != err {
+			return nil, errors.New(500, "DAILY_METRICS_ERROR", "日期格式错误")
+		}
+	}
+	end := start.AddDate(0, 0, 1)
+	day := start.Format("2006-01-02")
+
+	metrics, err = uuc.ubRepo.GetDailyMetricsStat(ctx, start, end)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.ubRepo.SaveDailyMetrics(ctx, day, metrics)
+	}); nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDailyMetricsReply{
+		Day:  day,
+		List: make([]*v1.AdminDailyMetricsReply_List, 0),
+	}
+	for _, v := range metrics {
+		res.List = append(res.List, &v1.AdminDailyMetricsReply_List{
+			Name:   v.Name,
+			Num:    v.Num,
+			Amount: fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+		})
+	}
+
+	return res, nil
+}
+
+// dailyMetricsSnapshot 存量指标，按周按月取区间最后一天，其余累加
+var dailyMetricsSnapshot = map[string]bool{
+	"fee_pool":         true,
+	"location_running": true,
+	"location_stop":    true,
+}
+
+// dailyMetricsPeriod 按粒度归到的区间，周从周一开始
+func dailyMetricsPeriod(day time.Time, granularity string) string {
+	if "week" == granularity {
+		weekday := int(day.Weekday())
+		if 0 == weekday {
+			weekday = 7
+		}
+		return day.AddDate(0, 0, 1-weekday).Format("2006-01-02")
+	} else if "month" == granularity {
+		return day.Format("2006-01")
+	}
+
+	return day.Format("2006-01-02")
+}
+
+// AdminDailyMetricsSeries 图表用的统计序列，默认最近30天按天，最多一年
+func (uuc *UserUseCase) AdminDailyMetricsSeries(ctx context.Context, req *v1.AdminDailyMetricsSeriesRequest) (*v1.AdminDailyMetricsSeriesReply, error) {
+	var (
+		start   time.Time
+		end     time.Time
+		metrics []*DailyMetrics
+		err     error
+	)
+
+	granularity := req.Granularity
+	if "" == granularity {
+		granularity = "day"
+	}
+	if "day" != granularity && "week" != granularity && "month" != granularity {
+		return nil, errors.New(500, "DAILY_METRICS_ERROR", "粒度错误")
+	}
+
+	if "" == req.End {
+		now := time.Now().UTC().AddDate(0, 0, -1)
+		end = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	} else {
+		end, err = time.Parse("2006-01-02", req.End)
+		if nil != err {
+			return nil, errors.New(500, "DAILY_METRICS_ERROR", "日期格式错误")
+		}
+	}
+	if "" == req.Start {
+		start = end.AddDate(0, 0, -29)
+	} else {
+		start, err = time.Parse("2006-01-02", req.Start)
+		if nil != err {
+			return nil, errors.New(500, "DAILY_METRICS_ERROR", "日期格式错误")
+		}
+	}
+	if start.After(end) {
+		return nil, errors.New(500, "DAILY_METRICS_ERROR", "开始日期不能晚于结束日期")
+	}
+	if start.AddDate(1, 0, 0).Before(end) {
+		return nil, errors.New(500, "DAILY_METRICS_ERROR", "最多查询一年")
+	}
+
+	metrics, err = uuc.ubRepo.GetDailyMetrics(ctx, start.Format("2006-01-02"), end.Format("2006-01-02"), req.Name)
+	if nil != err {
+		return nil, err
+	}
+
+	// 按区间和指标汇总，数据已按日期排好
+	periods := make([]string, 0)
+	periodNames := make(map[string][]string, 0)
+	periodMetrics := make(map[string]map[string]*DailyMetrics, 0)
+	for _, v := range metrics {
+		day, _ := time.Parse("2006-01-02", v.Day)
+		period := dailyMetricsPeriod(day, granularity)
+		if _, ok := periodMetrics[period]; !ok {
+			periods = append(periods, period)
+			periodMetrics[period] = make(map[string]*DailyMetrics, 0)
+		}
+		tmpMetrics, ok := periodMetrics[period][v.Name]
+		if !ok {
+			periodNames[period] = append(periodNames[period], v.Name)
+			periodMetrics[period][v.Name] = &DailyMetrics{Name: v.Name, Num: v.Num, Amount: v.Amount}
+			continue
+		}
+
+		if dailyMetricsSnapshot[v.Name] {
+			tmpMetrics.Num = v.Num
+			tmpMetrics.Amount = v.Amount
+		} else {
+			tmpMetrics.Num += v.Num
+			tmpMetrics.Amount += v.Amount
+		}
+	}
+
+	res := &v1.AdminDailyMetricsSeriesReply{
+		Start:       start.Format("2006-01-02"),
+		End:         end.Format("2006-01-02"),
+		Granularity: granularity,
+		List:        make([]*v1.AdminDailyMetricsSeriesReply_List, 0),
+	}
+	for _, period := range periods {
+		for _, name := range periodNames[period] {
+			res.List = append(res.List, &v1.AdminDailyMetricsSeriesReply_List{
+				Period: period,
+				Name:   name,
+				Num:    periodMetrics[period][name].Num,
+				Amount: fmt.Sprintf("%.2f", float64(periodMetrics[period][name].Amount)/float64(10000000000)),
+			})
+		}
+	}
+
+	return res, nil
+}
+
 func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
 	var (
 		currentValue                    int64
@@ -1292,37 +4135,36 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 		withdrawAmount                  int64
 		stopLocations                   []*Location
 		//lock                            bool
-		withdrawNotDeal   []*Withdraw
-		configs           []*Config
-		recommendNeed     int64
-		recommendNeedVip1 int64
-		recommendNeedVip2 int64
-		recommendNeedVip3 int64
-		recommendNeedVip4 int64
-		recommendNeedVip5 int64
-		err               error
+		withdrawNotDeal []*Withdraw
+		configs         []*Config
+		recommendNeed   int64
+		vipLevels       []*VipLevel
+		recommendLevels []*RecommendLevel
+		matrices        []*LocationMatrix
+		matrix          *LocationMatrix
+		err             error
 	)
+
+	ctx = withDhbReward(ctx, uuc.configRepo) // 分红折dhb
 	// 配置
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "recommend_need", "recommend_need_vip1", "recommend_need_vip2",
-		"recommend_need_vip3", "recommend_need_vip4", "recommend_need_vip5")
+	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "recommend_need")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "recommend_need" == vConfig.KeyName {
 				recommendNeed, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip1" == vConfig.KeyName {
-				recommendNeedVip1, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip2" == vConfig.KeyName {
-				recommendNeedVip2, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip3" == vConfig.KeyName {
-				recommendNeedVip3, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip4" == vConfig.KeyName {
-				recommendNeedVip4, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_need_vip5" == vConfig.KeyName {
-				recommendNeedVip5, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			}
 		}
 	}
 
+	// 会员等级
+	vipLevels = getVipLevels(ctx, uuc.uiRepo, uuc.configRepo)
+
+	// 多层级推荐
+	recommendLevels, _ = uuc.urRepo.GetRecommendLevels(ctx)
+
+	// 占位矩阵
+	matrices = getLocationMatrices(ctx, uuc.locationRepo)
+
 	time.Sleep(30 * time.Second) // 错开时间和充值
 
 	// todo 全局锁
@@ -1349,20 +4191,22 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 			continue
 		}
 
+		ctx := NewLocationTriggerContext(ctx, "withdraw", strconv.FormatInt(withdraw.ID, 10)) // 本次提现引起的占位变动
+
 		currentValue = withdraw.Amount
 
-		if "dhb" == withdraw.Type { // 提现dhb
-			//if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			//	_, err = uuc.ubRepo.UpdateWithdraw(ctx, withdraw.ID, "pass")
-			//	if nil != err {
-			//		return err
-			//	}
-			//
-			//	return nil
-			//}); nil != err {
-			//
-			//	return nil, err
-			//}
+		if "dhb" == withdraw.Type { // 提现dhb，不产生分红，直接通过走链上转账
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				_, err = uuc.ubRepo.UpdateWithdraw(ctx, withdraw.ID, "pass")
+				if nil != err {
+					return err
+				}
+
+				return nil
+			}); nil != err {
+
+				return nil, err
+			}
 
 			continue
 		}
@@ -1374,7 +4218,11 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 			for _, vStopLocations := range stopLocations {
 
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = uuc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
+					err = uuc.locationRepo.LockLocationMatrix(ctx, vStopLocations.MatrixId) // 和入单排队
+					if nil != err {
+						return err
+					}
+					err = uuc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID, locationMatrixById(matrices, vStopLocations.MatrixId))
 					if nil != err {
 						return err
 					}
@@ -1390,8 +4238,9 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 		if nil == myLocationLast { // 无占位信息
 			return nil, err
 		}
-		// 占位分红人
-		rewardLocations, err = uuc.locationRepo.GetRewardLocationByRowOrCol(ctx, myLocationLast.Row, myLocationLast.Col)
+		// 占位分红人，同一矩阵内
+		matrix = locationMatrixById(matrices, myLocationLast.MatrixId)
+		rewardLocations, err = uuc.locationRepo.GetRewardLocationByRowOrCol(ctx, matrix, myLocationLast.Row, myLocationLast.Col)
 
 		// 推荐人
 		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, withdraw.UserId)
@@ -1433,10 +4282,10 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 					var locationType string
 					var tmpAmount int64
 					if myLocationLast.Row == vRewardLocations.Row { // 同行的人
-						tmpAmount = currentValue / 100 * 5
+						tmpAmount = currentValue / 100 * matrix.RowRate
 						locationType = "row"
 					} else if myLocationLast.Col == vRewardLocations.Col { // 同列的人
-						tmpAmount = currentValue / 100
+						tmpAmount = currentValue / 100 * matrix.ColRate
 						locationType = "col"
 					} else {
 						continue
@@ -1511,7 +4360,7 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 						if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
 							rewardAmount = tmpCurrentAmount
 						}
-						_, err = uuc.ubRepo.NormalWithdrawRecommendReward(ctx, myUserRecommendUserId, rewardAmount, myLocationLast.ID) // 直推人奖励
+						_, err = uuc.ubRepo.NormalWithdrawRecommendReward(ctx, myUserRecommendUserId, rewardAmount, myLocationLast.ID, myUserRecommendUserLocationLast.ID) // 直推人奖励
 						if nil != err {
 							return err
 						}
@@ -1520,18 +4369,8 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 				}
 
 				if nil != myUserRecommendUserLocationLast {
-					var tmpMyRecommendAmount int64
-					if 5 == myUserRecommendUserInfo.Vip { // 会员等级分红
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip5
-					} else if 4 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip4
-					} else if 3 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip3
-					} else if 2 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip2
-					} else if 1 == myUserRecommendUserInfo.Vip {
-						tmpMyRecommendAmount = currentValue / 100 * recommendNeedVip1
-					}
+					// 会员等级分红
+					tmpMyRecommendAmount := currentValue / 100 * vipLevelRate(vipLevels, myUserRecommendUserInfo.Vip)
 					if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
 						tmpStatus := myUserRecommendUserLocationLast.Status // 现在还在运行中
 						tmpCurrent := myUserRecommendUserLocationLast.Current
@@ -1558,7 +4397,7 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 							if tmpCurrentAmount < tmpBalanceAmount { // 大于最大可分红额度
 								rewardAmount = tmpCurrentAmount
 							}
-							_, err = uuc.ubRepo.RecommendWithdrawReward(ctx, myUserRecommendUserId, rewardAmount, myLocationLast.ID) // 推荐人奖励
+							_, err = uuc.ubRepo.RecommendWithdrawReward(ctx, myUserRecommendUserId, rewardAmount, myLocationLast.ID, myUserRecommendUserLocationLast.ID) // 推荐人奖励
 							if nil != err {
 								return err
 							}
@@ -1568,6 +4407,17 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 				}
 			}
 
+			// 直推人以上的多层级推荐分红
+			var tmpLevelAmount int64
+			tmpLevelAmount, err = rewardRecommendLevels(ctx, uuc.locationRepo, uuc.ubRepo, uuc.uiRepo, recommendLevels, recommendUpUserIds(userRecommend.RecommendCode), currentValue, myLocationLast.ID, "withdraw")
+			if nil != err {
+				return err
+			}
+			systemAmount -= tmpLevelAmount
+			if 0 > systemAmount { // 分红比例合计超过重新分配的金额
+				return errors.New(500, "REWARD_RATE_ERROR", "分红比例配置错误")
+			}
+
 			err = uuc.ubRepo.SystemWithdrawReward(ctx, systemAmount, myLocationLast.ID)
 			if nil != err {
 				return err
@@ -1590,7 +4440,11 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 			for _, vStopLocations := range stopLocations {
 
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = uuc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID)
+					err = uuc.locationRepo.LockLocationMatrix(ctx, vStopLocations.MatrixId) // 和入单排队
+					if nil != err {
+						return err
+					}
+					err = uuc.locationRepo.UpdateLocationRowAndCol(ctx, vStopLocations.ID, locationMatrixById(matrices, vStopLocations.MatrixId))
 					if nil != err {
 						return err
 					}
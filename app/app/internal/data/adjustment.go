@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+type BalanceAdjustment struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	UserId          int64     `gorm:"type:int;not null;index"`
+	Direction       string    `gorm:"type:varchar(45);not null"`
+	CoinType        string    `gorm:"type:varchar(45);not null"`
+	Amount          int64     `gorm:"type:bigint;not null"`
+	Reason          string    `gorm:"type:varchar(255);not null"`
+	Ticket          string    `gorm:"type:varchar(100);not null"`
+	Status          string    `gorm:"type:varchar(45);not null"`
+	CreatedBy       int64     `gorm:"type:int;not null"`
+	ApprovedBy      int64     `gorm:"type:int;not null"`
+	BalanceRecordId int64     `gorm:"type:int;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+// CreateBalanceAdjustment .
+func (ub *UserBalanceRepo) CreateBalanceAdjustment(ctx context.Context, a *biz.BalanceAdjustment) (*biz.BalanceAdjustment, error) {
+	balanceAdjustment := &BalanceAdjustment{
+		UserId:    a.UserId,
+		Direction: a.Direction,
+		CoinType:  a.CoinType,
+		Amount:    a.Amount,
+		Reason:    a.Reason,
+		Ticket:    a.Ticket,
+		Status:    "pending",
+		CreatedBy: a.CreatedBy,
+	}
+	if err := ub.data.DB(ctx).Table("balance_adjustment").Create(balanceAdjustment).Error; err != nil {
+		return nil, errors.New(500, "CREATE_BALANCE_ADJUSTMENT_ERROR", "余额调整创建失败")
+	}
+
+	return balanceAdjustmentToBiz(balanceAdjustment), nil
+}
+
+// GetBalanceAdjustmentById .
+func (ub *UserBalanceRepo) GetBalanceAdjustmentById(ctx context.Context, id int64) (*biz.BalanceAdjustment, error) {
+	var balanceAdjustment BalanceAdjustment
+	if err := ub.data.DB(ctx).Table("balance_adjustment").Where("id=?", id).First(&balanceAdjustment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("BALANCE_ADJUSTMENT_NOT_FOUND", "balance adjustment not found")
+		}
+
+		return nil, errors.New(500, "BALANCE ADJUSTMENT ERROR", err.Error())
+	}
+
+	return balanceAdjustmentToBiz(&balanceAdjustment), nil
+}
+
+// GetBalanceAdjustments .
+func (ub *UserBalanceRepo) GetBalanceAdjustments(ctx context.Context, b *biz.Pagination, status string) ([]*biz.BalanceAdjustment, error, int64) {
+	var (
+		count              int64
+		balanceAdjustments []*BalanceAdjustment
+	)
+	res := make([]*biz.BalanceAdjustment, 0)
+
+	instance := ub.data.db.Table("balance_adjustment")
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&balanceAdjustments).Error; err != nil {
+		return res, errors.New(500, "BALANCE ADJUSTMENT ERROR", err.Error()), 0
+	}
+
+	for _, v := range balanceAdjustments {
+		res = append(res, balanceAdjustmentToBiz(v))
+	}
+
+	return res, nil, count
+}
+
+// UpdateBalanceAdjustmentStatus 事务中使用，只能从pending改 .
+func (ub *UserBalanceRepo) UpdateBalanceAdjustmentStatus(ctx context.Context, id int64, status string, approvedBy int64, balanceRecordId int64) error {
+	res := ub.data.DB(ctx).Table("balance_adjustment").
+		Where("id=? and status=?", id, "pending").
+		Updates(map[string]interface{}{"status": status, "approved_by": approvedBy, "balance_record_id": balanceRecordId})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_BALANCE_ADJUSTMENT_ERROR", "余额调整已处理")
+	}
+
+	return nil
+}
+
+// AdjustBalance 事务中使用，amount为负时扣款，余额不能扣成负数 .
+func (ub *UserBalanceRepo) AdjustBalance(ctx context.Context, userId int64, coinType string, amount int64, adjustmentId int64) (int64, error) {
+	var (
+		column = "balance_usdt"
+		err    error
+	)
+	if "dhb" == coinType {
+		column = "balance_dhb"
+	}
+
+	var userBalance *UserBalance
+	if userBalance, err = updateUserBalance(ctx, ub.data, userId, column, amount, true); nil != err {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "adjust"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Source = "admin"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "adjust", adjustmentId, userBalanceRecode.ID, coinType, ledgerSystem(ledgerAccountAdjustment), ledgerUser(userId), amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+func balanceAdjustmentToBiz(v *BalanceAdjustment) *biz.BalanceAdjustment {
+	return &biz.BalanceAdjustment{
+		ID:              v.ID,
+		UserId:          v.UserId,
+		Direction:       v.Direction,
+		CoinType:        v.CoinType,
+		Amount:          v.Amount,
+		Reason:          v.Reason,
+		Ticket:          v.Ticket,
+		Status:          v.Status,
+		CreatedBy:       v.CreatedBy,
+		ApprovedBy:      v.ApprovedBy,
+		BalanceRecordId: v.BalanceRecordId,
+		CreatedAt:       v.CreatedAt,
+	}
+}
@@ -31,6 +31,9 @@ type contextTxKey struct{}
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
 	}
 	return &Data{
 		db:  db,
@@ -97,9 +100,6 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
 
@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+type DhbConvert struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	UserId     int64     `gorm:"type:int;not null;index"`
+	FromCoin   string    `gorm:"type:varchar(45);not null"`
+	FromAmount int64     `gorm:"type:bigint;not null"`
+	ToCoin     string    `gorm:"type:varchar(45);not null"`
+	ToAmount   int64     `gorm:"type:bigint;not null"`
+	Price      int64     `gorm:"type:bigint;not null"`
+	Spread     int64     `gorm:"type:int;not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+// rewardDhbSplit 按上下文里的比例和价格拆分分红，返回发usdt的部分和折算的dhb数量
+func rewardDhbSplit(ctx context.Context, amount int64) (int64, int64) {
+	dhbReward := biz.DhbRewardFromContext(ctx)
+	if 0 >= dhbReward.Rate || 0 >= dhbReward.Price || 0 >= amount {
+		return amount, 0
+	}
+
+	dhbValue := amount / 100 * dhbReward.Rate
+	dhbAmount := biz.DhbAmountByUsdt(dhbValue, dhbReward.Price)
+	if 0 >= dhbAmount {
+		return amount, 0
+	}
+
+	return amount - dhbValue, dhbAmount
+}
+
+// rewardDhb 事务中使用，分红折dhb的部分，usdt留在兑换账户，dhb从兑换账户发给用户
+func rewardDhb(ctx context.Context, d *Data, userId int64, value int64, dhbAmount int64, eventType string, eventId int64) error {
+	if 0 >= dhbAmount {
+		return nil
+	}
+
+	userBalance, err := updateUserBalance(ctx, d, userId, "balance_dhb", dhbAmount, false)
+	if nil != err {
+		return err
+	}
+
+	userBalanceRecode := &UserBalanceRecord{
+		UserId:   userBalance.UserId,
+		Balance:  userBalance.BalanceDhb,
+		Amount:   dhbAmount,
+		Type:     "reward",
+		CoinType: "dhb",
+	}
+	if err = d.DB(ctx).Table("user_balance_record").Create(userBalanceRecode).Error; err != nil {
+		return err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, d, eventType, eventId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountConvert), value)
+	if err != nil {
+		return err
+	}
+
+	return ledgerTransfer(ctx, d, eventType, eventId, userBalanceRecode.ID, "dhb", ledgerSystem(ledgerAccountConvert), ledgerUser(userId), dhbAmount)
+}
+
+// Convert 事务中使用，usdt和dhb互换，两条余额记录，转出的金额记负数 .
+func (ub *UserBalanceRepo) Convert(ctx context.Context, c *biz.DhbConvert) (*biz.DhbConvert, error) {
+	var (
+		fromBalance *UserBalance
+		toBalance   *UserBalance
+		err         error
+	)
+	fromColumn, toColumn := "balance_usdt", "balance_dhb"
+	if "dhb" == c.FromCoin {
+		fromColumn, toColumn = "balance_dhb", "balance_usdt"
+	}
+
+	fromBalance, err = updateUserBalance(ctx, ub.data, c.UserId, fromColumn, -c.FromAmount, true)
+	if nil != err {
+		return nil, err
+	}
+	toBalance, err = updateUserBalance(ctx, ub.data, c.UserId, toColumn, c.ToAmount, false)
+	if nil != err {
+		return nil, err
+	}
+
+	fromRecord := &UserBalanceRecord{
+		UserId:   c.UserId,
+		Balance:  fromBalance.BalanceUsdt,
+		Amount:   -c.FromAmount,
+		Type:     "convert",
+		CoinType: c.FromCoin,
+		Source:   "out",
+	}
+	toRecord := &UserBalanceRecord{
+		UserId:   c.UserId,
+		Balance:  toBalance.BalanceDhb,
+		Amount:   c.ToAmount,
+		Type:     "convert",
+		CoinType: c.ToCoin,
+		Source:   "in",
+	}
+	if "dhb" == c.FromCoin {
+		fromRecord.Balance = fromBalance.BalanceDhb
+		toRecord.Balance = toBalance.BalanceUsdt
+	}
+	if err = ub.data.DB(ctx).Table("user_balance_record").Create(fromRecord).Error; err != nil {
+		return nil, err
+	}
+	if err = ub.data.DB(ctx).Table("user_balance_record").Create(toRecord).Error; err != nil {
+		return nil, err
+	}
+
+	dhbConvert := &DhbConvert{
+		UserId:     c.UserId,
+		FromCoin:   c.FromCoin,
+		FromAmount: c.FromAmount,
+		ToCoin:     c.ToCoin,
+		ToAmount:   c.ToAmount,
+		Price:      c.Price,
+		Spread:     c.Spread,
+	}
+	if err = ub.data.DB(ctx).Table("dhb_convert").Create(dhbConvert).Error; err != nil {
+		return nil, errors.New(500, "CREATE_DHB_CONVERT_ERROR", "兑换记录创建失败")
+	}
+
+	// 记账，兑换账户和用户对换
+	err = ledgerTransfer(ctx, ub.data, "convert", dhbConvert.ID, fromRecord.ID, c.FromCoin, ledgerUser(c.UserId), ledgerSystem(ledgerAccountConvert), c.FromAmount)
+	if err != nil {
+		return nil, err
+	}
+	err = ledgerTransfer(ctx, ub.data, "convert", dhbConvert.ID, toRecord.ID, c.ToCoin, ledgerSystem(ledgerAccountConvert), ledgerUser(c.UserId), c.ToAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.DhbConvert{
+		ID:         dhbConvert.ID,
+		UserId:     dhbConvert.UserId,
+		FromCoin:   dhbConvert.FromCoin,
+		FromAmount: dhbConvert.FromAmount,
+		ToCoin:     dhbConvert.ToCoin,
+		ToAmount:   dhbConvert.ToAmount,
+		Price:      dhbConvert.Price,
+		Spread:     dhbConvert.Spread,
+		CreatedAt:  dhbConvert.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// 账户，用户账户按user_id区分，系统账户user_id为0
+const (
+	ledgerAccountUser       = "user"       // 用户余额
+	ledgerAccountRevenue    = "revenue"    // 系统收入
+	ledgerAccountFeePool    = "fee_pool"   // 手续费池，按月分给用户
+	ledgerAccountSuspense   = "suspense"   // 挂账，入单本金和提现在途都在这里
+	ledgerAccountHotWallet  = "hot_wallet" // 链上热钱包
+	ledgerAccountAdjustment = "adjustment" // 后台人工调整
+	ledgerAccountConvert    = "convert"    // usdt和dhb兑换，分红折dhb也从这里出
+)
+
+type LedgerEntry struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	EventType string    `gorm:"type:varchar(45);not null;index:idx_event"`
+	EventId   int64     `gorm:"type:int;not null;index:idx_event"`
+	RecordId  int64     `gorm:"type:int;not null"`
+	UserId    int64     `gorm:"type:int;not null;index"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerPosting struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	EntryId   int64     `gorm:"type:int;not null;index"`
+	Account   string    `gorm:"type:varchar(45);not null;index:idx_account"`
+	UserId    int64     `gorm:"type:int;not null;index:idx_account"`
+	CoinType  string    `gorm:"type:varchar(45);not null;index:idx_account"`
+	Debit     int64     `gorm:"type:bigint;not null"`
+	Credit    int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type ledgerAccount struct {
+	name   string
+	userId int64
+}
+
+func ledgerUser(userId int64) ledgerAccount {
+	return ledgerAccount{name: ledgerAccountUser, userId: userId}
+}
+
+func ledgerSystem(name string) ledgerAccount {
+	return ledgerAccount{name: name}
+}
+
+// postLedger 事务中使用，一个业务事件一笔分录，每个币种借贷必须相等
+func postLedger(ctx context.Context, d *Data, entry *LedgerEntry, postings []*LedgerPosting) error {
+	sum := make(map[string]int64, 0)
+	for _, v := range postings {
+		sum[v.CoinType] += v.Debit - v.Credit
+	}
+	for _, v := range sum {
+		if 0 != v {
+			return errors.New(500, "LEDGER_NOT_BALANCED", "分录借贷不平")
+		}
+	}
+
+	if err := d.DB(ctx).Table("ledger_entry").Create(entry).Error; err != nil {
+		return errors.New(500, "CREATE_LEDGER_ERROR", "分录创建失败")
+	}
+	for _, v := range postings {
+		v.EntryId = entry.ID
+	}
+	if err := d.DB(ctx).Table("ledger_posting").Create(&postings).Error; err != nil {
+		return errors.New(500, "CREATE_LEDGER_ERROR", "分录创建失败")
+	}
+
+	return nil
+}
+
+// ledgerTransfer 事务中使用，debit借记credit贷记，金额为负时方向反过来
+func ledgerTransfer(ctx context.Context, d *Data, eventType string, eventId int64, recordId int64, coinType string, debit ledgerAccount, credit ledgerAccount, amount int64) error {
+	if 0 == amount {
+		return nil
+	}
+	if 0 > amount {
+		debit, credit, amount = credit, debit, -amount
+	}
+
+	entry := &LedgerEntry{
+		EventType: eventType,
+		EventId:   eventId,
+		RecordId:  recordId,
+		UserId:    debit.userId,
+	}
+	if 0 < credit.userId {
+		entry.UserId = credit.userId
+	}
+
+	return postLedger(ctx, d, entry, []*LedgerPosting{
+		{Account: debit.name, UserId: debit.userId, CoinType: coinType, Debit: amount},
+		{Account: credit.name, UserId: credit.userId, CoinType: coinType, Credit: amount},
+	})
+}
+
+// GetLedgerBalances 各账户余额，贷方减借方 .
+func (ub *UserBalanceRepo) GetLedgerBalances(ctx context.Context) ([]*biz.LedgerBalance, error) {
+	var ledgerBalances []*biz.LedgerBalance
+	if err := ub.data.db.Table("ledger_posting").
+		Select("account, user_id, coin_type, sum(credit) - sum(debit) as balance").
+		Group("account, user_id, coin_type").
+		Scan(&ledgerBalances).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	return ledgerBalances, nil
+}
+
+// GetUserBalances .
+func (ub *UserBalanceRepo) GetUserBalances(ctx context.Context) ([]*biz.UserBalance, error) {
+	var userBalances []*UserBalance
+	res := make([]*biz.UserBalance, 0)
+	if err := ub.data.db.Table("user_balance").Find(&userBalances).Error; err != nil {
+		return res, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	for _, v := range userBalances {
+		res = append(res, &biz.UserBalance{
+			ID:          v.ID,
+			UserId:      v.UserId,
+			BalanceUsdt: v.BalanceUsdt,
+			BalanceDhb:  v.BalanceDhb,
+		})
+	}
+
+	return res, nil
+}
+
+// LedgerOpening 事务中使用，上线账本前的余额记为期初 .
+func (ub *UserBalanceRepo) LedgerOpening(ctx context.Context, userId int64, coinType string, amount int64) error {
+	return ledgerTransfer(ctx, ub.data, "opening", userId, 0, coinType, ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), amount)
+}
+
+// ResetUserBalance 事务中使用，按账本重置余额缓存，先锁余额再读账本，差额走余额修改 .
+func (ub *UserBalanceRepo) ResetUserBalance(ctx context.Context, userId int64) error {
+	var (
+		userBalance    UserBalance
+		ledgerBalances []*biz.LedgerBalance
+	)
+	if err := ub.data.DB(ctx).Table("user_balance").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).First(&userBalance).Error; err != nil {
+		return errors.NotFound("user balance err", "user balance not found")
+	}
+
+	if err := ub.data.DB(ctx).Table("ledger_posting").
+		Select("account, user_id, coin_type, sum(credit) - sum(debit) as balance").
+		Where("account=? and user_id=?", ledgerAccountUser, userId).
+		Group("account, user_id, coin_type").
+		Scan(&ledgerBalances).Error; err != nil {
+		return errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	var ledgerUsdt, ledgerDhb int64
+	for _, v := range ledgerBalances {
+		if "dhb" == v.CoinType {
+			ledgerDhb = v.Balance
+		} else {
+			ledgerUsdt = v.Balance
+		}
+	}
+
+	if ledgerUsdt != userBalance.BalanceUsdt {
+		if _, err := updateUserBalance(ctx, ub.data, userId, "balance_usdt", ledgerUsdt-userBalance.BalanceUsdt, false); nil != err {
+			return err
+		}
+	}
+	if ledgerDhb != userBalance.BalanceDhb {
+		if _, err := updateUserBalance(ctx, ub.data, userId, "balance_dhb", ledgerDhb-userBalance.BalanceDhb, false); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WithdrawPayout 事务中使用，提现链上打款成功 .
+func (ub *UserBalanceRepo) WithdrawPayout(ctx context.Context, id int64, txHash string) error {
+	var withdraw Withdraw
+	if err := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).First(&withdraw).Error; err != nil {
+		return errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	if err := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).
+		Updates(map[string]interface{}{"tx_hash": txHash}).Error; err != nil {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return ledgerTransfer(ctx, ub.data, "withdraw_payout", withdraw.ID, withdraw.BalanceRecordId, withdraw.Type, ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountHotWallet), withdraw.Amount)
+}
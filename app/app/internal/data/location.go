@@ -5,13 +5,18 @@ import (
 	"dhb/app/app/internal/biz"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
 	"time"
 )
 
 type Location struct {
 	ID           int64     `gorm:"primarykey;type:int"`
 	UserId       int64     `gorm:"type:int;not null"`
+	MatrixId     int64     `gorm:"type:int;not null;default:1"`
+	Source       string    `gorm:"type:varchar(45);not null"`
 	Row          int64     `gorm:"type:int;not null"`
 	Col          int64     `gorm:"type:int;not null"`
 	Status       string    `gorm:"type:varchar(45);not null"`
@@ -19,10 +24,51 @@ type Location struct {
 	Current      int64     `gorm:"type:bigint;not null"`
 	CurrentMax   int64     `gorm:"type:bigint;not null"`
 	StopDate     time.Time `gorm:"type:datetime;not null"`
+	StopIsUpdate int64     `gorm:"type:int;not null;default:0"`
 	CreatedAt    time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
 }
 
+type LocationMatrix struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(45);not null"`
+	LevelMin  int64     `gorm:"type:int;not null"`
+	LevelMax  int64     `gorm:"type:int;not null"`
+	ColMax    int64     `gorm:"type:int;not null"`
+	RowRange  int64     `gorm:"type:int;not null"`
+	RowRate   int64     `gorm:"type:int;not null"`
+	ColRate   int64     `gorm:"type:int;not null"`
+	Status    string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LocationEvent struct {
+	ID             int64     `gorm:"primarykey;type:int"`
+	LocationId     int64     `gorm:"type:int;not null"`
+	UserId         int64     `gorm:"type:int;not null"`
+	MatrixId       int64     `gorm:"type:int;not null"`
+	Type           string    `gorm:"type:varchar(45);not null"`
+	BeforeStatus   string    `gorm:"type:varchar(45);not null"`
+	AfterStatus    string    `gorm:"type:varchar(45);not null"`
+	BeforeCurrent  int64     `gorm:"type:bigint;not null"`
+	AfterCurrent   int64     `gorm:"type:bigint;not null"`
+	BeforeLevel    int64     `gorm:"type:int;not null"`
+	AfterLevel     int64     `gorm:"type:int;not null"`
+	BeforeMax      int64     `gorm:"type:bigint;not null"`
+	AfterMax       int64     `gorm:"type:bigint;not null"`
+	BeforeRow      int64     `gorm:"type:int;not null"`
+	AfterRow       int64     `gorm:"type:int;not null"`
+	BeforeCol      int64     `gorm:"type:int;not null"`
+	AfterCol       int64     `gorm:"type:int;not null"`
+	BeforeStopDate time.Time `gorm:"type:datetime;not null"`
+	AfterStopDate  time.Time `gorm:"type:datetime;not null"`
+	TriggerType    string    `gorm:"type:varchar(45);not null"`
+	TriggerRef     string    `gorm:"type:varchar(100);not null"`
+	CorrelationId  string    `gorm:"type:varchar(100);not null"`
+	CreatedAt      time.Time `gorm:"type:datetime;not null"`
+}
+
 type GlobalLock struct {
 	ID     int64 `gorm:"primarykey;type:int"`
 	Status int64 `gorm:"type:int;not null"`
@@ -50,14 +96,22 @@ func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (
 	location.CurrentMax = rel.CurrentMax
 	location.CurrentLevel = rel.CurrentLevel
 	location.UserId = rel.UserId
+	location.MatrixId = rel.MatrixId
+	location.Source = biz.LocationTriggerFromContext(ctx).Type
 	res := lr.data.DB(ctx).Table("location").Create(&location)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "占位信息创建失败")
 	}
 
+	if err := lr.createLocationEvents(ctx, newLocationEvent(ctx, "create", &Location{}, &location)); nil != err {
+		return nil, err
+	}
+
 	return &biz.Location{
 		ID:           location.ID,
 		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
 		Status:       location.Status,
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
@@ -67,10 +121,25 @@ func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (
 	}, nil
 }
 
+// LockLocationMatrix 事务中使用，锁住矩阵，同一矩阵的占位和紧缩排队执行 .
+func (lr *LocationRepo) LockLocationMatrix(ctx context.Context, matrixId int64) error {
+	var matrix LocationMatrix
+	if err := lr.data.DB(ctx).Table("location_matrix").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=?", matrixId).First(&matrix).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("MATRIX_NOT_FOUND", "matrix not found")
+		}
+
+		return errors.New(500, "LOCATION MATRIX ERROR", err.Error())
+	}
+
+	return nil
+}
+
 // GetLocationLast .
-func (lr *LocationRepo) GetLocationLast(ctx context.Context) (*biz.Location, error) {
+func (lr *LocationRepo) GetLocationLast(ctx context.Context, matrixId int64) (*biz.Location, error) {
 	var location Location
-	if err := lr.data.db.Table("location").Order("id desc").First(&location).Error; err != nil {
+	if err := lr.data.DB(ctx).Table("location").Where("matrix_id=?", matrixId).Order("id desc").First(&location).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
 		}
@@ -81,6 +150,8 @@ func (lr *LocationRepo) GetLocationLast(ctx context.Context) (*biz.Location, err
 	return &biz.Location{
 		ID:           location.ID,
 		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
 		Status:       location.Status,
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
@@ -104,6 +175,8 @@ func (lr *LocationRepo) GetMyLocationLast(ctx context.Context, userId int64) (*b
 	return &biz.Location{
 		ID:           location.ID,
 		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
 		Status:       location.Status,
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
@@ -130,6 +203,8 @@ func (lr *LocationRepo) GetMyStopLocationLast(ctx context.Context, userId int64)
 	return &biz.Location{
 		ID:           location.ID,
 		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
 		Status:       location.Status,
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
@@ -156,6 +231,36 @@ func (lr *LocationRepo) GetMyLocationRunningLast(ctx context.Context, userId int
 	return &biz.Location{
 		ID:           location.ID,
 		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
+		Status:       location.Status,
+		CurrentLevel: location.CurrentLevel,
+		Current:      location.Current,
+		CurrentMax:   location.CurrentMax,
+		Row:          location.Row,
+		Col:          location.Col,
+	}, nil
+}
+
+// LockMyLocationRunningLast 事务中使用，读到本事务里已经加上的分红并锁住到事务结束 .
+func (lr *LocationRepo) LockMyLocationRunningLast(ctx context.Context, userId int64) (*biz.Location, error) {
+	var location Location
+	if err := lr.data.DB(ctx).Table("location").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id", userId).
+		Where("status=?", "running").
+		Order("id desc").First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.Location{
+		ID:           location.ID,
+		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
 		Status:       location.Status,
 		CurrentLevel: location.CurrentLevel,
 		Current:      location.Current,
@@ -183,12 +288,16 @@ func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64)
 		res = append(res, &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
 			CurrentMax:   location.CurrentMax,
 			Row:          location.Row,
 			Col:          location.Col,
+			StopDate:     location.StopDate,
+			CreatedAt:    location.CreatedAt,
 		})
 	}
 
@@ -214,6 +323,8 @@ func (lr *LocationRepo) GetLocationsStopNotUpdate(ctx context.Context) ([]*biz.L
 		res = append(res, &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
@@ -297,7 +408,7 @@ func (lr *LocationRepo) UnLockGlobalWithdraw(ctx context.Context) (bool, error)
 // GetLocationByIds .
 func (lr *LocationRepo) GetLocationByIds(ctx context.Context, userIds ...int64) ([]*biz.Location, error) {
 	var locations []*Location
-	if err := lr.data.db.Table("location").
+	if err := lr.data.DB(ctx).Table("location").
 		Where("user_id IN (?)", userIds).
 		Find(&locations).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -312,6 +423,8 @@ func (lr *LocationRepo) GetLocationByIds(ctx context.Context, userIds ...int64)
 		res = append(res, &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
@@ -324,76 +437,290 @@ func (lr *LocationRepo) GetLocationByIds(ctx context.Context, userIds ...int64)
 	return res, nil
 }
 
-// UpdateLocation .
+// UpdateLocation 事务中使用 .
 func (lr *LocationRepo) UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error {
+	var (
+		before Location
+		after  Location
+		res    *gorm.DB
+	)
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
 
+	after = before
+	after.Current += current
+	after.Status = status
 	if "stop" == status {
-		res := lr.data.db.Table("location").
+		after.StopDate = stopDate
+		res = lr.data.DB(ctx).Table("location").
 			Where("id=?", id).
 			Updates(map[string]interface{}{"current": gorm.Expr("current + ?", current), "status": "stop", "stop_date": stopDate})
-		if 0 == res.RowsAffected || res.Error != nil {
-			return res.Error
-		}
 	} else {
-		res := lr.data.db.Table("location").
+		res = lr.data.DB(ctx).Table("location").
 			Where("id=?", id).
 			Where("status=?", "running").
 			Updates(map[string]interface{}{"current": gorm.Expr("current + ?", current), "status": status})
-		if 0 == res.RowsAffected || res.Error != nil {
-			return res.Error
+	}
+	if 0 == res.RowsAffected || res.Error != nil {
+		return res.Error
+	}
+
+	eventType := "credit"
+	if "running" == before.Status && "stop" == after.Status {
+		eventType = "stop"
+	}
+
+	return lr.createLocationEvents(ctx, newLocationEvent(ctx, eventType, &before, &after))
+}
+
+// UpgradeLocation 事务中使用 .
+func (lr *LocationRepo) UpgradeLocation(ctx context.Context, id int64, currentLevel int64, currentMax int64) error {
+	var before Location
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
 		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
 	}
 
-	return nil
+	res := lr.data.DB(ctx).Table("location").
+		Where("id=?", id).
+		Where("status=?", "running").
+		Where("current_level<?", currentLevel).
+		Updates(map[string]interface{}{"current_level": currentLevel, "current_max": currentMax})
+	if res.Error != nil {
+		return res.Error
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPGRADE_LOCATION_ERROR", "占位升级失败")
+	}
+
+	after := before
+	after.CurrentLevel = currentLevel
+	after.CurrentMax = currentMax
+	return lr.createLocationEvents(ctx, newLocationEvent(ctx, "upgrade", &before, &after))
 }
 
-// UpdateLocationRowAndCol 事务中使用 .
-func (lr *LocationRepo) UpdateLocationRowAndCol(ctx context.Context, id int64) error {
+// ExitLocation 事务中使用 .
+func (lr *LocationRepo) ExitLocation(ctx context.Context, id int64, stopDate time.Time) error {
+	var before Location
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	res := lr.data.DB(ctx).Table("location").
+		Where("id=?", id).
+		Where("status=?", "running").
+		Updates(map[string]interface{}{"status": "stop", "stop_date": stopDate})
+	if res.Error != nil {
+		return res.Error
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "EXIT_LOCATION_ERROR", "占位退出失败")
+	}
+
+	after := before
+	after.Status = "stop"
+	after.StopDate = stopDate
+	return lr.createLocationEvents(ctx, newLocationEvent(ctx, "exit", &before, &after))
+}
+
+// MoveLocation 事务中使用，跨档位升级或冲正恢复时移到矩阵的新位置，原占位不再分红也不参与复投 .
+func (lr *LocationRepo) MoveLocation(ctx context.Context, id int64, stopDate time.Time) error {
+	var before Location
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	updates := map[string]interface{}{"status": "moved"}
+	after := before
+	after.Status = "moved"
+	if "running" == before.Status { // 已出局的保留原出局时间
+		updates["stop_date"] = stopDate
+		after.StopDate = stopDate
+	}
+	res := lr.data.DB(ctx).Table("location").
+		Where("id=?", id).
+		Where("status IN (?)", []string{"running", "stop"}).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "MOVE_LOCATION_ERROR", "占位移动失败")
+	}
+
+	return lr.createLocationEvents(ctx, newLocationEvent(ctx, "move", &before, &after))
+}
+
+// RollbackLocation 事务中使用，充值冲正扣回这笔充值加的分红额度，restore 时被分满出局还没紧缩的恢复运行 .
+func (lr *LocationRepo) RollbackLocation(ctx context.Context, id int64, current int64, restore bool, stopDate time.Time) (*biz.Location, error) {
+	var before Location
+	if err := lr.data.DB(ctx).Table("location").Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	after := before
+	after.Current -= current
+	if 0 > after.Current {
+		after.Current = 0
+	}
+	updates := map[string]interface{}{"current": after.Current}
+	if restore && "stop" == before.Status && 0 == before.StopIsUpdate && after.Current < before.CurrentMax {
+		after.Status = "running"
+		after.StopDate = stopDate
+		updates["status"] = "running"
+		updates["stop_date"] = stopDate
+	}
+	if res := lr.data.DB(ctx).Table("location").Where("id=?", id).Updates(updates); res.Error != nil {
+		return nil, errors.New(500, "ROLLBACK_LOCATION_ERROR", "占位分红扣回失败")
+	}
+
+	if err := lr.createLocationEvents(ctx, newLocationEvent(ctx, "rollback", &before, &after)); nil != err {
+		return nil, err
+	}
+
+	return &biz.Location{
+		ID:           after.ID,
+		UserId:       after.UserId,
+		MatrixId:     after.MatrixId,
+		Source:       after.Source,
+		Status:       after.Status,
+		CurrentLevel: after.CurrentLevel,
+		Current:      after.Current,
+		CurrentMax:   after.CurrentMax,
+		Row:          after.Row,
+		Col:          after.Col,
+		StopDate:     after.StopDate,
+	}, nil
+}
+
+// ReverseLocation 事务中使用，充值冲正，占位停止后由紧缩移出矩阵 .
+func (lr *LocationRepo) ReverseLocation(ctx context.Context, id int64, stopDate time.Time) error {
+	var before Location
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&before).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return errors.New(500, "LOCATION ERROR", err.Error())
+	}
 
-	if res := lr.data.db.Table("location").
+	updates := map[string]interface{}{"status": "stop"}
+	after := before
+	after.Status = "stop"
+	if "running" == before.Status { // 已出局的保留原出局时间
+		updates["stop_date"] = stopDate
+		after.StopDate = stopDate
+	}
+	if res := lr.data.DB(ctx).Table("location").Where("id=?", id).Updates(updates); res.Error != nil {
+		return errors.New(500, "REVERSE_LOCATION_ERROR", "占位冲正失败")
+	}
+
+	return lr.createLocationEvents(ctx, newLocationEvent(ctx, "reverse", &before, &after))
+}
+
+// UpdateLocationRowAndCol 事务中使用，只移动同一矩阵内的占位，已经紧缩过或恢复运行的不再处理 .
+func (lr *LocationRepo) UpdateLocationRowAndCol(ctx context.Context, id int64, matrix *biz.LocationMatrix) error {
+	var (
+		stopLocation   Location
+		shiftLocations []*Location
+	)
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&stopLocation).Error; err != nil {
+		return err
+	}
+	if 1 == stopLocation.StopIsUpdate || "running" == stopLocation.Status {
+		return nil
+	}
+
+	if err := lr.data.DB(ctx).Table("location").
+		Where("id>?", id).
+		Where("matrix_id=?", matrix.ID).
+		Where("update_status=?", 0).
+		Find(&shiftLocations).Error; err != nil {
+		return err
+	}
+
+	if res := lr.data.DB(ctx).Table("location").
 		Where("id>?", id).
+		Where("matrix_id=?", matrix.ID).
 		Where("col > 1").
 		Where("update_status=?", 0).
 		Updates(map[string]interface{}{"col": gorm.Expr("col - ?", 1), "update_status": 1}); res.Error != nil {
 		return res.Error
 	}
 
-	if res := lr.data.db.Table("location").
+	if res := lr.data.DB(ctx).Table("location").
 		Where("id>?", id).
+		Where("matrix_id=?", matrix.ID).
 		Where("col = 1").
 		Where("update_status=?", 0).
-		Updates(map[string]interface{}{"row": gorm.Expr("row - ?", 1), "col": 3, "update_status": 1}); res.Error != nil {
+		Updates(map[string]interface{}{"row": gorm.Expr("row - ?", 1), "col": matrix.ColMax, "update_status": 1}); res.Error != nil {
 		return res.Error
 	}
 
-	if res := lr.data.db.Table("location").
+	if res := lr.data.DB(ctx).Table("location").
 		Where("id>?", id).
+		Where("matrix_id=?", matrix.ID).
 		Updates(map[string]interface{}{"update_status": 0}); res.Error != nil {
 		return res.Error
 	}
 
-	if res := lr.data.db.Table("location").
+	if res := lr.data.DB(ctx).Table("location").
 		Where("id=?", id).
 		Updates(map[string]interface{}{"stop_is_update": 1}); res.Error != nil {
 		return res.Error
 	}
-	return nil
+
+	// 移位流水，和上面的更新规则一致
+	events := make([]*LocationEvent, 0)
+	for _, before := range shiftLocations {
+		after := *before
+		if 1 < before.Col {
+			after.Col = before.Col - 1
+		} else {
+			after.Row = before.Row - 1
+			after.Col = matrix.ColMax
+		}
+		events = append(events, newLocationEvent(ctx, "shift", before, &after))
+	}
+
+	return lr.createLocationEvents(ctx, events...)
 }
 
 // GetRewardLocationByRowOrCol .
-func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64) ([]*biz.Location, error) {
+func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, matrix *biz.LocationMatrix, row int64, col int64) ([]*biz.Location, error) {
 	var (
 		rowMin    int64 = 1
 		rowMax    int64
 		locations []*Location
 	)
-	if row > 25 {
-		rowMin = row - 25
+	if row > matrix.RowRange {
+		rowMin = row - matrix.RowRange
 	}
-	rowMax = row + 25
+	rowMax = row + matrix.RowRange
 
-	if err := lr.data.db.Table("location").
+	if err := lr.data.DB(ctx).Table("location").
 		Where("status=?", "running").
+		Where("matrix_id=?", matrix.ID).
 		Where("row=? or (col=? and row>=? and row<=?)", row, col, rowMin, rowMax).
 		Find(&locations).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -408,6 +735,8 @@ func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int
 		res = append(res, &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
@@ -440,6 +769,8 @@ func (lr *LocationRepo) GetRewardLocationByIds(ctx context.Context, ids ...int64
 		res[location.ID] = &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
@@ -478,6 +809,8 @@ func (lr *LocationRepo) GetLocations(ctx context.Context, b *biz.Pagination, use
 		res = append(res, &biz.Location{
 			ID:           location.ID,
 			UserId:       location.UserId,
+			MatrixId:     location.MatrixId,
+			Source:       location.Source,
 			Status:       location.Status,
 			CurrentLevel: location.CurrentLevel,
 			Current:      location.Current,
@@ -490,3 +823,330 @@ func (lr *LocationRepo) GetLocations(ctx context.Context, b *biz.Pagination, use
 
 	return res, nil, count
 }
+
+// GetLocationMatrices .
+func (lr *LocationRepo) GetLocationMatrices(ctx context.Context) ([]*biz.LocationMatrix, error) {
+	var matrices []*LocationMatrix
+	if err := lr.data.db.Table("location_matrix").Order("id asc").Find(&matrices).Error; err != nil {
+		return nil, errors.New(500, "LOCATION MATRIX ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationMatrix, 0)
+	for _, matrix := range matrices {
+		res = append(res, &biz.LocationMatrix{
+			ID:       matrix.ID,
+			Name:     matrix.Name,
+			LevelMin: matrix.LevelMin,
+			LevelMax: matrix.LevelMax,
+			ColMax:   matrix.ColMax,
+			RowRange: matrix.RowRange,
+			RowRate:  matrix.RowRate,
+			ColRate:  matrix.ColRate,
+			Status:   matrix.Status,
+		})
+	}
+
+	return res, nil
+}
+
+// GetLocationById .
+func (lr *LocationRepo) GetLocationById(ctx context.Context, id int64) (*biz.Location, error) {
+	var location Location
+	if err := lr.data.DB(ctx).Table("location").Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.Location{
+		ID:           location.ID,
+		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
+		Status:       location.Status,
+		CurrentLevel: location.CurrentLevel,
+		Current:      location.Current,
+		CurrentMax:   location.CurrentMax,
+		Row:          location.Row,
+		Col:          location.Col,
+		StopDate:     location.StopDate,
+		CreatedAt:    location.CreatedAt,
+	}, nil
+}
+
+// LockLocationById 事务中使用，锁住占位到事务结束 .
+func (lr *LocationRepo) LockLocationById(ctx context.Context, id int64) (*biz.Location, error) {
+	var location Location
+	if err := lr.data.DB(ctx).Table("location").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.Location{
+		ID:           location.ID,
+		UserId:       location.UserId,
+		MatrixId:     location.MatrixId,
+		Source:       location.Source,
+		Status:       location.Status,
+		CurrentLevel: location.CurrentLevel,
+		Current:      location.Current,
+		CurrentMax:   location.CurrentMax,
+		Row:          location.Row,
+		Col:          location.Col,
+		StopDate:     location.StopDate,
+		CreatedAt:    location.CreatedAt,
+	}, nil
+}
+
+// newLocationEvent 占位变动流水，业务来源取自上下文
+func newLocationEvent(ctx context.Context, eventType string, before *Location, after *Location) *LocationEvent {
+	trigger := biz.LocationTriggerFromContext(ctx)
+	return &LocationEvent{
+		LocationId:     after.ID,
+		UserId:         after.UserId,
+		MatrixId:       after.MatrixId,
+		Type:           eventType,
+		BeforeStatus:   before.Status,
+		AfterStatus:    after.Status,
+		BeforeCurrent:  before.Current,
+		AfterCurrent:   after.Current,
+		BeforeLevel:    before.CurrentLevel,
+		AfterLevel:     after.CurrentLevel,
+		BeforeMax:      before.CurrentMax,
+		AfterMax:       after.CurrentMax,
+		BeforeRow:      before.Row,
+		AfterRow:       after.Row,
+		BeforeCol:      before.Col,
+		AfterCol:       after.Col,
+		BeforeStopDate: before.StopDate,
+		AfterStopDate:  after.StopDate,
+		TriggerType:    trigger.Type,
+		TriggerRef:     trigger.Ref,
+		CorrelationId:  trigger.CorrelationId,
+	}
+}
+
+// createLocationEvents 事务中使用 .
+func (lr *LocationRepo) createLocationEvents(ctx context.Context, events ...*LocationEvent) error {
+	if 0 >= len(events) {
+		return nil
+	}
+
+	if res := lr.data.DB(ctx).Table("location_event").CreateInBatches(events, 100); res.Error != nil {
+		return errors.New(500, "CREATE_LOCATION_EVENT_ERROR", "占位流水创建失败")
+	}
+
+	// 团队统计，入单和升级加业绩，冲正减业绩
+	runningDelta := make(map[int64]int64, 0)
+	runningUserIds := make([]int64, 0)
+	for _, v := range events {
+		var err error
+		if "create" == v.Type {
+			err = updateUserTeamStats(ctx, lr.data, v.UserId, 0, 0, v.AfterMax/5)
+		} else if "reverse" == v.Type || "move" == v.Type { // 移动的业绩算在新占位上
+			err = updateUserTeamStats(ctx, lr.data, v.UserId, 0, 0, -v.BeforeMax/5)
+		} else if "upgrade" == v.Type {
+			err = updateUserTeamStats(ctx, lr.data, v.UserId, 0, 0, (v.AfterMax-v.BeforeMax)/5)
+		}
+		if nil != err {
+			return err
+		}
+
+		var tmpDelta int64
+		if "running" == v.AfterStatus {
+			tmpDelta++
+		}
+		if "running" == v.BeforeStatus {
+			tmpDelta--
+		}
+		if 0 == tmpDelta {
+			continue
+		}
+		if _, ok := runningDelta[v.UserId]; !ok {
+			runningUserIds = append(runningUserIds, v.UserId)
+		}
+		runningDelta[v.UserId] += tmpDelta
+	}
+
+	// 活跃人数按用户算，有运行中的占位就是活跃，和全量重建一致
+	for _, userId := range runningUserIds {
+		if 0 == runningDelta[userId] {
+			continue
+		}
+
+		var runningNum int64
+		if err := lr.data.DB(ctx).Table("location").Where("user_id=? and status=?", userId, "running").Count(&runningNum).Error; err != nil {
+			return errors.New(500, "LOCATION ERROR", err.Error())
+		}
+
+		var activeNum int64
+		if 0 < runningNum && 0 >= runningNum-runningDelta[userId] {
+			activeNum = 1
+		} else if 0 >= runningNum && 0 < runningNum-runningDelta[userId] {
+			activeNum = -1
+		}
+		if 0 == activeNum {
+			continue
+		}
+
+		if err := updateUserTeamStats(ctx, lr.data, userId, 0, activeNum, 0); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLocationEventsByLocationId .
+func (lr *LocationRepo) GetLocationEventsByLocationId(ctx context.Context, locationId int64) ([]*biz.LocationEvent, error) {
+	var events []*LocationEvent
+	if err := lr.data.db.Table("location_event").
+		Where("location_id=?", locationId).
+		Order("id asc").Find(&events).Error; err != nil {
+		return nil, errors.New(500, "LOCATION EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationEvent, 0)
+	for _, event := range events {
+		res = append(res, &biz.LocationEvent{
+			ID:             event.ID,
+			LocationId:     event.LocationId,
+			UserId:         event.UserId,
+			MatrixId:       event.MatrixId,
+			Type:           event.Type,
+			BeforeStatus:   event.BeforeStatus,
+			AfterStatus:    event.AfterStatus,
+			BeforeCurrent:  event.BeforeCurrent,
+			AfterCurrent:   event.AfterCurrent,
+			BeforeLevel:    event.BeforeLevel,
+			AfterLevel:     event.AfterLevel,
+			BeforeMax:      event.BeforeMax,
+			AfterMax:       event.AfterMax,
+			BeforeRow:      event.BeforeRow,
+			AfterRow:       event.AfterRow,
+			BeforeCol:      event.BeforeCol,
+			AfterCol:       event.AfterCol,
+			BeforeStopDate: event.BeforeStopDate,
+			AfterStopDate:  event.AfterStopDate,
+			TriggerType:    event.TriggerType,
+			TriggerRef:     event.TriggerRef,
+			CorrelationId:  event.CorrelationId,
+			CreatedAt:      event.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetLocationEventsByTrigger .
+func (lr *LocationRepo) GetLocationEventsByTrigger(ctx context.Context, triggerType string, triggerRef string) ([]*biz.LocationEvent, error) {
+	var events []*LocationEvent
+	if err := lr.data.db.Table("location_event").
+		Where("trigger_type=? and trigger_ref=?", triggerType, triggerRef).
+		Order("id asc").Find(&events).Error; err != nil {
+		return nil, errors.New(500, "LOCATION EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationEvent, 0)
+	for _, event := range events {
+		res = append(res, &biz.LocationEvent{
+			ID:             event.ID,
+			LocationId:     event.LocationId,
+			UserId:         event.UserId,
+			MatrixId:       event.MatrixId,
+			Type:           event.Type,
+			BeforeStatus:   event.BeforeStatus,
+			AfterStatus:    event.AfterStatus,
+			BeforeCurrent:  event.BeforeCurrent,
+			AfterCurrent:   event.AfterCurrent,
+			BeforeLevel:    event.BeforeLevel,
+			AfterLevel:     event.AfterLevel,
+			BeforeMax:      event.BeforeMax,
+			AfterMax:       event.AfterMax,
+			BeforeStopDate: event.BeforeStopDate,
+			AfterStopDate:  event.AfterStopDate,
+			TriggerType:    event.TriggerType,
+			TriggerRef:     event.TriggerRef,
+			CorrelationId:  event.CorrelationId,
+			CreatedAt:      event.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetLocationRunningAheadCount 同一矩阵内排在前面的运行中占位数
+func (lr *LocationRepo) GetLocationRunningAheadCount(ctx context.Context, matrixId int64, row int64, col int64) (int64, error) {
+	var count int64
+	if err := lr.data.db.Table("location").
+		Where("status=?", "running").
+		Where("matrix_id=?", matrixId).
+		Where("row<? or (row=? and col<?)", row, row, col).
+		Count(&count).Error; err != nil {
+		return 0, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// GetLocationNeighbourCache .
+func (lr *LocationRepo) GetLocationNeighbourCache(ctx context.Context, userId int64) (string, error) {
+	res, err := lr.data.rdb.Get(ctx, "location_neighbour:"+strconv.FormatInt(userId, 10)).Result()
+	if err == redis.Nil {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
+
+// SetLocationNeighbourCache .
+func (lr *LocationRepo) SetLocationNeighbourCache(ctx context.Context, userId int64, value string, expiration time.Duration) error {
+	return lr.data.rdb.Set(ctx, "location_neighbour:"+strconv.FormatInt(userId, 10), value, expiration).Err()
+}
+
+// GetLocationEventLastId .
+func (lr *LocationRepo) GetLocationEventLastId(ctx context.Context) (int64, error) {
+	var event LocationEvent
+	if err := lr.data.db.Table("location_event").Order("id desc").First(&event).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "LOCATION EVENT ERROR", err.Error())
+	}
+
+	return event.ID, nil
+}
+
+// GetLocationStopEventsAfterId .
+func (lr *LocationRepo) GetLocationStopEventsAfterId(ctx context.Context, id int64) ([]*biz.LocationEvent, error) {
+	var events []*LocationEvent
+	if err := lr.data.db.Table("location_event").
+		Where("id>?", id).
+		Where("type=?", "stop").
+		Order("id asc").Find(&events).Error; err != nil {
+		return nil, errors.New(500, "LOCATION EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationEvent, 0)
+	for _, event := range events {
+		res = append(res, &biz.LocationEvent{
+			ID:         event.ID,
+			LocationId: event.LocationId,
+			UserId:     event.UserId,
+			MatrixId:   event.MatrixId,
+			Type:       event.Type,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
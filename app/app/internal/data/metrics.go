@@ -0,0 +1,203 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"time"
+)
+
+type DailyMetrics struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Day       string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_day_name"`
+	Name      string    `gorm:"type:varchar(45);not null;uniqueIndex:idx_day_name"`
+	Num       int64     `gorm:"type:int;not null"`
+	Amount    int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type metricsGroup struct {
+	Name  string
+	Num   int64
+	Total int64
+}
+
+// GetDailyMetricsStat 统计一天的指标，占位数和手续费池是当天结束时的存量 .
+func (ub *UserBalanceRepo) GetDailyMetricsStat(ctx context.Context, start time.Time, end time.Time) ([]*biz.DailyMetrics, error) {
+	var (
+		newUser         int64
+		deposits        []*EthUserRecord
+		internals       metricsGroup
+		withdrawApply   []*metricsGroup
+		withdrawPaid    []*metricsGroup
+		rewards         []*metricsGroup
+		revenue         metricsGroup
+		feePool         metricsGroup
+		runningLocation int64
+		stopLocation    int64
+	)
+	res := make([]*biz.DailyMetrics, 0)
+
+	// 新用户
+	if err := ub.data.db.Table("user").
+		Where("created_at>=? and created_at<?", start, end).
+		Count(&newUser).Error; err != nil {
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+	res = append(res, &biz.DailyMetrics{Name: "new_user", Num: newUser})
+
+	// 链上充值按档位，档位是充值的usdt数
+	if err := ub.data.db.Table("eth_user_record").
+		Where("type=? and status=? and coin_type=?", "deposit", "success", "USDT").
+		Where("created_at>=? and created_at<?", start, end).
+		Find(&deposits).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+	depositLevels := make(map[string]*biz.DailyMetrics, 0)
+	depositNames := make([]string, 0)
+	for _, v := range deposits {
+		chainAmount, ok := new(big.Int).SetString(v.Amount, 10)
+		if !ok {
+			continue
+		}
+		name := "deposit_" + new(big.Int).Div(chainAmount, big.NewInt(1000000000000000000)).String()
+		if _, ok = depositLevels[name]; !ok {
+			depositLevels[name] = &biz.DailyMetrics{Name: name}
+			depositNames = append(depositNames, name)
+		}
+		depositLevels[name].Num++
+		depositLevels[name].Amount += new(big.Int).Div(chainAmount, big.NewInt(100000000)).Int64() // 链上精度转换为系统精度
+	}
+	for _, name := range depositNames {
+		res = append(res, depositLevels[name])
+	}
+
+	// 余额复投入单
+	if err := ub.data.db.Table("user_balance_record").
+		Select("count(*) as num, ifnull(sum(amount), 0) as total").
+		Where("type=? and source=?", "deposit", "internal").
+		Where("created_at>=? and created_at<?", start, end).
+		Scan(&internals).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+	res = append(res, &biz.DailyMetrics{Name: "deposit_internal", Num: internals.Num, Amount: internals.Total})
+
+	// 提现申请和打款，按币种，申请金额取扣余额的记录，提现表的金额审核后会改成实际打款金额
+	if err := ub.data.db.Table("withdraw as w").
+		Select("concat('withdraw_apply_', w.type) as name, count(*) as num, sum(ifnull(r.amount, w.amount)) as total").
+		Joins("left join user_balance_record as r on r.id=w.balance_record_id").
+		Where("w.created_at>=? and w.created_at<?", start, end).
+		Group("w.type").Scan(&withdrawApply).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+	if err := ub.data.db.Table("withdraw").
+		Select("concat('withdraw_paid_', type) as name, count(*) as num, sum(amount) as total").
+		Where("status=?", "success").
+		Where("updated_at>=? and updated_at<?", start, end).
+		Group("type").Scan(&withdrawPaid).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	// 用户分红按原因，系统分红算在系统收入里
+	if err := ub.data.db.Table("reward").
+		Select("concat('reward_', reason) as name, count(*) as num, sum(amount) as total").
+		Where("user_id<>?", 999999999).
+		Where("created_at>=? and created_at<?", start, end).
+		Group("reason").Scan(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+	for _, v := range append(append(withdrawApply, withdrawPaid...), rewards...) {
+		res = append(res, &biz.DailyMetrics{Name: v.Name, Num: v.Num, Amount: v.Total})
+	}
+
+	// 系统收入，贷方减借方
+	if err := ub.data.db.Table("ledger_posting").
+		Select("count(*) as num, ifnull(sum(credit) - sum(debit), 0) as total").
+		Where("account=? and coin_type=?", ledgerAccountRevenue, "usdt").
+		Where("created_at>=? and created_at<?", start, end).
+		Scan(&revenue).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+	res = append(res, &biz.DailyMetrics{Name: "system_revenue", Num: revenue.Num, Amount: revenue.Total})
+
+	// 手续费池当天结束时的余额
+	if err := ub.data.db.Table("ledger_posting").
+		Select("count(*) as num, ifnull(sum(credit) - sum(debit), 0) as total").
+		Where("account=? and coin_type=?", ledgerAccountFeePool, "usdt").
+		Where("created_at<?", end).
+		Scan(&feePool).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+	res = append(res, &biz.DailyMetrics{Name: "fee_pool", Num: feePool.Num, Amount: feePool.Total})
+
+	// 当天结束时运行中和已出局的占位
+	if err := ub.data.db.Table("location").
+		Where("created_at<?", end).
+		Where("not (status in (?) and stop_date<?)", []string{"stop", "moved"}, end).
+		Count(&runningLocation).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+	if err := ub.data.db.Table("location").
+		Where("created_at<?", end).
+		Where("status=? and stop_date<?", "stop", end).
+		Count(&stopLocation).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+	res = append(res, &biz.DailyMetrics{Name: "location_running", Num: runningLocation})
+	res = append(res, &biz.DailyMetrics{Name: "location_stop", Num: stopLocation})
+
+	return res, nil
+}
+
+// SaveDailyMetrics 事务中使用，同一天重跑覆盖 .
+func (ub *UserBalanceRepo) SaveDailyMetrics(ctx context.Context, day string, metrics []*biz.DailyMetrics) error {
+	if err := ub.data.DB(ctx).Table("daily_metrics").Where("day=?", day).Delete(&DailyMetrics{}).Error; err != nil {
+		return errors.New(500, "DAILY METRICS ERROR", err.Error())
+	}
+
+	if 0 == len(metrics) {
+		return nil
+	}
+	dailyMetrics := make([]*DailyMetrics, 0)
+	for _, v := range metrics {
+		dailyMetrics = append(dailyMetrics, &DailyMetrics{
+			Day:    day,
+			Name:   v.Name,
+			Num:    v.Num,
+			Amount: v.Amount,
+		})
+	}
+	if err := ub.data.DB(ctx).Table("daily_metrics").CreateInBatches(dailyMetrics, 500).Error; err != nil {
+		return errors.New(500, "CREATE_DAILY_METRICS_ERROR", "每日统计创建失败")
+	}
+
+	return nil
+}
+
+// GetDailyMetrics 日期区间内的每日统计，name为空时全部 .
+func (ub *UserBalanceRepo) GetDailyMetrics(ctx context.Context, startDay string, endDay string, name string) ([]*biz.DailyMetrics, error) {
+	var dailyMetrics []*DailyMetrics
+	res := make([]*biz.DailyMetrics, 0)
+	instance := ub.data.db.Table("daily_metrics").Where("day>=? and day<=?", startDay, endDay)
+	if "" != name {
+		instance = instance.Where("name=?", name)
+	}
+	if err := instance.Order("day asc, id asc").Find(&dailyMetrics).Error; err != nil {
+		return res, errors.New(500, "DAILY METRICS ERROR", err.Error())
+	}
+
+	for _, v := range dailyMetrics {
+		res = append(res, &biz.DailyMetrics{
+			ID:        v.ID,
+			Day:       v.Day,
+			Name:      v.Name,
+			Num:       v.Num,
+			Amount:    v.Amount,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
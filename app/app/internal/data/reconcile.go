@@ -0,0 +1,349 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+// balanceRecordSignedAmount user_balance_record 对余额的影响，链上入单不动余额
+const balanceRecordSignedAmount = "case when type in ('reward', 'exit') then amount " +
+	"when type in ('adjust', 'reverse', 'transfer', 'convert') then amount " +
+	"when type='withdraw' then -amount " +
+	"when type='deposit' and source='internal' then -amount " +
+	"else 0 end"
+
+type ReconcileResult struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Day       string    `gorm:"type:varchar(10);not null;index"`
+	Name      string    `gorm:"type:varchar(45);not null"`
+	Expected  int64     `gorm:"type:bigint;not null"`
+	Actual    int64     `gorm:"type:bigint;not null"`
+	Num       int64     `gorm:"type:int;not null"`
+	Alert     int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type ReconcileDetail struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	ResultId  int64     `gorm:"type:int;not null;index"`
+	UserId    int64     `gorm:"type:int;not null"`
+	RecordId  int64     `gorm:"type:int;not null"`
+	Expected  int64     `gorm:"type:bigint;not null"`
+	Actual    int64     `gorm:"type:bigint;not null"`
+	Reason    string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type reconcileUserAmount struct {
+	UserId int64
+	Total  int64
+}
+
+// GetReconcileDeposit 当天按用户，链上充值和余额入单金额，对比入单产生的分红（含系统） .
+func (ub *UserBalanceRepo) GetReconcileDeposit(ctx context.Context, start time.Time, end time.Time) ([]*biz.ReconcileDetail, error) {
+	var (
+		ethUserRecords []*EthUserRecord
+		internals      []*reconcileUserAmount
+		rewards        []*reconcileUserAmount
+	)
+	if err := ub.data.db.Table("eth_user_record").
+		Where("type=? and status=? and coin_type=?", "deposit", "success", "USDT").
+		Where("created_at>=? and created_at<?", start, end).
+		Find(&ethUserRecords).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	if err := ub.data.db.Table("user_balance_record").
+		Select("user_id, sum(amount) as total").
+		Where("type=? and source=?", "deposit", "internal").
+		Where("created_at>=? and created_at<?", start, end).
+		Group("user_id").Scan(&internals).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	// 入单分红记在入单用户的占位上，冲正的充值不算
+	if err := ub.data.db.Table("reward").
+		Select("location.user_id as user_id, sum(reward.amount) as total").
+		Joins("join location on location.id=reward.type_record_id").
+		Where("reward.type=?", "location").
+		Where("reward.type_record_id not in (select location_id from deposit_reversal)").
+		Where("reward.created_at>=? and reward.created_at<?", start, end).
+		Group("location.user_id").Scan(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	details := make(map[int64]*biz.ReconcileDetail, 0)
+	detail := func(userId int64) *biz.ReconcileDetail {
+		if _, ok := details[userId]; !ok {
+			details[userId] = &biz.ReconcileDetail{UserId: userId, Reason: "deposit"}
+		}
+		return details[userId]
+	}
+	for _, v := range ethUserRecords {
+		if 8 >= len(v.Amount) {
+			continue
+		}
+		tmpAmount, _ := strconv.ParseInt(v.Amount[:len(v.Amount)-8], 10, 64) // 链上精度转换为系统精度
+		detail(v.UserId).Expected += tmpAmount
+	}
+	for _, v := range internals {
+		detail(v.UserId).Expected += v.Total
+	}
+	for _, v := range rewards {
+		detail(v.UserId).Actual += v.Total
+	}
+
+	res := make([]*biz.ReconcileDetail, 0)
+	for _, v := range details {
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// GetReconcileBalance 按用户，余额和余额记录累计 .
+func (ub *UserBalanceRepo) GetReconcileBalance(ctx context.Context) ([]*biz.ReconcileDetail, error) {
+	var (
+		userBalances []*UserBalance
+		records      []*reconcileUserAmount
+	)
+	if err := ub.data.db.Table("user_balance").Find(&userBalances).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	if err := ub.data.db.Table("user_balance_record").
+		Select("user_id, sum("+balanceRecordSignedAmount+") as total").
+		Where("coin_type<>?", "dhb").
+		Group("user_id").Scan(&records).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	recordTotal := make(map[int64]int64, 0)
+	for _, v := range records {
+		recordTotal[v.UserId] = v.Total
+	}
+
+	res := make([]*biz.ReconcileDetail, 0)
+	for _, v := range userBalances {
+		res = append(res, &biz.ReconcileDetail{
+			UserId:   v.UserId,
+			Expected: recordTotal[v.UserId],
+			Actual:   v.BalanceUsdt,
+			Reason:   "balance",
+		})
+	}
+
+	return res, nil
+}
+
+// GetReconcileWithdrawSuccess 当天打款成功的提现 .
+func (ub *UserBalanceRepo) GetReconcileWithdrawSuccess(ctx context.Context, start time.Time, end time.Time) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := ub.data.db.Table("withdraw").
+		Where("status=?", "success").
+		Where("updated_at>=? and updated_at<?", start, end).
+		Order("id asc").Find(&withdraws).Error; err != nil {
+		return res, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, v := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              v.ID,
+			UserId:          v.UserId,
+			Amount:          v.Amount,
+			RelAmount:       v.RelAmount,
+			BalanceRecordId: v.BalanceRecordId,
+			Status:          v.Status,
+			Type:            v.Type,
+			TxHash:          v.TxHash,
+			CreatedAt:       v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetReconcileWithdraw 当天打款成功的提现，对比链上实际转账和打款记账，onchain 是按提现id核对过的链上转账金额 .
+func (ub *UserBalanceRepo) GetReconcileWithdraw(ctx context.Context, start time.Time, end time.Time, onchain map[int64]int64) ([]*biz.ReconcileDetail, error) {
+	var (
+		withdraws []*Withdraw
+		payouts   []*struct {
+			EventId int64
+			Total   int64
+		}
+		withdrawIds []int64
+	)
+	if err := ub.data.db.Table("withdraw").
+		Where("status=?", "success").
+		Where("updated_at>=? and updated_at<?", start, end).
+		Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	res := make([]*biz.ReconcileDetail, 0)
+	if 0 == len(withdraws) {
+		return res, nil
+	}
+	for _, v := range withdraws {
+		withdrawIds = append(withdrawIds, v.ID)
+	}
+
+	if err := ub.data.db.Table("ledger_entry").
+		Select("ledger_entry.event_id as event_id, sum(ledger_posting.credit) as total").
+		Joins("join ledger_posting on ledger_posting.entry_id=ledger_entry.id").
+		Where("ledger_entry.event_type=? and ledger_entry.event_id in (?)", "withdraw_payout", withdrawIds).
+		Where("ledger_posting.account=?", ledgerAccountHotWallet).
+		Group("ledger_entry.event_id").Scan(&payouts).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	payoutTotal := make(map[int64]int64, 0)
+	for _, v := range payouts {
+		payoutTotal[v.EventId] = v.Total
+	}
+
+	for _, v := range withdraws {
+		detail := &biz.ReconcileDetail{
+			UserId:   v.UserId,
+			RecordId: v.ID,
+			Expected: v.Amount,
+			Reason:   "withdraw",
+		}
+
+		if "" == v.TxHash {
+			detail.Reason = "no_tx_hash"
+		} else if _, ok := onchain[v.ID]; !ok { // 交易没找到或链上执行失败
+			detail.Reason = "tx_failed"
+		} else if v.Amount != payoutTotal[v.ID] { // 打款没有按提现金额记账
+			detail.Actual = payoutTotal[v.ID]
+			detail.Reason = "ledger"
+		} else {
+			detail.Actual = onchain[v.ID]
+		}
+
+		res = append(res, detail)
+	}
+
+	return res, nil
+}
+
+// SaveReconcileResults 事务中使用，同一天重跑覆盖 .
+func (ub *UserBalanceRepo) SaveReconcileResults(ctx context.Context, day string, results []*biz.ReconcileResult) error {
+	var resultIds []int64
+	if err := ub.data.DB(ctx).Table("reconcile_result").Where("day=?", day).Pluck("id", &resultIds).Error; err != nil {
+		return errors.New(500, "RECONCILE ERROR", err.Error())
+	}
+	if 0 < len(resultIds) {
+		if err := ub.data.DB(ctx).Table("reconcile_detail").Where("result_id in (?)", resultIds).Delete(&ReconcileDetail{}).Error; err != nil {
+			return errors.New(500, "RECONCILE ERROR", err.Error())
+		}
+		if err := ub.data.DB(ctx).Table("reconcile_result").Where("id in (?)", resultIds).Delete(&ReconcileResult{}).Error; err != nil {
+			return errors.New(500, "RECONCILE ERROR", err.Error())
+		}
+	}
+
+	for _, v := range results {
+		reconcileResult := &ReconcileResult{
+			Day:      day,
+			Name:     v.Name,
+			Expected: v.Expected,
+			Actual:   v.Actual,
+			Num:      int64(len(v.Details)),
+			Alert:    v.Alert,
+		}
+		if err := ub.data.DB(ctx).Table("reconcile_result").Create(reconcileResult).Error; err != nil {
+			return errors.New(500, "CREATE_RECONCILE_ERROR", "对账结果创建失败")
+		}
+		v.ID = reconcileResult.ID
+
+		if 0 == len(v.Details) {
+			continue
+		}
+		reconcileDetails := make([]*ReconcileDetail, 0)
+		for _, vDetail := range v.Details {
+			reconcileDetails = append(reconcileDetails, &ReconcileDetail{
+				ResultId: reconcileResult.ID,
+				UserId:   vDetail.UserId,
+				RecordId: vDetail.RecordId,
+				Expected: vDetail.Expected,
+				Actual:   vDetail.Actual,
+				Reason:   vDetail.Reason,
+			})
+		}
+		if err := ub.data.DB(ctx).Table("reconcile_detail").CreateInBatches(reconcileDetails, 500).Error; err != nil {
+			return errors.New(500, "CREATE_RECONCILE_ERROR", "对账明细创建失败")
+		}
+	}
+
+	return nil
+}
+
+// GetReconcileResults .
+func (ub *UserBalanceRepo) GetReconcileResults(ctx context.Context, b *biz.Pagination, day string) ([]*biz.ReconcileResult, error, int64) {
+	var (
+		count            int64
+		reconcileResults []*ReconcileResult
+	)
+	res := make([]*biz.ReconcileResult, 0)
+
+	instance := ub.data.db.Table("reconcile_result")
+	if "" != day {
+		instance = instance.Where("day=?", day)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&reconcileResults).Error; err != nil {
+		return res, errors.New(500, "RECONCILE ERROR", err.Error()), 0
+	}
+
+	for _, v := range reconcileResults {
+		res = append(res, &biz.ReconcileResult{
+			ID:        v.ID,
+			Day:       v.Day,
+			Name:      v.Name,
+			Expected:  v.Expected,
+			Actual:    v.Actual,
+			Num:       v.Num,
+			Alert:     v.Alert,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetReconcileDetails .
+func (ub *UserBalanceRepo) GetReconcileDetails(ctx context.Context, b *biz.Pagination, resultId int64) ([]*biz.ReconcileDetail, error, int64) {
+	var (
+		count            int64
+		reconcileDetails []*ReconcileDetail
+	)
+	res := make([]*biz.ReconcileDetail, 0)
+
+	instance := ub.data.db.Table("reconcile_detail").Where("result_id=?", resultId)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id asc").Find(&reconcileDetails).Error; err != nil {
+		return res, errors.New(500, "RECONCILE ERROR", err.Error()), 0
+	}
+
+	for _, v := range reconcileDetails {
+		res = append(res, &biz.ReconcileDetail{
+			ID:       v.ID,
+			ResultId: v.ResultId,
+			UserId:   v.UserId,
+			RecordId: v.RecordId,
+			Expected: v.Expected,
+			Actual:   v.Actual,
+			Reason:   v.Reason,
+		})
+	}
+
+	return res, nil, count
+}
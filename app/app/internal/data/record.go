@@ -10,15 +10,16 @@ import (
 )
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+	ID              int64     `gorm:"primarykey;type:int"`
+	Hash            string    `gorm:"type:varchar(100);not null"`
+	UserId          int64     `gorm:"type:int;not null"`
+	Status          string    `gorm:"type:varchar(45);not null"`
+	Type            string    `gorm:"type:varchar(45);not null"`
+	Amount          string    `gorm:"type:varchar(45);not null"`
+	CoinType        string    `gorm:"type:varchar(45);not null"`
+	BalanceRecordId int64     `gorm:"type:int;not null;default:0;index"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecordRepo struct {
@@ -67,6 +68,7 @@ func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r
 	ethUserRecord.Status = r.Status
 	ethUserRecord.Amount = r.Amount
 	ethUserRecord.CoinType = r.CoinType
+	ethUserRecord.BalanceRecordId = r.BalanceRecordId
 
 	res := e.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if res.Error != nil {
@@ -74,12 +76,25 @@ func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r
 	}
 
 	return &biz.EthUserRecord{
-		ID:       ethUserRecord.ID,
-		UserId:   ethUserRecord.UserId,
-		Hash:     ethUserRecord.Hash,
-		Status:   ethUserRecord.Status,
-		Type:     ethUserRecord.Type,
-		Amount:   ethUserRecord.Amount,
-		CoinType: ethUserRecord.CoinType,
+		ID:              ethUserRecord.ID,
+		UserId:          ethUserRecord.UserId,
+		Hash:            ethUserRecord.Hash,
+		Status:          ethUserRecord.Status,
+		Type:            ethUserRecord.Type,
+		Amount:          ethUserRecord.Amount,
+		CoinType:        ethUserRecord.CoinType,
+		BalanceRecordId: ethUserRecord.BalanceRecordId,
 	}, nil
 }
+
+// UpdateEthUserRecordStatus 事务中使用，只改原状态的记录 .
+func (e *EthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, fromStatus string, status string) error {
+	res := e.data.DB(ctx).Table("eth_user_record").
+		Where("id=? and status=?", id, fromStatus).
+		Updates(map[string]interface{}{"status": status})
+	if 0 == res.RowsAffected || res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+
+	return nil
+}
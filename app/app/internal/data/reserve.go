@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ReserveSnapshot struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	Onchain         int64     `gorm:"type:bigint;not null"`
+	UserBalance     int64     `gorm:"type:bigint;not null"`
+	PendingWithdraw int64     `gorm:"type:bigint;not null"`
+	Wallets         string    `gorm:"type:varchar(2000);not null"`
+	MerkleRoot      string    `gorm:"type:varchar(100);not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+type ReserveLeaf struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	SnapshotId int64     `gorm:"type:int;not null;index"`
+	UserId     int64     `gorm:"type:int;not null"`
+	Address    string    `gorm:"type:varchar(100);not null"`
+	Balance    int64     `gorm:"type:bigint;not null"`
+	Nonce      string    `gorm:"type:varchar(45);not null;default:''"`
+	Leaf       string    `gorm:"type:varchar(100);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+// GetWithdrawUsdtNotPaidTotal 还没打款的usdt提现 .
+func (ub *UserBalanceRepo) GetWithdrawUsdtNotPaidTotal(ctx context.Context) (int64, error) {
+	var total UserBalanceTotal
+	if err := ub.data.db.Table("withdraw").
+		Where("type=?", "usdt").
+		Where("status<>?", "success").
+		Select("sum(amount) as total").Take(&total).Error; err != nil {
+		return total.Total, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// CreateReserveSnapshot 事务中使用 .
+func (ub *UserBalanceRepo) CreateReserveSnapshot(ctx context.Context, s *biz.ReserveSnapshot, leaves []*biz.ReserveLeaf) (*biz.ReserveSnapshot, error) {
+	reserveSnapshot := &ReserveSnapshot{
+		Onchain:         s.Onchain,
+		UserBalance:     s.UserBalance,
+		PendingWithdraw: s.PendingWithdraw,
+		Wallets:         s.Wallets,
+		MerkleRoot:      s.MerkleRoot,
+	}
+	if err := ub.data.DB(ctx).Table("reserve_snapshot").Create(reserveSnapshot).Error; err != nil {
+		return nil, errors.New(500, "CREATE_RESERVE_SNAPSHOT_ERROR", "储备快照创建失败")
+	}
+
+	if 0 < len(leaves) {
+		reserveLeaves := make([]*ReserveLeaf, 0)
+		for _, v := range leaves {
+			reserveLeaves = append(reserveLeaves, &ReserveLeaf{
+				SnapshotId: reserveSnapshot.ID,
+				UserId:     v.UserId,
+				Address:    v.Address,
+				Balance:    v.Balance,
+				Nonce:      v.Nonce,
+				Leaf:       v.Leaf,
+			})
+		}
+		if err := ub.data.DB(ctx).Table("reserve_leaf").CreateInBatches(reserveLeaves, 500).Error; err != nil {
+			return nil, errors.New(500, "CREATE_RESERVE_SNAPSHOT_ERROR", "储备快照创建失败")
+		}
+	}
+
+	return &biz.ReserveSnapshot{
+		ID:              reserveSnapshot.ID,
+		Onchain:         reserveSnapshot.Onchain,
+		UserBalance:     reserveSnapshot.UserBalance,
+		PendingWithdraw: reserveSnapshot.PendingWithdraw,
+		Wallets:         reserveSnapshot.Wallets,
+		MerkleRoot:      reserveSnapshot.MerkleRoot,
+		CreatedAt:       reserveSnapshot.CreatedAt,
+	}, nil
+}
+
+// GetReserveSnapshots .
+func (ub *UserBalanceRepo) GetReserveSnapshots(ctx context.Context, b *biz.Pagination) ([]*biz.ReserveSnapshot, error, int64) {
+	var (
+		count            int64
+		reserveSnapshots []*ReserveSnapshot
+	)
+	res := make([]*biz.ReserveSnapshot, 0)
+
+	instance := ub.data.db.Table("reserve_snapshot")
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&reserveSnapshots).Error; err != nil {
+		return res, errors.New(500, "RESERVE SNAPSHOT ERROR", err.Error()), 0
+	}
+
+	for _, v := range reserveSnapshots {
+		res = append(res, &biz.ReserveSnapshot{
+			ID:              v.ID,
+			Onchain:         v.Onchain,
+			UserBalance:     v.UserBalance,
+			PendingWithdraw: v.PendingWithdraw,
+			Wallets:         v.Wallets,
+			MerkleRoot:      v.MerkleRoot,
+			CreatedAt:       v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetReserveSnapshot id为0时取最新一次 .
+func (ub *UserBalanceRepo) GetReserveSnapshot(ctx context.Context, id int64) (*biz.ReserveSnapshot, error) {
+	var reserveSnapshot ReserveSnapshot
+	instance := ub.data.db.Table("reserve_snapshot")
+	if 0 < id {
+		instance = instance.Where("id=?", id)
+	}
+	if err := instance.Order("id desc").First(&reserveSnapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("RESERVE_SNAPSHOT_NOT_FOUND", "reserve snapshot not found")
+		}
+
+		return nil, errors.New(500, "RESERVE SNAPSHOT ERROR", err.Error())
+	}
+
+	return &biz.ReserveSnapshot{
+		ID:              reserveSnapshot.ID,
+		Onchain:         reserveSnapshot.Onchain,
+		UserBalance:     reserveSnapshot.UserBalance,
+		PendingWithdraw: reserveSnapshot.PendingWithdraw,
+		Wallets:         reserveSnapshot.Wallets,
+		MerkleRoot:      reserveSnapshot.MerkleRoot,
+		CreatedAt:       reserveSnapshot.CreatedAt,
+	}, nil
+}
+
+// GetReserveLeaves 按user_id排序，和建树时的顺序一致 .
+func (ub *UserBalanceRepo) GetReserveLeaves(ctx context.Context, snapshotId int64) ([]*biz.ReserveLeaf, error) {
+	var reserveLeaves []*ReserveLeaf
+	res := make([]*biz.ReserveLeaf, 0)
+	if err := ub.data.db.Table("reserve_leaf").Where("snapshot_id=?", snapshotId).Order("user_id asc").Find(&reserveLeaves).Error; err != nil {
+		return res, errors.New(500, "RESERVE LEAF ERROR", err.Error())
+	}
+
+	for _, v := range reserveLeaves {
+		res = append(res, &biz.ReserveLeaf{
+			SnapshotId: v.SnapshotId,
+			UserId:     v.UserId,
+			Address:    v.Address,
+			Balance:    v.Balance,
+			Nonce:      v.Nonce,
+			Leaf:       v.Leaf,
+		})
+	}
+
+	return res, nil
+}
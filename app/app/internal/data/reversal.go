@@ -0,0 +1,186 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+type DepositReversal struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	Hash            string    `gorm:"type:varchar(100);not null;index"`
+	EthUserRecordId int64     `gorm:"type:int;not null"`
+	UserId          int64     `gorm:"type:int;not null;index"`
+	LocationId      int64     `gorm:"type:int;not null;index"`
+	Amount          int64     `gorm:"type:bigint;not null"`
+	RewardNum       int64     `gorm:"type:int;not null"`
+	RewardAmount    int64     `gorm:"type:bigint;not null"`
+	Reason          string    `gorm:"type:varchar(500);not null"`
+	CreatedBy       int64     `gorm:"type:int;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+// GetRewardsByTypeRecordId .
+func (ub *UserBalanceRepo) GetRewardsByTypeRecordId(ctx context.Context, rewardType string, typeRecordId int64) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+	if err := ub.data.DB(ctx).Table("reward").
+		Where("type=? and type_record_id=?", rewardType, typeRecordId).
+		Order("id asc").Find(&rewards).Error; err != nil {
+		return res, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, v := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               v.ID,
+			UserId:           v.UserId,
+			Amount:           v.Amount,
+			AmountDhb:        v.AmountDhb,
+			BalanceRecordId:  v.BalanceRecordId,
+			Type:             v.Type,
+			TypeRecordId:     v.TypeRecordId,
+			Reason:           v.Reason,
+			ReasonLocationId: v.ReasonLocationId,
+			LocationType:     v.LocationType,
+			CreatedAt:        v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// CreateDepositReversal 事务中使用 .
+func (ub *UserBalanceRepo) CreateDepositReversal(ctx context.Context, r *biz.DepositReversal) (*biz.DepositReversal, error) {
+	depositReversal := &DepositReversal{
+		Hash:            r.Hash,
+		EthUserRecordId: r.EthUserRecordId,
+		UserId:          r.UserId,
+		LocationId:      r.LocationId,
+		Amount:          r.Amount,
+		RewardNum:       r.RewardNum,
+		RewardAmount:    r.RewardAmount,
+		Reason:          r.Reason,
+		CreatedBy:       r.CreatedBy,
+	}
+	if err := ub.data.DB(ctx).Table("deposit_reversal").Create(depositReversal).Error; err != nil {
+		return nil, errors.New(500, "CREATE_DEPOSIT_REVERSAL_ERROR", "充值冲正记录创建失败")
+	}
+
+	return &biz.DepositReversal{
+		ID:              depositReversal.ID,
+		Hash:            depositReversal.Hash,
+		EthUserRecordId: depositReversal.EthUserRecordId,
+		UserId:          depositReversal.UserId,
+		LocationId:      depositReversal.LocationId,
+		Amount:          depositReversal.Amount,
+		RewardNum:       depositReversal.RewardNum,
+		RewardAmount:    depositReversal.RewardAmount,
+		Reason:          depositReversal.Reason,
+		CreatedBy:       depositReversal.CreatedBy,
+		CreatedAt:       depositReversal.CreatedAt,
+	}, nil
+}
+
+// ReverseReward 事务中使用，扣回分红，余额不足时扣成负数作为应收 .
+func (ub *UserBalanceRepo) ReverseReward(ctx context.Context, reward *biz.Reward, reversalId int64) (int64, error) {
+	var err error
+	if 999999999 == reward.UserId { // 系统分红只冲收入
+		return 0, ledgerTransfer(ctx, ub.data, "deposit_reverse", reversalId, 0, "usdt", ledgerSystem(ledgerAccountRevenue), ledgerSystem(ledgerAccountSuspense), reward.Amount)
+	}
+
+	// 分红折了dhb的，usdt只扣当时实际发的部分，dhb另扣
+	usdtAmount := reward.Amount
+	if 0 < reward.AmountDhb {
+		var rewardRecord UserBalanceRecord
+		if err = ub.data.DB(ctx).Table("user_balance_record").Where("id=?", reward.BalanceRecordId).First(&rewardRecord).Error; err != nil {
+			return 0, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+		}
+		usdtAmount = rewardRecord.Amount
+	}
+
+	var userBalance *UserBalance
+	userBalance, err = updateUserBalance(ctx, ub.data, reward.UserId, "balance_usdt", -usdtAmount, false)
+	if nil != err {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reverse"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Source = "admin"
+	userBalanceRecode.Amount = -usdtAmount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "deposit_reverse", reversalId, userBalanceRecode.ID, "usdt", ledgerUser(reward.UserId), ledgerSystem(ledgerAccountSuspense), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+
+	if 0 < reward.AmountDhb {
+		userBalance, err = updateUserBalance(ctx, ub.data, reward.UserId, "balance_dhb", -reward.AmountDhb, false)
+		if nil != err {
+			return 0, err
+		}
+
+		dhbRecord := &UserBalanceRecord{
+			UserId:   userBalance.UserId,
+			Balance:  userBalance.BalanceDhb,
+			Amount:   -reward.AmountDhb,
+			Type:     "reverse",
+			CoinType: "dhb",
+			Source:   "admin",
+		}
+		if err = ub.data.DB(ctx).Table("user_balance_record").Create(dhbRecord).Error; err != nil {
+			return 0, err
+		}
+
+		// 记账，折dhb时留在兑换账户的usdt一起退回挂账
+		err = ledgerTransfer(ctx, ub.data, "deposit_reverse", reversalId, dhbRecord.ID, "dhb", ledgerUser(reward.UserId), ledgerSystem(ledgerAccountConvert), reward.AmountDhb)
+		if err != nil {
+			return 0, err
+		}
+		err = ledgerTransfer(ctx, ub.data, "deposit_reverse", reversalId, dhbRecord.ID, "usdt", ledgerSystem(ledgerAccountConvert), ledgerSystem(ledgerAccountSuspense), reward.Amount-usdtAmount)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// ReverseDeposit 事务中使用，链上入单冲正，和入单一样不动余额 .
+func (ub *UserBalanceRepo) ReverseDeposit(ctx context.Context, userId int64, amount int64, reversalId int64) (int64, error) {
+	var userBalance UserBalance
+	err := ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "deposit_reverse"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Source = "chain"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "deposit_reverse", reversalId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountHotWallet), amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+func userBalanceRecordToBiz(v *UserBalanceRecord) *biz.UserBalanceRecord {
+	return &biz.UserBalanceRecord{
+		ID:        v.ID,
+		UserId:    v.UserId,
+		Balance:   v.Balance,
+		Amount:    v.Amount,
+		Type:      v.Type,
+		CoinType:  v.CoinType,
+		Source:    v.Source,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+// GetUserBalanceRecordsByTime .
+func (ub *UserBalanceRepo) GetUserBalanceRecordsByTime(ctx context.Context, userId int64, start time.Time, end time.Time) ([]*biz.UserBalanceRecord, error) {
+	var userBalanceRecords []*UserBalanceRecord
+	res := make([]*biz.UserBalanceRecord, 0)
+	if err := ub.data.db.Table("user_balance_record").
+		Where("user_id=?", userId).
+		Where("created_at>=? and created_at<?", start, end).
+		Order("id asc").Find(&userBalanceRecords).Error; err != nil {
+		return res, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	for _, v := range userBalanceRecords {
+		res = append(res, userBalanceRecordToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetUserBalanceRecordLast 某个时间之前的最后一条余额记录，没有时返回nil .
+func (ub *UserBalanceRepo) GetUserBalanceRecordLast(ctx context.Context, userId int64, coinType string, before time.Time) (*biz.UserBalanceRecord, error) {
+	var userBalanceRecord UserBalanceRecord
+	instance := ub.data.db.Table("user_balance_record").Where("user_id=? and created_at<?", userId, before)
+	if "dhb" == coinType {
+		instance = instance.Where("coin_type=?", "dhb")
+	} else {
+		instance = instance.Where("coin_type<>?", "dhb")
+	}
+	if err := instance.Order("id desc").First(&userBalanceRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	return userBalanceRecordToBiz(&userBalanceRecord), nil
+}
+
+// GetRewardsByBalanceRecordIds .
+func (ub *UserBalanceRepo) GetRewardsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make(map[int64]*biz.Reward, 0)
+	if 0 == len(ids) {
+		return res, nil
+	}
+	if err := ub.data.db.Table("reward").Where("balance_record_id in (?)", ids).Find(&rewards).Error; err != nil {
+		return res, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, v := range rewards {
+		res[v.BalanceRecordId] = &biz.Reward{
+			ID:               v.ID,
+			UserId:           v.UserId,
+			Amount:           v.Amount,
+			BalanceRecordId:  v.BalanceRecordId,
+			Type:             v.Type,
+			TypeRecordId:     v.TypeRecordId,
+			Reason:           v.Reason,
+			ReasonLocationId: v.ReasonLocationId,
+			LocationType:     v.LocationType,
+			CreatedAt:        v.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+// GetWithdrawsByBalanceRecordIds .
+func (ub *UserBalanceRepo) GetWithdrawsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make(map[int64]*biz.Withdraw, 0)
+	if 0 == len(ids) {
+		return res, nil
+	}
+	if err := ub.data.db.Table("withdraw").Where("balance_record_id in (?)", ids).Find(&withdraws).Error; err != nil {
+		return res, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res[withdraw.BalanceRecordId] = &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			CreatedAt:       withdraw.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+// GetEthUserRecordsByBalanceRecordIds .
+func (e *EthUserRecordRepo) GetEthUserRecordsByBalanceRecordIds(ctx context.Context, ids ...int64) (map[int64]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make(map[int64]*biz.EthUserRecord, 0)
+	if 0 == len(ids) {
+		return res, nil
+	}
+	if err := e.data.db.Table("eth_user_record").Where("balance_record_id in (?)", ids).Find(&ethUserRecords).Error; err != nil {
+		return res, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, item := range ethUserRecords {
+		res[item.BalanceRecordId] = &biz.EthUserRecord{
+			ID:              item.ID,
+			UserId:          item.UserId,
+			Hash:            item.Hash,
+			Status:          item.Status,
+			Type:            item.Type,
+			Amount:          item.Amount,
+			CoinType:        item.CoinType,
+			BalanceRecordId: item.BalanceRecordId,
+			CreatedAt:       item.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
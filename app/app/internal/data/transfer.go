@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Transfer struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	FromUserId   int64     `gorm:"type:int;not null;index"`
+	ToUserId     int64     `gorm:"type:int;not null;index"`
+	Amount       int64     `gorm:"type:bigint;not null"`
+	CoinType     string    `gorm:"type:varchar(45);not null"`
+	FromRecordId int64     `gorm:"type:int;not null"`
+	ToRecordId   int64     `gorm:"type:int;not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+// Transfer 事务中使用，转出人和收款人各一条余额记录，转出的金额记负数 .
+func (ub *UserBalanceRepo) Transfer(ctx context.Context, fromUserId int64, toUserId int64, amount int64) (*biz.Transfer, error) {
+	var (
+		fromBalance *UserBalance
+		toBalance   *UserBalance
+		err         error
+	)
+	// 两边的余额按用户id从小到大加锁，互相转账时不会死锁
+	lockUserIds := []int64{fromUserId, toUserId}
+	if fromUserId > toUserId {
+		lockUserIds = []int64{toUserId, fromUserId}
+	}
+	for _, lockUserId := range lockUserIds {
+		var userBalance UserBalance
+		if err = ub.data.DB(ctx).Table("user_balance").Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id=?", lockUserId).First(&userBalance).Error; err != nil {
+			return nil, errors.NotFound("user balance err", "user balance not found")
+		}
+	}
+
+	if fromBalance, err = updateUserBalance(ctx, ub.data, fromUserId, "balance_usdt", -amount, true); nil != err {
+		return nil, err
+	}
+
+	fromRecord := &UserBalanceRecord{
+		UserId:   fromBalance.UserId,
+		Balance:  fromBalance.BalanceUsdt,
+		Amount:   -amount,
+		Type:     "transfer",
+		CoinType: "usdt",
+		Source:   "out",
+	}
+	if err = ub.data.DB(ctx).Table("user_balance_record").Create(fromRecord).Error; err != nil {
+		return nil, err
+	}
+
+	toBalance, err = updateUserBalance(ctx, ub.data, toUserId, "balance_usdt", amount, false)
+	if nil != err {
+		return nil, err
+	}
+
+	toRecord := &UserBalanceRecord{
+		UserId:   toBalance.UserId,
+		Balance:  toBalance.BalanceUsdt,
+		Amount:   amount,
+		Type:     "transfer",
+		CoinType: "usdt",
+		Source:   "in",
+	}
+	if err = ub.data.DB(ctx).Table("user_balance_record").Create(toRecord).Error; err != nil {
+		return nil, err
+	}
+
+	transfer := &Transfer{
+		FromUserId:   fromUserId,
+		ToUserId:     toUserId,
+		Amount:       amount,
+		CoinType:     "usdt",
+		FromRecordId: fromRecord.ID,
+		ToRecordId:   toRecord.ID,
+	}
+	if err = ub.data.DB(ctx).Table("transfer").Create(transfer).Error; err != nil {
+		return nil, errors.New(500, "CREATE_TRANSFER_ERROR", "转账记录创建失败")
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "transfer", transfer.ID, toRecord.ID, "usdt", ledgerUser(fromUserId), ledgerUser(toUserId), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.Transfer{
+		ID:           transfer.ID,
+		FromUserId:   transfer.FromUserId,
+		ToUserId:     transfer.ToUserId,
+		Amount:       transfer.Amount,
+		CoinType:     transfer.CoinType,
+		FromRecordId: transfer.FromRecordId,
+		ToRecordId:   transfer.ToRecordId,
+		CreatedAt:    transfer.CreatedAt,
+	}, nil
+}
+
+// GetTransferTotalToday 今天转出的金额和次数 .
+func (ub *UserBalanceRepo) GetTransferTotalToday(ctx context.Context, userId int64) (int64, int64, error) {
+	var total struct {
+		Total int64
+		Num   int64
+	}
+	now := time.Now().UTC()
+	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if err := ub.data.DB(ctx).Table("transfer").
+		Where("from_user_id=?", userId).
+		Where("created_at>=?", todayStart).
+		Select("coalesce(sum(amount), 0) as total, count(*) as num").Take(&total).Error; err != nil {
+		return 0, 0, errors.New(500, "TRANSFER ERROR", err.Error())
+	}
+
+	return total.Total, total.Num, nil
+}
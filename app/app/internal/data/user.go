@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"strings"
 	"time"
@@ -24,10 +25,44 @@ type UserInfo struct {
 	UserId           int64     `gorm:"type:int;not null"`
 	Vip              int64     `gorm:"type:int;not null"`
 	HistoryRecommend int64     `gorm:"type:int;not null"`
+	AutoReinvest     int64     `gorm:"type:int;not null;default:0"`
+	VipExpire        time.Time `gorm:"type:datetime;default:null"`
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
 }
 
+type VipLevel struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	Level         int64     `gorm:"type:int;not null;uniqueIndex"`
+	NeedRecommend int64     `gorm:"type:int;not null;default:0"`
+	NeedTeam      int64     `gorm:"type:bigint;not null;default:0"`
+	NeedArea      int64     `gorm:"type:bigint;not null;default:0"`
+	Rate          int64     `gorm:"type:int;not null;default:0"`
+	ExpireDays    int64     `gorm:"type:int;not null;default:0"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type RecommendLevel struct {
+	ID                int64     `gorm:"primarykey;type:int"`
+	Level             int64     `gorm:"type:int;not null;uniqueIndex"`
+	Rate              int64     `gorm:"type:int;not null;default:0"`
+	NeedRecommend     int64     `gorm:"type:int;not null;default:0"`
+	NeedLocationLevel int64     `gorm:"type:int;not null;default:0"`
+	CreatedAt         time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt         time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserVipLog struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null;index"`
+	BeforeVip int64     `gorm:"type:int;not null"`
+	AfterVip  int64     `gorm:"type:int;not null"`
+	Reason    string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserRecommend struct {
 	ID            int64     `gorm:"primarykey;type:int"`
 	UserId        int64     `gorm:"type:int;not null"`
@@ -36,6 +71,41 @@ type UserRecommend struct {
 	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
 }
 
+type UserRecommendTree struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	AncestorId   int64     `gorm:"type:int;not null;index:idx_ancestor_depth,priority:1"`
+	DescendantId int64     `gorm:"type:int;not null;index"`
+	Depth        int64     `gorm:"type:int;not null;index:idx_ancestor_depth,priority:2"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserTeamStats struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	UserId        int64     `gorm:"type:int;not null;uniqueIndex"`
+	TeamNum       int64     `gorm:"type:int;not null;default:0"`
+	ActiveNum     int64     `gorm:"type:int;not null;default:0"`
+	OwnAmount     int64     `gorm:"type:bigint;not null;default:0"`
+	TeamAmount    int64     `gorm:"type:bigint;not null;default:0"`
+	MaxAreaAmount int64     `gorm:"type:bigint;not null;default:0"`
+	AreaAmount    int64     `gorm:"type:bigint;not null;default:0"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserMoveLog struct {
+	ID                 int64     `gorm:"primarykey;type:int"`
+	UserId             int64     `gorm:"type:int;not null;index"`
+	OldRecommendUserId int64     `gorm:"type:int;not null"`
+	NewRecommendUserId int64     `gorm:"type:int;not null"`
+	OldRecommendCode   string    `gorm:"type:varchar(10000);not null"`
+	NewRecommendCode   string    `gorm:"type:varchar(10000);not null"`
+	Num                int64     `gorm:"type:int;not null"`
+	Reason             string    `gorm:"type:varchar(255);not null"`
+	CreatedAt          time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt          time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserCurrentMonthRecommend struct {
 	ID              int64     `gorm:"primarykey;type:int"`
 	UserId          int64     `gorm:"type:int;not null"`
@@ -59,6 +129,7 @@ type UserBalance struct {
 	UserId      int64     `gorm:"type:int"`
 	BalanceUsdt int64     `gorm:"type:bigint"`
 	BalanceDhb  int64     `gorm:"type:bigint"`
+	Version     int64     `gorm:"type:int;not null;default:0"`
 	CreatedAt   time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
 }
@@ -71,6 +142,7 @@ type Withdraw struct {
 	Status          string    `gorm:"type:varchar(45);not null"`
 	Type            string    `gorm:"type:varchar(45);not null"`
 	BalanceRecordId int64     `gorm:"type:int"`
+	TxHash          string    `gorm:"type:varchar(100);not null"`
 	CreatedAt       time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
@@ -82,6 +154,7 @@ type UserBalanceRecord struct {
 	Amount    int64     `gorm:"type:bigint"`
 	Type      string    `gorm:"type:varchar(45);not null"`
 	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Source    string    `gorm:"type:varchar(45);not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -90,6 +163,7 @@ type Reward struct {
 	ID               int64     `gorm:"primarykey;type:int"`
 	UserId           int64     `gorm:"type:int;not null"`
 	Amount           int64     `gorm:"type:bigint;not null"`
+	AmountDhb        int64     `gorm:"type:bigint;not null;default:0"`
 	BalanceRecordId  int64     `gorm:"type:int;not null"`
 	Type             string    `gorm:"type:varchar(45);not null"`
 	TypeRecordId     int64     `gorm:"type:int;not null"`
@@ -293,6 +367,8 @@ func (ui *UserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (
 		UserId:           userInfo.UserId,
 		Vip:              userInfo.Vip,
 		HistoryRecommend: userInfo.HistoryRecommend,
+		AutoReinvest:     userInfo.AutoReinvest,
+		VipExpire:        userInfo.VipExpire,
 	}, nil
 }
 
@@ -403,43 +479,6 @@ func (u *UserRepo) GetUsers(ctx context.Context, b *biz.Pagination, address stri
 	return res, nil, count
 }
 
-// GetUserRecommendLowArea .
-func (ur *UserRecommendRepo) GetUserRecommendLowArea(ctx context.Context, code string) ([]*biz.UserRecommendArea, error) {
-
-	//var firstRecommendArea *UserRecommendArea
-	//if err := ur.data.db.Order("num desc").Table("user_recommend_area").First(&firstRecommendArea).Error; err != nil {
-	//	if errors.Is(err, gorm.ErrRecordNotFound) {
-	//		return nil, errors.New(500, "USER RECOMMEND NOT FOUND", err.Error())
-	//	}
-	//
-	//	return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
-	//}
-
-	var myUserRecommendAreas []*UserRecommendArea
-	if err := ur.data.db.Where("recommend_code like ?", code+"%").Table("user_recommend_area").Find(&myUserRecommendAreas).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New(500, "USER RECOMMEND NOT FOUND", err.Error())
-		}
-
-		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
-	}
-
-	res := make([]*biz.UserRecommendArea, 0)
-	for _, v := range myUserRecommendAreas {
-		//if firstRecommendArea.ID == v.ID {
-		//	continue
-		//}
-
-		res = append(res, &biz.UserRecommendArea{
-			ID:            v.ID,
-			RecommendCode: v.RecommendCode,
-			Num:           v.Num,
-		})
-	}
-
-	return res, nil
-}
-
 // CreateUser .
 func (u *UserRepo) CreateUser(ctx context.Context, uc *biz.User) (*biz.User, error) {
 	var user User
@@ -492,6 +531,171 @@ func (ui *UserInfoRepo) UpdateUserInfo(ctx context.Context, u *biz.UserInfo) (*b
 	}, nil
 }
 
+// UpdateUserInfoAutoReinvest .
+func (ui *UserInfoRepo) UpdateUserInfoAutoReinvest(ctx context.Context, userId int64, level int64) error {
+	res := ui.data.DB(ctx).Table("user_info").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"auto_reinvest": level})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
+// UpdateUserInfoVip .
+func (ui *UserInfoRepo) UpdateUserInfoVip(ctx context.Context, userId int64, vip int64, vipExpire time.Time) error {
+	var tmpVipExpire interface{}
+	if !vipExpire.IsZero() {
+		tmpVipExpire = vipExpire
+	}
+
+	res := ui.data.DB(ctx).Table("user_info").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"vip": vip, "vip_expire": tmpVipExpire})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
+// UpdateUserInfoHistoryRecommend .
+func (ui *UserInfoRepo) UpdateUserInfoHistoryRecommend(ctx context.Context, userId int64, historyRecommend int64) error {
+	res := ui.data.DB(ctx).Table("user_info").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"history_recommend": historyRecommend})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
+// GetVipLevels .
+func (ui *UserInfoRepo) GetVipLevels(ctx context.Context) ([]*biz.VipLevel, error) {
+	var vipLevels []*VipLevel
+	if err := ui.data.db.Table("vip_level").Order("level asc").Find(&vipLevels).Error; err != nil {
+		return nil, errors.New(500, "VIP LEVEL ERROR", err.Error())
+	}
+
+	res := make([]*biz.VipLevel, 0)
+	for _, vipLevel := range vipLevels {
+		res = append(res, &biz.VipLevel{
+			ID:            vipLevel.ID,
+			Level:         vipLevel.Level,
+			NeedRecommend: vipLevel.NeedRecommend,
+			NeedTeam:      vipLevel.NeedTeam,
+			NeedArea:      vipLevel.NeedArea,
+			Rate:          vipLevel.Rate,
+			ExpireDays:    vipLevel.ExpireDays,
+		})
+	}
+
+	return res, nil
+}
+
+// SaveVipLevel 按等级新增或修改 .
+func (ui *UserInfoRepo) SaveVipLevel(ctx context.Context, vl *biz.VipLevel) error {
+	var vipLevel VipLevel
+	if err := ui.data.DB(ctx).Table("vip_level").Where("level=?", vl.Level).First(&vipLevel).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New(500, "VIP LEVEL ERROR", err.Error())
+		}
+
+		vipLevel.Level = vl.Level
+		vipLevel.NeedRecommend = vl.NeedRecommend
+		vipLevel.NeedTeam = vl.NeedTeam
+		vipLevel.NeedArea = vl.NeedArea
+		vipLevel.Rate = vl.Rate
+		vipLevel.ExpireDays = vl.ExpireDays
+		if err = ui.data.DB(ctx).Table("vip_level").Create(&vipLevel).Error; err != nil {
+			return errors.New(500, "CREATE_VIP_LEVEL_ERROR", "会员等级创建失败")
+		}
+
+		return nil
+	}
+
+	res := ui.data.DB(ctx).Table("vip_level").Where("id=?", vipLevel.ID).
+		Updates(map[string]interface{}{
+			"need_recommend": vl.NeedRecommend,
+			"need_team":      vl.NeedTeam,
+			"need_area":      vl.NeedArea,
+			"rate":           vl.Rate,
+			"expire_days":    vl.ExpireDays,
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_VIP_LEVEL_ERROR", "会员等级修改失败")
+	}
+
+	return nil
+}
+
+// CreateUserVipLog .
+func (ui *UserInfoRepo) CreateUserVipLog(ctx context.Context, l *biz.UserVipLog) error {
+	var userVipLog UserVipLog
+	userVipLog.UserId = l.UserId
+	userVipLog.BeforeVip = l.BeforeVip
+	userVipLog.AfterVip = l.AfterVip
+	userVipLog.Reason = l.Reason
+	if err := ui.data.DB(ctx).Table("user_vip_log").Create(&userVipLog).Error; err != nil {
+		return errors.New(500, "CREATE_USER_VIP_LOG_ERROR", "会员等级记录创建失败")
+	}
+
+	return nil
+}
+
+// GetUserVipLogs .
+func (ui *UserInfoRepo) GetUserVipLogs(ctx context.Context, b *biz.Pagination, userId int64) ([]*biz.UserVipLog, error, int64) {
+	var (
+		userVipLogs []*UserVipLog
+		count       int64
+	)
+	res := make([]*biz.UserVipLog, 0)
+
+	instance := ui.data.db.Table("user_vip_log")
+
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&userVipLogs).Error; err != nil {
+		return nil, errors.New(500, "USER VIP LOG ERROR", err.Error()), 0
+	}
+
+	for _, userVipLog := range userVipLogs {
+		res = append(res, &biz.UserVipLog{
+			ID:        userVipLog.ID,
+			UserId:    userVipLog.UserId,
+			BeforeVip: userVipLog.BeforeVip,
+			AfterVip:  userVipLog.AfterVip,
+			Reason:    userVipLog.Reason,
+			CreatedAt: userVipLog.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetRecommendLevels .
+func (ur *UserRecommendRepo) GetRecommendLevels(ctx context.Context) ([]*biz.RecommendLevel, error) {
+	var recommendLevels []*RecommendLevel
+	if err := ur.data.db.Table("recommend_level").Order("level asc").Find(&recommendLevels).Error; err != nil {
+		return nil, errors.New(500, "RECOMMEND LEVEL ERROR", err.Error())
+	}
+
+	res := make([]*biz.RecommendLevel, 0)
+	for _, recommendLevel := range recommendLevels {
+		res = append(res, &biz.RecommendLevel{
+			ID:                recommendLevel.ID,
+			Level:             recommendLevel.Level,
+			Rate:              recommendLevel.Rate,
+			NeedRecommend:     recommendLevel.NeedRecommend,
+			NeedLocationLevel: recommendLevel.NeedLocationLevel,
+		})
+	}
+
+	return res, nil
+}
+
 // GetUserRecommendByUserId .
 func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*biz.UserRecommend, error) {
 	var userRecommend UserRecommend
@@ -509,25 +713,37 @@ func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userI
 	}, nil
 }
 
-// GetUserRecommendByCode .
-func (ur *UserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code string) ([]*biz.UserRecommend, error) {
-	var (
-		userRecommends []*UserRecommend
-	)
-	res := make([]*biz.UserRecommend, 0)
-
-	instance := ur.data.db.Table("user_recommend").Where("recommend_code=?", code)
+// GetUserRecommendByUserIds .
+func (ur *UserRecommendRepo) GetUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*biz.UserRecommend, error) {
+	var userRecommends []*UserRecommend
+	res := make(map[int64]*biz.UserRecommend, 0)
+	if err := ur.data.db.Table("user_recommend").Where("user_id IN (?)", userIds).Find(&userRecommends).Error; err != nil {
+		return res, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
 
-	if err := instance.Find(&userRecommends).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
+	for _, userRecommend := range userRecommends {
+		res[userRecommend.UserId] = &biz.UserRecommend{
+			ID:            userRecommend.ID,
+			UserId:        userRecommend.UserId,
+			RecommendCode: userRecommend.RecommendCode,
+			CreatedAt:     userRecommend.CreatedAt,
 		}
+	}
+
+	return res, nil
+}
 
+// GetUserRecommends .
+func (ur *UserRecommendRepo) GetUserRecommends(ctx context.Context) ([]*biz.UserRecommend, error) {
+	var userRecommends []*UserRecommend
+	res := make([]*biz.UserRecommend, 0)
+	if err := ur.data.DB(ctx).Table("user_recommend").Find(&userRecommends).Error; err != nil {
 		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
 	}
 
 	for _, userRecommend := range userRecommends {
 		res = append(res, &biz.UserRecommend{
+			ID:            userRecommend.ID,
 			UserId:        userRecommend.UserId,
 			RecommendCode: userRecommend.RecommendCode,
 			CreatedAt:     userRecommend.CreatedAt,
@@ -537,22 +753,453 @@ func (ur *UserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code st
 	return res, nil
 }
 
-// GetUserRecommendLikeCode .
-func (ur *UserRecommendRepo) GetUserRecommendLikeCode(ctx context.Context, code string) ([]*biz.UserRecommend, error) {
-	var userRecommends []*UserRecommend
-	res := make([]*biz.UserRecommend, 0)
-	if err := ur.data.db.Where("recommend_code Like ?", code+"%").Table("user_recommend").Find(&userRecommends).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
+// CreateUserRecommendTree 新用户的上级是推荐人和推荐人的全部上级 .
+func (ur *UserRecommendRepo) CreateUserRecommendTree(ctx context.Context, u *biz.User, recommendUser *biz.UserRecommend) error {
+	if nil == recommendUser || 0 >= recommendUser.UserId {
+		return updateUserTeamStats(ctx, ur.data, u.ID, 1, 0, 0)
+	}
+
+	var ancestors []*UserRecommendTree
+	if err := ur.data.DB(ctx).Table("user_recommend_tree").Where("descendant_id=?", recommendUser.UserId).Find(&ancestors).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	userRecommendTrees := []*UserRecommendTree{{
+		AncestorId:   recommendUser.UserId,
+		DescendantId: u.ID,
+		Depth:        1,
+	}}
+	for _, v := range ancestors {
+		userRecommendTrees = append(userRecommendTrees, &UserRecommendTree{
+			AncestorId:   v.AncestorId,
+			DescendantId: u.ID,
+			Depth:        v.Depth + 1,
+		})
+	}
+
+	if err := ur.data.DB(ctx).Table("user_recommend_tree").CreateInBatches(&userRecommendTrees, 100).Error; err != nil {
+		return errors.New(500, "CREATE_USER_RECOMMEND_TREE_ERROR", "用户推荐上下级关系创建失败")
+	}
+
+	return updateUserTeamStats(ctx, ur.data, u.ID, 1, 0, 0)
+}
+
+// ResetUserRecommendTree 事务中使用，上级由近到远 .
+func (ur *UserRecommendRepo) ResetUserRecommendTree(ctx context.Context, userId int64, upUserIds []int64) error {
+	if err := ur.data.DB(ctx).Table("user_recommend_tree").Where("descendant_id=?", userId).Delete(&UserRecommendTree{}).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	if 0 == len(upUserIds) {
+		return nil
+	}
+
+	userRecommendTrees := make([]*UserRecommendTree, 0)
+	for i, v := range upUserIds {
+		userRecommendTrees = append(userRecommendTrees, &UserRecommendTree{
+			AncestorId:   v,
+			DescendantId: userId,
+			Depth:        int64(i + 1),
+		})
+	}
+
+	if err := ur.data.DB(ctx).Table("user_recommend_tree").CreateInBatches(&userRecommendTrees, 100).Error; err != nil {
+		return errors.New(500, "CREATE_USER_RECOMMEND_TREE_ERROR", "用户推荐上下级关系创建失败")
+	}
+
+	return nil
+}
+
+// UpdateUserRecommendCode 事务中使用 .
+func (ur *UserRecommendRepo) UpdateUserRecommendCode(ctx context.Context, userId int64, code string) error {
+	res := ur.data.DB(ctx).Table("user_recommend").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"recommend_code": code})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
+	}
+
+	return nil
+}
+
+// MoveUserRecommendArea 事务中使用，oldPrefix 开头的链路改为 newPrefix 开头 .
+func (ur *UserRecommendRepo) MoveUserRecommendArea(ctx context.Context, oldPrefix string, newPrefix string, oldRecommendCode string) error {
+	var userRecommendAreas []*UserRecommendArea
+	if err := ur.data.DB(ctx).Table("user_recommend_area").
+		Where("recommend_code=? or recommend_code like ?", oldPrefix, oldPrefix+"D%").
+		Find(&userRecommendAreas).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	for _, v := range userRecommendAreas {
+		tmpCode := newPrefix + v.RecommendCode[len(oldPrefix):]
+		res := ur.data.DB(ctx).Table("user_recommend_area").
+			Where("id=? and version=?", v.ID, v.Version).
+			Updates(map[string]interface{}{"version": gorm.Expr("version + ?", 1), "num": int64(len(strings.Split(tmpCode, "D")) - 1), "recommend_code": tmpCode})
+		if 0 == res.RowsAffected || nil != res.Error {
+			return errors.New(500, "UPDATE_USER_RECOMMEND_AREA_ERROR", "用户推荐关系链路修改失败")
 		}
+	}
 
-		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	// 新推荐人原来在链路末尾的，已经被移过来的链路接上
+	newRecommendCode := newPrefix[:strings.LastIndex(newPrefix, "D")]
+	if err := ur.data.DB(ctx).Table("user_recommend_area").Where("recommend_code=?", newRecommendCode).Delete(&UserRecommendArea{}).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND ERROR", err.Error())
 	}
 
-	for _, userRecommend := range userRecommends {
-		res = append(res, &biz.UserRecommend{
-			UserId:        userRecommend.UserId,
-			RecommendCode: userRecommend.RecommendCode,
+	// 原推荐人没有别的链路了，补一条到自己为止的
+	if "" == oldRecommendCode {
+		return nil
+	}
+	var count int64
+	if err := ur.data.DB(ctx).Table("user_recommend_area").
+		Where("recommend_code=? or recommend_code like ?", oldRecommendCode, oldRecommendCode+"D%").
+		Count(&count).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+	if 0 == count {
+		var userRecommendArea UserRecommendArea
+		userRecommendArea.RecommendCode = oldRecommendCode
+		userRecommendArea.Num = int64(len(strings.Split(oldRecommendCode, "D")) - 1)
+		if err := ur.data.DB(ctx).Table("user_recommend_area").Create(&userRecommendArea).Error; err != nil {
+			return errors.New(500, "CREATE_USER_RECOMMEND_AREA_ERROR", "用户推荐关系链路创建失败")
+		}
+	}
+
+	return nil
+}
+
+// GetUserRecommendAreas .
+func (ur *UserRecommendRepo) GetUserRecommendAreas(ctx context.Context) ([]*biz.UserRecommendArea, error) {
+	var userRecommendAreas []*UserRecommendArea
+	res := make([]*biz.UserRecommendArea, 0)
+	if err := ur.data.db.Table("user_recommend_area").Order("id asc").Find(&userRecommendAreas).Error; err != nil {
+		return res, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	for _, v := range userRecommendAreas {
+		res = append(res, &biz.UserRecommendArea{
+			ID:            v.ID,
+			RecommendCode: v.RecommendCode,
+			Num:           v.Num,
+			CreatedAt:     v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// FixUserRecommendArea 事务中使用，删除多余的，ID为0的新建，其他的改人数 .
+func (ur *UserRecommendRepo) FixUserRecommendArea(ctx context.Context, deleteIds []int64, areas []*biz.UserRecommendArea) error {
+	if 0 < len(deleteIds) {
+		if err := ur.data.DB(ctx).Table("user_recommend_area").Where("id in (?)", deleteIds).Delete(&UserRecommendArea{}).Error; err != nil {
+			return errors.New(500, "DELETE_USER_RECOMMEND_AREA_ERROR", "用户推荐关系链路删除失败")
+		}
+	}
+
+	for _, v := range areas {
+		if 0 == v.ID {
+			var userRecommendArea UserRecommendArea
+			userRecommendArea.RecommendCode = v.RecommendCode
+			userRecommendArea.Num = v.Num
+			if err := ur.data.DB(ctx).Table("user_recommend_area").Create(&userRecommendArea).Error; err != nil {
+				return errors.New(500, "CREATE_USER_RECOMMEND_AREA_ERROR", "用户推荐关系链路创建失败")
+			}
+			continue
+		}
+
+		res := ur.data.DB(ctx).Table("user_recommend_area").Where("id=?", v.ID).
+			Updates(map[string]interface{}{"version": gorm.Expr("version + ?", 1), "num": v.Num})
+		if nil != res.Error {
+			return errors.New(500, "UPDATE_USER_RECOMMEND_AREA_ERROR", "用户推荐关系链路修改失败")
+		}
+	}
+
+	return nil
+}
+
+// CreateUserMoveLog .
+func (ur *UserRecommendRepo) CreateUserMoveLog(ctx context.Context, l *biz.UserMoveLog) error {
+	var userMoveLog UserMoveLog
+	userMoveLog.UserId = l.UserId
+	userMoveLog.OldRecommendUserId = l.OldRecommendUserId
+	userMoveLog.NewRecommendUserId = l.NewRecommendUserId
+	userMoveLog.OldRecommendCode = l.OldRecommendCode
+	userMoveLog.NewRecommendCode = l.NewRecommendCode
+	userMoveLog.Num = l.Num
+	userMoveLog.Reason = l.Reason
+	if err := ur.data.DB(ctx).Table("user_move_log").Create(&userMoveLog).Error; err != nil {
+		return errors.New(500, "CREATE_USER_MOVE_LOG_ERROR", "调整推荐人记录创建失败")
+	}
+
+	return nil
+}
+
+// updateUserTeamStats 事务中使用，修改用户自己的业绩和全部上级的团队统计 .
+func updateUserTeamStats(ctx context.Context, d *Data, userId int64, teamNum int64, activeNum int64, amount int64) error {
+	var ancestors []*UserRecommendTree
+	if err := d.DB(ctx).Table("user_recommend_tree").Where("descendant_id=?", userId).Order("depth asc").Find(&ancestors).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	// 自己和上级，由近到远
+	userIds := []int64{userId}
+	for _, v := range ancestors {
+		userIds = append(userIds, v.AncestorId)
+	}
+
+	// 没有统计的先创建
+	newStats := make([]*UserTeamStats, 0)
+	for _, v := range userIds {
+		newStats = append(newStats, &UserTeamStats{UserId: v})
+	}
+	if err := d.DB(ctx).Table("user_team_stats").Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&newStats, 100).Error; err != nil {
+		return errors.New(500, "CREATE_USER_TEAM_STATS_ERROR", "团队统计创建失败")
+	}
+
+	if 0 != amount {
+		if err := d.DB(ctx).Table("user_team_stats").Where("user_id=?", userId).
+			Updates(map[string]interface{}{"own_amount": gorm.Expr("own_amount + ?", amount)}).Error; err != nil {
+			return errors.New(500, "UPDATE_USER_TEAM_STATS_ERROR", "团队统计修改失败")
+		}
+	}
+
+	if 0 == len(ancestors) {
+		return nil
+	}
+
+	if err := d.DB(ctx).Table("user_team_stats").Where("user_id IN (?)", userIds[1:]).
+		Updates(map[string]interface{}{
+			"team_num":    gorm.Expr("team_num + ?", teamNum),
+			"active_num":  gorm.Expr("active_num + ?", activeNum),
+			"team_amount": gorm.Expr("team_amount + ?", amount),
+			"area_amount": gorm.Expr("area_amount + ?", amount),
+		}).Error; err != nil {
+		return errors.New(500, "UPDATE_USER_TEAM_STATS_ERROR", "团队统计修改失败")
+	}
+
+	if 0 == amount {
+		return nil
+	}
+
+	if 0 > amount { // 减业绩时原来的大区可能不再最大，按每条直推线重新算
+		for _, v := range ancestors {
+			var maxLeg struct {
+				Amount int64
+			}
+			if err := d.DB(ctx).Table("user_team_stats").
+				Select("ifnull(max(user_team_stats.own_amount + user_team_stats.team_amount), 0) as amount").
+				Joins("join user_recommend_tree on user_recommend_tree.descendant_id = user_team_stats.user_id").
+				Where("user_recommend_tree.ancestor_id=? and user_recommend_tree.depth=?", v.AncestorId, 1).
+				Scan(&maxLeg).Error; err != nil {
+				return errors.New(500, "USER TEAM STATS ERROR", err.Error())
+			}
+
+			if err := d.DB(ctx).Table("user_team_stats").Where("user_id=?", v.AncestorId).
+				Updates(map[string]interface{}{
+					"max_area_amount": maxLeg.Amount,
+					"area_amount":     gorm.Expr("team_amount - ?", maxLeg.Amount),
+				}).Error; err != nil {
+				return errors.New(500, "UPDATE_USER_TEAM_STATS_ERROR", "团队统计修改失败")
+			}
+		}
+
+		return nil
+	}
+
+	// 每个上级经过的那条线的业绩是线上直推人自己加团队的业绩，超过原来的最大线就换成大区
+	var legs []*UserTeamStats
+	if err := d.DB(ctx).Table("user_team_stats").Where("user_id IN (?)", userIds[:len(userIds)-1]).Find(&legs).Error; err != nil {
+		return errors.New(500, "USER TEAM STATS ERROR", err.Error())
+	}
+	legAmount := make(map[int64]int64, 0)
+	for _, v := range legs {
+		legAmount[v.UserId] = v.OwnAmount + v.TeamAmount
+	}
+
+	for i, v := range ancestors {
+		if err := d.DB(ctx).Table("user_team_stats").Where("user_id=?", v.AncestorId).Where("max_area_amount<?", legAmount[userIds[i]]).
+			Updates(map[string]interface{}{
+				"max_area_amount": legAmount[userIds[i]],
+				"area_amount":     gorm.Expr("team_amount - ?", legAmount[userIds[i]]),
+			}).Error; err != nil {
+			return errors.New(500, "UPDATE_USER_TEAM_STATS_ERROR", "团队统计修改失败")
+		}
+	}
+
+	return nil
+}
+
+// GetUserTeamStatsByUserIds .
+func (ur *UserRecommendRepo) GetUserTeamStatsByUserIds(ctx context.Context, userIds ...int64) (map[int64]*biz.UserTeamStats, error) {
+	var userTeamStats []*UserTeamStats
+	res := make(map[int64]*biz.UserTeamStats, 0)
+	if err := ur.data.db.Table("user_team_stats").Where("user_id IN (?)", userIds).Find(&userTeamStats).Error; err != nil {
+		return res, errors.New(500, "USER TEAM STATS ERROR", err.Error())
+	}
+
+	for _, v := range userTeamStats {
+		res[v.UserId] = &biz.UserTeamStats{
+			ID:            v.ID,
+			UserId:        v.UserId,
+			TeamNum:       v.TeamNum,
+			ActiveNum:     v.ActiveNum,
+			OwnAmount:     v.OwnAmount,
+			TeamAmount:    v.TeamAmount,
+			MaxAreaAmount: v.MaxAreaAmount,
+			AreaAmount:    v.AreaAmount,
+		}
+	}
+
+	return res, nil
+}
+
+// LockUserTeamStats 事务中使用，锁住全部团队统计，重建期间增量维护等待 .
+func (ur *UserRecommendRepo) LockUserTeamStats(ctx context.Context) error {
+	var ids []int64
+	if err := ur.data.DB(ctx).Table("user_team_stats").Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &ids).Error; err != nil {
+		return errors.New(500, "USER TEAM STATS ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// SaveUserTeamStats 按用户覆盖 .
+func (ur *UserRecommendRepo) SaveUserTeamStats(ctx context.Context, stats []*biz.UserTeamStats) error {
+	if 0 == len(stats) {
+		return nil
+	}
+
+	userTeamStats := make([]*UserTeamStats, 0)
+	for _, v := range stats {
+		userTeamStats = append(userTeamStats, &UserTeamStats{
+			UserId:        v.UserId,
+			TeamNum:       v.TeamNum,
+			ActiveNum:     v.ActiveNum,
+			OwnAmount:     v.OwnAmount,
+			TeamAmount:    v.TeamAmount,
+			MaxAreaAmount: v.MaxAreaAmount,
+			AreaAmount:    v.AreaAmount,
+		})
+	}
+
+	if err := ur.data.DB(ctx).Table("user_team_stats").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"team_num", "active_num", "own_amount", "team_amount", "max_area_amount", "area_amount", "updated_at"}),
+	}).CreateInBatches(&userTeamStats, 100).Error; err != nil {
+		return errors.New(500, "SAVE_USER_TEAM_STATS_ERROR", "团队统计保存失败")
+	}
+
+	return nil
+}
+
+// GetUserRecommendDepthStats 每层下级人数和有运行中占位的人数 .
+func (ur *UserRecommendRepo) GetUserRecommendDepthStats(ctx context.Context, userId int64) ([]*biz.UserRecommendDepth, error) {
+	var depths []*struct {
+		Depth     int64
+		Num       int64
+		ActiveNum int64
+	}
+	res := make([]*biz.UserRecommendDepth, 0)
+	if err := ur.data.db.Table("user_recommend_tree as t").
+		Select("t.depth, count(*) as num, ifnull(sum(exists(select 1 from location as l where l.user_id=t.descendant_id and l.status=?)), 0) as active_num", "running").
+		Where("t.ancestor_id=?", userId).
+		Group("t.depth").Order("t.depth asc").Scan(&depths).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	for _, v := range depths {
+		res = append(res, &biz.UserRecommendDepth{
+			Depth:     v.Depth,
+			Num:       v.Num,
+			ActiveNum: v.ActiveNum,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserRecommendChildren 直推分页，按直推所在线的团队统计排序 .
+func (ur *UserRecommendRepo) GetUserRecommendChildren(ctx context.Context, b *biz.Pagination, userId int64, sort string) ([]*biz.UserRecommendTree, error, int64) {
+	var (
+		userRecommendTrees []*UserRecommendTree
+		count              int64
+	)
+	res := make([]*biz.UserRecommendTree, 0)
+
+	if err := ur.data.db.Table("user_recommend_tree").Where("ancestor_id=? and depth=?", userId, 1).Count(&count).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND TREE ERROR", err.Error()), 0
+	}
+
+	// 一条线的人数不含直推自己，运行人数和业绩含直推自己
+	instance := ur.data.db.Table("user_recommend_tree as t").Select("t.*").
+		Joins("left join user_team_stats as s on s.user_id=t.descendant_id").
+		Where("t.ancestor_id=? and t.depth=?", userId, 1)
+	if "team_num" == sort {
+		instance = instance.Order("ifnull(s.team_num, 0) desc")
+	} else if "active_num" == sort {
+		instance = instance.Order("ifnull(s.active_num, 0) + exists(select 1 from location as l where l.user_id=t.descendant_id and l.status='running') desc")
+	} else if "team_amount" == sort {
+		instance = instance.Order("ifnull(s.own_amount, 0) + ifnull(s.team_amount, 0) desc")
+	}
+	if err := instance.Order("t.id desc").Scopes(Paginate(b.PageNum, b.PageSize)).Find(&userRecommendTrees).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND TREE ERROR", err.Error()), 0
+	}
+
+	for _, v := range userRecommendTrees {
+		res = append(res, &biz.UserRecommendTree{
+			ID:           v.ID,
+			AncestorId:   v.AncestorId,
+			DescendantId: v.DescendantId,
+			Depth:        v.Depth,
+			CreatedAt:    v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetUserRecommendAncestors 上级由近到远 .
+func (ur *UserRecommendRepo) GetUserRecommendAncestors(ctx context.Context, userId int64) ([]*biz.UserRecommendTree, error) {
+	var userRecommendTrees []*UserRecommendTree
+	res := make([]*biz.UserRecommendTree, 0)
+	if err := ur.data.db.Table("user_recommend_tree").Where("descendant_id=?", userId).Order("depth asc").Find(&userRecommendTrees).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	for _, v := range userRecommendTrees {
+		res = append(res, &biz.UserRecommendTree{
+			ID:           v.ID,
+			AncestorId:   v.AncestorId,
+			DescendantId: v.DescendantId,
+			Depth:        v.Depth,
+			CreatedAt:    v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserRecommendDescendants depth为0查全部下级 .
+func (ur *UserRecommendRepo) GetUserRecommendDescendants(ctx context.Context, userId int64, depth int64) ([]*biz.UserRecommendTree, error) {
+	var userRecommendTrees []*UserRecommendTree
+	res := make([]*biz.UserRecommendTree, 0)
+
+	instance := ur.data.db.Table("user_recommend_tree").Where("ancestor_id=?", userId)
+	if 0 < depth {
+		instance = instance.Where("depth=?", depth)
+	}
+
+	if err := instance.Order("id asc").Find(&userRecommendTrees).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND TREE ERROR", err.Error())
+	}
+
+	for _, v := range userRecommendTrees {
+		res = append(res, &biz.UserRecommendTree{
+			ID:           v.ID,
+			AncestorId:   v.AncestorId,
+			DescendantId: v.DescendantId,
+			Depth:        v.Depth,
+			CreatedAt:    v.CreatedAt,
 		})
 	}
 
@@ -621,18 +1268,63 @@ func (ub UserBalanceRepo) GetUserBalance(ctx context.Context, userId int64) (*bi
 	}, nil
 }
 
-// LocationReward .
-func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
-	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+// LockUserBalance 事务中使用，锁住用户余额到事务结束，同一用户的入单排队 .
+func (ub *UserBalanceRepo) LockUserBalance(ctx context.Context, userId int64) error {
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Table("user_balance").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).First(&userBalance).Error; err != nil {
+		return errors.NotFound("user balance err", "user balance not found")
 	}
 
+	return nil
+}
+
+// updateUserBalance 余额变动都走这里，事务中先锁行再按version更新，guard为true时不能扣成负数
+func updateUserBalance(ctx context.Context, d *Data, userId int64, column string, amount int64, guard bool) (*UserBalance, error) {
 	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	for i := 0; i < 3; i++ { // 不在事务中时锁随语句释放，version冲突重试
+		if err := d.DB(ctx).Table("user_balance").Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id=?", userId).First(&userBalance).Error; err != nil {
+			return nil, errors.NotFound("user balance err", "user balance not found")
+		}
+
+		tmpBalance := userBalance.BalanceUsdt
+		if "balance_dhb" == column {
+			tmpBalance = userBalance.BalanceDhb
+		}
+		if guard && 0 > tmpBalance+amount {
+			return nil, errors.New(500, "BALANCE_NOT_ENOUGH", "余额不足")
+		}
+
+		res := d.DB(ctx).Table("user_balance").
+			Where("id=? and version=?", userBalance.ID, userBalance.Version).
+			Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount), "version": gorm.Expr("version + 1")})
+		if nil != res.Error {
+			return nil, errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额修改失败")
+		}
+		if 0 == res.RowsAffected {
+			continue
+		}
+
+		if "balance_dhb" == column {
+			userBalance.BalanceDhb += amount
+		} else {
+			userBalance.BalanceUsdt += amount
+		}
+		userBalance.Version++
+		return &userBalance, nil
+	}
+
+	return nil, errors.New(500, "USER_BALANCE_VERSION_CONFLICT", "余额变动频繁，请重试")
+}
+
+// LocationReward .
+func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
+	var err error
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -640,7 +1332,8 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -649,6 +1342,7 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
@@ -660,21 +1354,26 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "location_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "location_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // WithdrawReward .
 func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -682,7 +1381,8 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -691,6 +1391,7 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
@@ -702,6 +1403,16 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "withdraw_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "withdraw_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
@@ -725,37 +1436,69 @@ func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "deposit"
 	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Source = "chain"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "deposit", userBalanceRecode.ID, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountHotWallet), ledgerSystem(ledgerAccountSuspense), amount)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
-// DepositLast .
-func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error) {
-	var (
-		err error
-	)
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", lastAmount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+// DepositInternal 余额入单 .
+func (ub *UserBalanceRepo) DepositInternal(ctx context.Context, userId int64, amount int64) (int64, error) {
+	var err error
+	var userBalance *UserBalance
+	if userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", -amount, true); nil != err {
+		return 0, errors.New(500, "BALANCE_NOT_ENOUGH", "余额不足")
 	}
 
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "deposit"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Source = "internal"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "deposit_internal", userBalanceRecode.ID, userBalanceRecode.ID, "usdt", ledgerUser(userId), ledgerSystem(ledgerAccountSuspense), amount)
 	if err != nil {
 		return 0, err
 	}
 
+	return userBalanceRecode.ID, nil
+}
+
+// DepositLast 上一单超出的部分补给新占位，type_record_id记新占位，冲正时一起扣回 .
+func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64, newLocationId int64) (int64, error) {
+	var (
+		err error
+	)
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, lastAmount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
+		return 0, err
+	}
+
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = lastAmount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -764,36 +1507,42 @@ func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAm
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = lastAmount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
-	reward.Type = "last"          // 本次分红的行为类型
+	reward.Type = "last" // 本次分红的行为类型
+	reward.TypeRecordId = newLocationId
 	reward.Reason = "last_reward" // 给我分红的理由
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
-	res := ub.data.db.Table("location").
+	res := ub.data.DB(ctx).Table("location").
 		Where("id=?", locationId).
 		Updates(map[string]interface{}{"stop_location_again": "1"})
 	if 0 == res.RowsAffected || res.Error != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "last_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, lastAmount-usdtAmount, dhbAmount, "last_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // DepositDhb .
 func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_dhb", amount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -808,6 +1557,12 @@ func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "deposit", userBalanceRecode.ID, userBalanceRecode.ID, "dhb", ledgerSystem(ledgerAccountHotWallet), ledgerUser(userId), amount)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
@@ -834,18 +1589,11 @@ func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, s
 }
 
 // WithdrawUsdt .
-func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) error {
+func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_usdt>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
-		return err
+	var userBalance *UserBalance
+	if userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", -amount, true); nil != err {
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	var userBalanceRecode UserBalanceRecord
@@ -856,25 +1604,24 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "withdraw", userBalanceRecode.ID, userBalanceRecode.ID, "usdt", ledgerUser(userId), ledgerSystem(ledgerAccountSuspense), amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
 }
 
 // WithdrawDhb .
-func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) error {
+func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_dhb>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
-		return err
+	var userBalance *UserBalance
+	if userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_dhb", -amount, true); nil != err {
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	var userBalanceRecode UserBalanceRecord
@@ -885,18 +1632,25 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "withdraw", userBalanceRecode.ID, userBalanceRecode.ID, "dhb", ledgerUser(userId), ledgerSystem(ledgerAccountSuspense), amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
 }
 
 // GreateWithdraw .
-func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*biz.Withdraw, error) {
+func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, balanceRecordId int64) (*biz.Withdraw, error) {
 	var withdraw Withdraw
 	withdraw.UserId = userId
 	withdraw.Amount = amount
 	withdraw.Type = coinType
+	withdraw.BalanceRecordId = balanceRecordId
 	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -1076,17 +1830,12 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewarded(ctx context.Context) ([]*bi
 }
 
 // RecommendReward .
-func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -1094,7 +1843,8 @@ func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, am
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -1103,15 +1853,27 @@ func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, am
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "recommend_vip" // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "recommend_vip_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "recommend_vip_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
@@ -1132,9 +1894,70 @@ func (ub *UserBalanceRepo) SystemReward(ctx context.Context, amount int64, locat
 		return err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "system_reward", locationId, 0, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountRevenue), amount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SystemExitPenalty .
+func (ub *UserBalanceRepo) SystemExitPenalty(ctx context.Context, amount int64, locationId int64) error {
+	var (
+		reward Reward
+		err    error
+	)
+	reward.UserId = 999999999
+	reward.Amount = amount
+	reward.BalanceRecordId = 999999999
+	reward.Type = "exit" // 本次分红的行为类型
+	reward.TypeRecordId = locationId
+	reward.Reason = "system_exit_penalty" // 给我分红的理由
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "system_exit_penalty", locationId, 0, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountRevenue), amount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// ExitRefund 提前退出返还 .
+func (ub *UserBalanceRepo) ExitRefund(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+	var err error
+	var userBalance *UserBalance
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", amount, false)
+	if nil != err {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "exit"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "exit_refund", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // SystemWithdrawReward .
 func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int64, locationId int64) error {
 	var (
@@ -1152,6 +1975,12 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 		return err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "system_withdraw_reward", locationId, 0, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountRevenue), amount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1172,21 +2001,21 @@ func (ub *UserBalanceRepo) SystemFee(ctx context.Context, amount int64, location
 		return err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "system_fee", locationId, 0, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerSystem(ledgerAccountFeePool), amount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // UserFee .
-func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int64) (int64, error) {
+func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int64, myLocationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", amount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -1194,6 +2023,7 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1206,26 +2036,28 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward" // 本次分红的行为类型
 	reward.Reason = "fee"         // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "fee_reward", userBalanceRecode.ID, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountFeePool), ledgerUser(userId), amount)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // RecommendWithdrawReward .
-func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -1233,7 +2065,8 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -1242,30 +2075,37 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "recommend_vip" // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "recommend_vip_withdraw_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "recommend_vip_withdraw_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
 // NormalRecommendReward .
-func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
-	}
-
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
-	if err != nil {
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
 		return 0, err
 	}
 
@@ -1273,7 +2113,8 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -1282,38 +2123,95 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "location" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "recommend" // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "recommend_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "recommend_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
-// NormalWithdrawRecommendReward .
-func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+// LevelRecommendReward 多层级推荐分红 .
+func (ub *UserBalanceRepo) LevelRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, rewardType string, level int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
+		return 0, err
 	}
 
-	var userBalance UserBalance
-	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = rewardType // 本次分红的行为类型
+	reward.TypeRecordId = locationId
+	reward.Reason = "recommend_level" // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
+	reward.LocationType = "level" + strconv.FormatInt(level, 10)
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "recommend_level_"+rewardType+"_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "recommend_level_"+rewardType+"_reward", locationId)
 	if err != nil {
 		return 0, err
 	}
 
+	return userBalanceRecode.ID, nil
+}
+
+// NormalWithdrawRecommendReward .
+func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64) (int64, error) {
+	var err error
+	var userBalance *UserBalance
+	usdtAmount, dhbAmount := rewardDhbSplit(ctx, amount) // 按配置折一部分dhb
+	userBalance, err = updateUserBalance(ctx, ub.data, userId, "balance_usdt", usdtAmount, false)
+	if nil != err {
+		return 0, err
+	}
+
 	var userBalanceRecode UserBalanceRecord
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
-	userBalanceRecode.Amount = amount
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = usdtAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -1322,15 +2220,27 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, us
 	var reward Reward
 	reward.UserId = userBalance.UserId
 	reward.Amount = amount
+	reward.AmountDhb = dhbAmount
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "withdraw" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "recommend" // 给我分红的理由
+	reward.ReasonLocationId = myLocationId
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
 	}
 
+	// 记账
+	err = ledgerTransfer(ctx, ub.data, "recommend_withdraw_reward", locationId, userBalanceRecode.ID, "usdt", ledgerSystem(ledgerAccountSuspense), ledgerUser(userId), usdtAmount)
+	if err != nil {
+		return 0, err
+	}
+	err = rewardDhb(ctx, ub.data, userId, amount-usdtAmount, dhbAmount, "recommend_withdraw_reward", locationId)
+	if err != nil {
+		return 0, err
+	}
+
 	return userBalanceRecode.ID, nil
 }
 
@@ -1689,6 +2599,8 @@ func (ui *UserInfoRepo) GetUserInfoByUserIds(ctx context.Context, userIds ...int
 			UserId:           userInfo.UserId,
 			Vip:              userInfo.Vip,
 			HistoryRecommend: userInfo.HistoryRecommend,
+			AutoReinvest:     userInfo.AutoReinvest,
+			VipExpire:        userInfo.VipExpire,
 		}
 	}
 
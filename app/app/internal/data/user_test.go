@@ -0,0 +1,226 @@
+package data
+
+import (
+	"context"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 需要真实的mysql，DHB_TEST_MYSQL_DSN 指向一个测试库，没有配置时跳过
+// 例如 root:123456@tcp(127.0.0.1:3306)/dhb_test?charset=utf8mb4&parseTime=True&loc=UTC
+func newTestData(t *testing.T) *Data {
+	dsn := os.Getenv("DHB_TEST_MYSQL_DSN")
+	if "" == dsn {
+		t.Skip("DHB_TEST_MYSQL_DSN not set")
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	tables := map[string]interface{}{
+		"user_balance":        &UserBalance{},
+		"user_balance_record": &UserBalanceRecord{},
+		"reward":              &Reward{},
+		"ledger_entry":        &LedgerEntry{},
+		"ledger_posting":      &LedgerPosting{},
+	}
+	for name, model := range tables {
+		if err = db.Table(name).AutoMigrate(model); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	sqlDB, err := db.DB()
+	if nil != err {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(50)
+
+	return &Data{db: db}
+}
+
+// newTestUserBalance 每个用例一个新用户，避免和其他数据冲突
+func newTestUserBalance(t *testing.T, d *Data, balanceUsdt int64) *UserBalance {
+	userBalance := &UserBalance{
+		UserId:      100000000 + time.Now().UnixNano()%100000000,
+		BalanceUsdt: balanceUsdt,
+	}
+	if err := d.db.Table("user_balance").Create(userBalance).Error; nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		d.db.Table("user_balance").Where("user_id=?", userBalance.UserId).Delete(&UserBalance{})
+		d.db.Table("user_balance_record").Where("user_id=?", userBalance.UserId).Delete(&UserBalanceRecord{})
+		d.db.Table("reward").Where("user_id=?", userBalance.UserId).Delete(&Reward{})
+		d.db.Table("ledger_entry").Where("user_id=?", userBalance.UserId).Delete(&LedgerEntry{})
+		d.db.Table("ledger_posting").Where("user_id=?", userBalance.UserId).Delete(&LedgerPosting{})
+	})
+
+	return userBalance
+}
+
+func getTestUserBalance(t *testing.T, d *Data, userId int64) *UserBalance {
+	var userBalance UserBalance
+	if err := d.db.Table("user_balance").Where("user_id=?", userId).First(&userBalance).Error; nil != err {
+		t.Fatal(err)
+	}
+
+	return &userBalance
+}
+
+// 并发扣款不能透支，每次成功的修改版本号各不相同且连续
+func TestUpdateUserBalanceParallelDebit(t *testing.T) {
+	d := newTestData(t)
+	initial := newTestUserBalance(t, d, 100*10000000000)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		versions []int64
+		success  int64
+	)
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var userBalance *UserBalance
+			err := d.ExecTx(context.Background(), func(ctx context.Context) error {
+				var err error
+				userBalance, err = updateUserBalance(ctx, d, initial.UserId, "balance_usdt", -10*10000000000, true)
+				return err
+			})
+			if nil != err {
+				return
+			}
+
+			mu.Lock()
+			success++
+			versions = append(versions, userBalance.Version)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if 10 != success {
+		t.Fatalf("success %d, want 10", success)
+	}
+
+	final := getTestUserBalance(t, d, initial.UserId)
+	if 0 != final.BalanceUsdt {
+		t.Fatalf("balance %d, want 0", final.BalanceUsdt)
+	}
+	if initial.Version+success != final.Version {
+		t.Fatalf("version %d, want %d", final.Version, initial.Version+success)
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	for i, v := range versions {
+		if initial.Version+int64(i)+1 != v {
+			t.Fatalf("versions %v not monotonic from %d", versions, initial.Version)
+		}
+	}
+}
+
+// 同一用户并发提现、分红、余额入单，余额等于成功操作的合计，不丢更新不透支
+func TestUpdateUserBalanceParallelMixed(t *testing.T) {
+	d := newTestData(t)
+	ub := &UserBalanceRepo{data: d}
+	initial := newTestUserBalance(t, d, 50*10000000000)
+
+	const (
+		withdrawAmount = 7 * 10000000000
+		depositAmount  = 11 * 10000000000
+		rewardAmount   = 3 * 10000000000
+	)
+
+	var (
+		wg               sync.WaitGroup
+		mu               sync.Mutex
+		withdrawSuccess  int64
+		depositSuccess   int64
+		rewardSuccess    int64
+		unexpectedErrors []error
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			err := d.ExecTx(context.Background(), func(ctx context.Context) error {
+				_, err := ub.WithdrawUsdt(ctx, initial.UserId, withdrawAmount)
+				return err
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if nil == err {
+				withdrawSuccess++
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			err := d.ExecTx(context.Background(), func(ctx context.Context) error {
+				_, err := ub.DepositInternal(ctx, initial.UserId, depositAmount)
+				return err
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if nil == err {
+				depositSuccess++
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			err := d.ExecTx(context.Background(), func(ctx context.Context) error {
+				_, err := ub.LocationReward(ctx, initial.UserId, rewardAmount, 0, 0, "")
+				return err
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if nil == err {
+				rewardSuccess++
+			} else {
+				unexpectedErrors = append(unexpectedErrors, err) // 分红不校验余额，不应失败
+			}
+		}()
+	}
+	wg.Wait()
+
+	if 0 < len(unexpectedErrors) {
+		t.Fatalf("reward errors: %v", unexpectedErrors)
+	}
+
+	final := getTestUserBalance(t, d, initial.UserId)
+	want := initial.BalanceUsdt - withdrawSuccess*withdrawAmount - depositSuccess*depositAmount + rewardSuccess*rewardAmount
+	if want != final.BalanceUsdt {
+		t.Fatalf("balance %d, want %d (withdraw %d deposit %d reward %d)", final.BalanceUsdt, want, withdrawSuccess, depositSuccess, rewardSuccess)
+	}
+	if 0 > final.BalanceUsdt {
+		t.Fatalf("overdraft: %d", final.BalanceUsdt)
+	}
+	if initial.Version+withdrawSuccess+depositSuccess+rewardSuccess != final.Version {
+		t.Fatalf("version %d, want %d", final.Version, initial.Version+withdrawSuccess+depositSuccess+rewardSuccess)
+	}
+
+	// 每条流水记的变动后余额都不能为负，条数和成功次数一致
+	var records []*UserBalanceRecord
+	if err := d.db.Table("user_balance_record").Where("user_id=? and coin_type=?", initial.UserId, "usdt").Find(&records).Error; nil != err {
+		t.Fatal(err)
+	}
+	if withdrawSuccess+depositSuccess+rewardSuccess != int64(len(records)) {
+		t.Fatalf("records %d, want %d", len(records), withdrawSuccess+depositSuccess+rewardSuccess)
+	}
+	for _, v := range records {
+		if 0 > v.Balance {
+			t.Fatalf("record %d balance %d below zero", v.ID, v.Balance)
+		}
+	}
+}
@@ -43,6 +43,7 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAppHTTPServer(srv, app)
+	srv.Route("/").GET("/api/app_server/statement_csv", app.StatementCsv) // 账单下载，和json接口一样走jwt
 	return srv
 }
 
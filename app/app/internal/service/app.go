@@ -6,6 +6,7 @@ import (
 	"dhb/app/app/internal/pkg/middleware/auth"
 	"encoding/json"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -202,6 +203,10 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 
 			} else if "500000000000000000000" == vDepositUsdtResult.Value {
 
+			} else if "300000000000000000000" == vDepositUsdtResult.Value { // 补差价升级
+
+			} else if "400000000000000000000" == vDepositUsdtResult.Value { // 补差价升级
+
 			} else {
 				continue
 			}
@@ -232,6 +237,51 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 		//time.Sleep(2 * time.Second)
 	}
 
+	// dhb充值只入余额，不匹配档位
+	for i := 1; i <= 10; i++ {
+		var depositDhbResult map[string]*eth
+		depositDhbResult, err = requestEthDepositResult(200, int64(i), a.uuc.GetDhbTokenAddress(ctx))
+		if 0 >= len(depositDhbResult) {
+			break
+		}
+
+		hashKeys = make([]string, 0)
+		fromAccount = make([]string, 0)
+		for hashKey, vDepositResult := range depositDhbResult {
+			hashKeys = append(hashKeys, hashKey)
+			fromAccount = append(fromAccount, vDepositResult.From)
+		}
+
+		depositUsers, err = a.uuc.GetUserByAddress(ctx, fromAccount...)
+		if nil != err || nil == depositUsers {
+			continue
+		}
+		existEthUserRecords, err = a.ruc.GetEthUserRecordByTxHash(ctx, hashKeys...)
+		notExistDepositResult = make([]*biz.EthUserRecord, 0)
+		for _, vDepositDhbResult := range depositDhbResult {
+			if _, ok := existEthUserRecords[vDepositDhbResult.Hash]; ok { // 记录已存在
+				continue
+			}
+			if _, ok := depositUsers[vDepositDhbResult.From]; !ok { // 用户不存在
+				continue
+			}
+
+			notExistDepositResult = append(notExistDepositResult, &biz.EthUserRecord{
+				UserId:   depositUsers[vDepositDhbResult.From].ID,
+				Hash:     vDepositDhbResult.Hash,
+				Status:   "success",
+				Type:     "deposit",
+				Amount:   vDepositDhbResult.Value,
+				CoinType: "DHB",
+			})
+		}
+
+		_, err = a.ruc.EthUserRecordDhbHandle(ctx, notExistDepositResult...)
+		if nil != err {
+			fmt.Println(err)
+		}
+	}
+
 	//_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
 	return &v1.DepositReply{}, nil
 }
@@ -336,6 +386,57 @@ func (a *AppService) RewardList(ctx context.Context, req *v1.RewardListRequest)
 	})
 }
 
+// MyLocationList myLocationList.
+func (a *AppService) MyLocationList(ctx context.Context, req *v1.MyLocationListRequest) (*v1.MyLocationListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.MyLocationList(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
+// LocationNeighbour locationNeighbour.
+func (a *AppService) LocationNeighbour(ctx context.Context, req *v1.LocationNeighbourRequest) (*v1.LocationNeighbourReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.LocationNeighbour(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
+// RecommendTree recommendTree.
+func (a *AppService) RecommendTree(ctx context.Context, req *v1.RecommendTreeRequest) (*v1.RecommendTreeReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.RecommendTree(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
 func (a *AppService) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest) (*v1.RecommendRewardListReply, error) {
 	// 在上下文 context 中取出 claims 对象
 	var userId int64
@@ -401,6 +502,142 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	})
 }
 
+// Transfer transfer.
+func (a *AppService) Transfer(ctx context.Context, req *v1.TransferRequest) (*v1.TransferReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.Transfer(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// Convert convert.
+func (a *AppService) Convert(ctx context.Context, req *v1.ConvertRequest) (*v1.ConvertReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.Convert(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// SetAutoReinvest setAutoReinvest.
+func (a *AppService) SetAutoReinvest(ctx context.Context, req *v1.SetAutoReinvestRequest) (*v1.SetAutoReinvestReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.SetAutoReinvest(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// UpgradeLocation upgradeLocation.
+func (a *AppService) UpgradeLocation(ctx context.Context, req *v1.UpgradeLocationRequest) (*v1.UpgradeLocationReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.UpgradeLocation(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// ExitLocation exitLocation.
+func (a *AppService) ExitLocation(ctx context.Context, req *v1.ExitLocationRequest) (*v1.ExitLocationReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.ExitLocation(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// ReserveProof reserveProof.
+func (a *AppService) ReserveProof(ctx context.Context, req *v1.ReserveProofRequest) (*v1.ReserveProofReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.ReserveProof(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// Statement statement.
+func (a *AppService) Statement(ctx context.Context, req *v1.StatementRequest) (*v1.StatementReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.Statement(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// Reinvest reinvest.
+func (a *AppService) Reinvest(ctx context.Context, req *v1.ReinvestRequest) (*v1.ReinvestReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.Reinvest(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
 func (a *AppService) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
 	return a.uuc.AdminRewardList(ctx, req)
 }
@@ -418,11 +655,291 @@ func (a *AppService) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdra
 }
 
 func (a *AppService) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
-	return a.uuc.AdminWithdraw(ctx, req)
+	lastEventId := a.ruc.LocationEventLastId(ctx)
+	res, err := a.uuc.AdminWithdraw(ctx, req)
+	a.ruc.AutoReinvest(ctx, lastEventId) // 本轮出局的自动复投
+	return res, err
 }
 
 func (a *AppService) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (*v1.AdminFeeReply, error) {
-	return a.uuc.AdminFee(ctx, req)
+	lastEventId := a.ruc.LocationEventLastId(ctx)
+	res, err := a.uuc.AdminFee(ctx, req)
+	a.ruc.AutoReinvest(ctx, lastEventId) // 本轮出局的自动复投
+	return res, err
+}
+
+func (a *AppService) AdminLocationEventList(ctx context.Context, req *v1.AdminLocationEventListRequest) (*v1.AdminLocationEventListReply, error) {
+	return a.uuc.AdminLocationEventList(ctx, req)
+}
+
+func (a *AppService) AdminRecommendTreeBackfill(ctx context.Context, req *v1.AdminRecommendTreeBackfillRequest) (*v1.AdminRecommendTreeBackfillReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminRecommendTreeBackfill(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminTeamStatsRebuild(ctx context.Context, req *v1.AdminTeamStatsRebuildRequest) (*v1.AdminTeamStatsRebuildReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminTeamStatsRebuild(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminBalanceAdjust(ctx context.Context, req *v1.AdminBalanceAdjustRequest) (*v1.AdminBalanceAdjustReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminBalanceAdjust(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminBalanceAdjustApprove(ctx context.Context, req *v1.AdminBalanceAdjustApproveRequest) (*v1.AdminBalanceAdjustApproveReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminBalanceAdjustApprove(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminBalanceAdjustList(ctx context.Context, req *v1.AdminBalanceAdjustListRequest) (*v1.AdminBalanceAdjustListReply, error) {
+	return a.uuc.AdminBalanceAdjustList(ctx, req)
+}
+
+func (a *AppService) AdminDepositReverse(ctx context.Context, req *v1.AdminDepositReverseRequest) (*v1.AdminDepositReverseReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.ruc.AdminDepositReverse(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminReserveSnapshot(ctx context.Context, req *v1.AdminReserveSnapshotRequest) (*v1.AdminReserveSnapshotReply, error) {
+	wallets := make(map[string]int64, 0)
+	for _, v := range a.uuc.GetReserveWallets(ctx) {
+		balance, err := tokenBalance(v, "0x55d398326f99059fF775485246999027B3197955")
+		if nil != err {
+			return nil, err
+		}
+		wallets[v] = new(big.Int).Div(balance, big.NewInt(100000000)).Int64() // 链上精度转换为系统精度
+	}
+
+	return a.uuc.AdminReserveSnapshot(ctx, wallets)
+}
+
+func (a *AppService) AdminReserveList(ctx context.Context, req *v1.AdminReserveListRequest) (*v1.AdminReserveListReply, error) {
+	return a.uuc.AdminReserveList(ctx, req)
+}
+
+func (a *AppService) AdminReserveMerkle(ctx context.Context, req *v1.AdminReserveMerkleRequest) (*v1.AdminReserveMerkleReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminReserveMerkle(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminReconcile(ctx context.Context, req *v1.AdminReconcileRequest) (*v1.AdminReconcileReply, error) {
+	var (
+		withdraws []*biz.Withdraw
+		userIds   []int64
+		users     map[int64]*biz.User
+		err       error
+	)
+	withdraws, err = a.uuc.GetReconcileWithdrawList(ctx, req.Day)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range withdraws {
+		userIds = append(userIds, v.UserId)
+	}
+	users = make(map[int64]*biz.User, 0)
+	if 0 < len(userIds) {
+		users, err = a.uuc.GetUserByUserIds(ctx, userIds...)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	// 按交易hash到链上核对，交易成功且转给提现地址的金额
+	dhbTokenAddress := a.uuc.GetDhbTokenAddress(ctx)
+	onchain := make(map[int64]int64, 0)
+	for _, v := range withdraws {
+		if "" == v.TxHash {
+			continue
+		}
+		if _, ok := users[v.UserId]; !ok {
+			continue
+		}
+		tokenAddress := withdrawTokenAddress(v.Type, dhbTokenAddress)
+		if "" == tokenAddress {
+			continue
+		}
+
+		amount, err := tokenTransferAmount(v.TxHash, tokenAddress, users[v.UserId].Address)
+		if nil != err {
+			a.log.Errorf("reconcile withdraw %d tx %s: %v", v.ID, v.TxHash, err)
+			continue
+		}
+		onchain[v.ID] = new(big.Int).Div(amount, big.NewInt(100000000)).Int64() // 链上精度转换为系统精度
+	}
+
+	return a.uuc.AdminReconcile(ctx, req, onchain)
+}
+
+func (a *AppService) AdminReconcileList(ctx context.Context, req *v1.AdminReconcileListRequest) (*v1.AdminReconcileListReply, error) {
+	return a.uuc.AdminReconcileList(ctx, req)
+}
+
+func (a *AppService) AdminReconcileDetail(ctx context.Context, req *v1.AdminReconcileDetailRequest) (*v1.AdminReconcileDetailReply, error) {
+	return a.uuc.AdminReconcileDetail(ctx, req)
+}
+
+func (a *AppService) AdminLedgerVerify(ctx context.Context, req *v1.AdminLedgerVerifyRequest) (*v1.AdminLedgerVerifyReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminLedgerVerify(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminRecommendAreaCheck(ctx context.Context, req *v1.AdminRecommendAreaCheckRequest) (*v1.AdminRecommendAreaCheckReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminRecommendAreaCheck(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminUserMove(ctx context.Context, req *v1.AdminUserMoveRequest) (*v1.AdminUserMoveReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminUserMove(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminVipLevelList(ctx context.Context, req *v1.AdminVipLevelListRequest) (*v1.AdminVipLevelListReply, error) {
+	return a.uuc.AdminVipLevelList(ctx, req)
+}
+
+func (a *AppService) AdminVipLevelUpdate(ctx context.Context, req *v1.AdminVipLevelUpdateRequest) (*v1.AdminVipLevelUpdateReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminVipLevelUpdate(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+func (a *AppService) AdminVipRecalc(ctx context.Context, req *v1.AdminVipRecalcRequest) (*v1.AdminVipRecalcReply, error) {
+	return a.uuc.AdminVipRecalc(ctx, req)
+}
+
+func (a *AppService) AdminVipLogList(ctx context.Context, req *v1.AdminVipLogListRequest) (*v1.AdminVipLogListReply, error) {
+	return a.uuc.AdminVipLogList(ctx, req)
+}
+
+func (a *AppService) AdminDhbPrice(ctx context.Context, req *v1.AdminDhbPriceRequest) (*v1.AdminDhbPriceReply, error) {
+	var price int64
+	amountOut, err := dhbAmountOut(a.uuc.GetDhbTokenAddress(ctx))
+	if nil == err {
+		price = new(big.Int).Div(amountOut, big.NewInt(100000000)).Int64() // 链上精度转换为系统精度
+	} else {
+		fmt.Println(err)
+	}
+
+	return a.uuc.AdminDhbPrice(ctx, price)
+}
+
+func (a *AppService) AdminDailyMetrics(ctx context.Context, req *v1.AdminDailyMetricsRequest) (*v1.AdminDailyMetricsReply, error) {
+	return a.uuc.AdminDailyMetrics(ctx, req)
+}
+
+func (a *AppService) AdminDailyMetricsSeries(ctx context.Context, req *v1.AdminDailyMetricsSeriesRequest) (*v1.AdminDailyMetricsSeriesReply, error) {
+	return a.uuc.AdminDailyMetricsSeries(ctx, req)
 }
 
 func (a *AppService) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (*v1.AdminAllReply, error) {
@@ -438,11 +955,35 @@ func (a *AppService) AdminMonthRecommend(ctx context.Context, req *v1.AdminMonth
 }
 
 func (a *AppService) AdminConfig(ctx context.Context, req *v1.AdminConfigRequest) (*v1.AdminConfigReply, error) {
-	return a.uuc.AdminConfig(ctx, req)
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminConfig(ctx, req, &biz.User{
+		ID: userId,
+	})
 }
 
 func (a *AppService) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigUpdateRequest) (*v1.AdminConfigUpdateReply, error) {
-	return a.uuc.AdminConfigUpdate(ctx, req)
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.AdminConfigUpdate(ctx, req, &biz.User{
+		ID: userId,
+	})
 }
 
 func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdrawEthRequest) (*v1.AdminWithdrawEthReply, error) {
@@ -472,18 +1013,14 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 		return nil, err
 	}
 
+	dhbTokenAddress := a.uuc.GetDhbTokenAddress(ctx)
 	for _, v := range withdraws {
 		if _, ok := users[v.UserId]; !ok {
 			continue
 		}
 
-		if "dhb" == v.Type {
-			continue
-			//tokenAddress = "0x96BD81715c69eE013405B4005Ba97eA1f420fd87"
-		} else if "usdt" == v.Type {
-			//tokenAddress = "0x337610d27c682E347C9cD60BD4b3b107C9d34dDd"
-			tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		} else {
+		tokenAddress = withdrawTokenAddress(v.Type, dhbTokenAddress)
+		if "" == tokenAddress {
 			continue
 		}
 
@@ -494,12 +1031,13 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 
 		withDrawAmount := strconv.FormatInt(v.Amount, 10) + "00000000" // 补八个0.系统基础1是10个0
 
+		var withdrawHash string
 		for i := 0; i < 3; i++ {
 			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, _, err = toToken("", users[v.UserId].Address, withDrawAmount, tokenAddress)
+			_, withdrawHash, err = toToken("", users[v.UserId].Address, withDrawAmount, tokenAddress)
 			fmt.Println(3333, err)
 			if err == nil {
-				_, err = a.uuc.UpdateWithdrawSuccess(ctx, v.ID)
+				_, err = a.uuc.UpdateWithdrawSuccess(ctx, v.ID, withdrawHash)
 				time.Sleep(6 * time.Second)
 				break
 			} else if "insufficient funds for gas * price + value" == err.Error() {
@@ -654,6 +1192,116 @@ func toToken(userPrivateKey string, toAccount string, withdrawAmount string, wit
 	return true, signedTx.Hash().Hex(), nil
 }
 
+// withdrawTokenAddress 提现币种对应的代币合约
+func withdrawTokenAddress(coinType string, dhbTokenAddress string) string {
+	if "dhb" == coinType {
+		return dhbTokenAddress
+	} else if "usdt" == coinType {
+		//return "0x337610d27c682E347C9cD60BD4b3b107C9d34dDd"
+		return "0x55d398326f99059fF775485246999027B3197955"
+	}
+
+	return ""
+}
+
+// tokenTransferAmount 交易执行成功时转给收款地址的代币数量，链上精度
+func tokenTransferAmount(txHash string, tokenAddress string, toAddress string) (*big.Int, error) {
+	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
+	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+	if err != nil {
+		return nil, err
+	}
+	if types.ReceiptStatusSuccessful != receipt.Status {
+		return nil, errors.New(500, "TX_FAILED", "链上交易失败")
+	}
+
+	transferSignature := []byte("Transfer(address,address,uint256)")
+	hash := sha3.NewKeccak256()
+	hash.Write(transferSignature)
+	transferTopic := common.BytesToHash(hash.Sum(nil))
+
+	token := common.HexToAddress(tokenAddress)
+	to := common.HexToAddress(toAddress)
+	amount := new(big.Int)
+	for _, vLog := range receipt.Logs {
+		if token != vLog.Address || 3 > len(vLog.Topics) || transferTopic != vLog.Topics[0] {
+			continue
+		}
+		if to != common.BytesToAddress(vLog.Topics[2].Bytes()) {
+			continue
+		}
+		amount.Add(amount, new(big.Int).SetBytes(vLog.Data))
+	}
+
+	return amount, nil
+}
+
+// tokenBalance 链上token余额，链上精度
+func tokenBalance(account string, tokenAddress string) (*big.Int, error) {
+	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
+	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
+	if err != nil {
+		return nil, err
+	}
+
+	balanceOfSignature := []byte("balanceOf(address)")
+	hash := sha3.NewKeccak256()
+	hash.Write(balanceOfSignature)
+	methodID := hash.Sum(nil)[:4]
+
+	var data []byte
+	data = append(data, methodID...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(account).Bytes(), 32)...)
+
+	token := common.HexToAddress(tokenAddress)
+	res, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(res), nil
+}
+
+// dhbAmountOut 薄饼路由查询1个dhb能换的usdt，链上精度
+func dhbAmountOut(dhbTokenAddress string) (*big.Int, error) {
+	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
+	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
+	if err != nil {
+		return nil, err
+	}
+
+	getAmountsOutSignature := []byte("getAmountsOut(uint256,address[])")
+	hash := sha3.NewKeccak256()
+	hash.Write(getAmountsOutSignature)
+	methodID := hash.Sum(nil)[:4]
+
+	amountIn, _ := new(big.Int).SetString("1000000000000000000", 10)
+
+	var data []byte
+	data = append(data, methodID...)
+	data = append(data, common.LeftPadBytes(amountIn.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(64).Bytes(), 32)...) // 数组偏移
+	data = append(data, common.LeftPadBytes(big.NewInt(2).Bytes(), 32)...)  // 数组长度
+	data = append(data, common.LeftPadBytes(common.HexToAddress(dhbTokenAddress).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress("0x55d398326f99059fF775485246999027B3197955").Bytes(), 32)...)
+
+	router := common.HexToAddress("0x10ED43C718714eb63d5aA57B78B54704E256024E")
+	res, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &router, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if 32*4 > len(res) { // 偏移、长度、两个数量
+		return nil, errors.New(500, "DHB_PRICE_ERROR", "价格查询失败")
+	}
+
+	return new(big.Int).SetBytes(res[len(res)-32:]), nil
+}
+
 func BnbBalance(bnbAccount string) string {
 	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
 	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"encoding/csv"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const OperationAppStatementCsv = "/api.App/StatementCsv"
+
+// StatementCsv 账单csv下载，参数和Statement一致 .
+func (a *AppService) StatementCsv(ctx http.Context) error {
+	var in v1.StatementRequest
+	if err := ctx.BindQuery(&in); err != nil {
+		return err
+	}
+	http.SetOperation(ctx, OperationAppStatementCsv)
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return a.Statement(ctx, req.(*v1.StatementRequest))
+	})
+	out, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	reply := out.(*v1.StatementReply)
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=statement_"+reply.Start+"_"+reply.End+".csv")
+	w.WriteHeader(200)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"created_at", "type", "reason", "coin_type", "amount", "balance", "status", "ref"})
+	_ = cw.Write([]string{reply.Start, "opening", "", "usdt", "", reply.OpeningUsdt, "", ""})
+	_ = cw.Write([]string{reply.Start, "opening", "", "dhb", "", reply.OpeningDhb, "", ""})
+	for _, v := range reply.List {
+		_ = cw.Write([]string{v.CreatedAt, v.Type, v.Reason, v.CoinType, v.Amount, v.Balance, v.Status, v.Ref})
+	}
+	_ = cw.Write([]string{reply.End, "closing", "", "usdt", "", reply.ClosingUsdt, "", ""})
+	_ = cw.Write([]string{reply.End, "closing", "", "dhb", "", reply.ClosingDhb, "", ""})
+	cw.Flush()
+
+	return cw.Error()
+}